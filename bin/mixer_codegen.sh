@@ -110,6 +110,7 @@ mappings=(
   "gogoproto/gogo.proto=github.com/gogo/protobuf/gogoproto"
   "google/protobuf/any.proto=github.com/gogo/protobuf/types"
   "google/protobuf/duration.proto=github.com/gogo/protobuf/types"
+  "google/protobuf/timestamp.proto=github.com/gogo/protobuf/types"
   "google/rpc/status.proto=istio.io/gogo-genproto/googleapis/google/rpc"
   "google/rpc/code.proto=istio.io/gogo-genproto/googleapis/google/rpc"
   "google/rpc/error_details.proto=istio.io/gogo-genproto/googleapis/google/rpc"
@@ -134,6 +135,9 @@ if [ "$opttemplate" = true ]; then
     "mixer/v1/template/standard_types.proto:istio.io/api/mixer/v1/template"
     "gogoproto/gogo.proto:github.com/gogo/protobuf/gogoproto"
     "google/protobuf/duration.proto:github.com/gogo/protobuf/types"
+    "google/protobuf/timestamp.proto:github.com/gogo/protobuf/types"
+    "google/rpc/status.proto:istio.io/gogo-genproto/googleapis/google/rpc"
+    "mixer/pkg/adapter/remote/remote.proto:istio.io/istio/mixer/pkg/adapter/remote"
   )
 
   TMPL_GEN_MAP=""
@@ -148,14 +152,21 @@ if [ "$opttemplate" = true ]; then
   TMPL_PLUGIN="--plugin=$ROOT/bin/protoc-gen-gogoslick-$GOGO_VERSION --gogoslick-${GOGO_VERSION}_out=$TMPL_PROTOC_MAPPING:"
   TMPL_PLUGIN+=$outdir
 
+  SVC_PLUGIN="--plugin=$ROOT/bin/protoc-gen-gogoslick-$GOGO_VERSION --gogoslick-${GOGO_VERSION}_out=plugins=grpc,$TMPL_PROTOC_MAPPING:"
+  SVC_PLUGIN+=$outdir
+
   descriptor_set="_proto.descriptor_set"
   handler_gen_go="_handler.gen.go"
   instance_proto="_instance.proto"
+  service_proto="_handler_service.proto"
+  service_gen_go="_handler_service.gen.go"
   pb_go=".pb.go"
 
   templateDS=${template/.proto/$descriptor_set}
   templateHG=${template/.proto/$handler_gen_go}
   templateIP=${template/.proto/$instance_proto}
+  templateSP=${template/.proto/$service_proto}
+  templateSG=${template/.proto/$service_gen_go}
   templatePG=${template/.proto/$pb_go}
 
   # generate the descriptor set for the intermediate artifacts
@@ -165,13 +176,21 @@ if [ "$opttemplate" = true ]; then
     die "template generation failure: $err"; 
   fi
   
-  go run $GOPATH/src/istio.io/istio/mixer/tools/codegen/cmd/mixgenproc/main.go $templateDS -o $templateHG -t $templateIP $TMPL_GEN_MAP  
+  go run $GOPATH/src/istio.io/istio/mixer/tools/codegen/cmd/mixgenproc/main.go $templateDS -o $templateHG -t $templateIP -s $templateSP -g $templateSG $TMPL_GEN_MAP
 
   err=`$protoc $IMPORTS $TMPL_PLUGIN $templateIP`
   if [ ! -z "$err" ]; then 
     die "template generation failure: $err"; 
   fi
 
+  # attribute generating templates have no handler service
+  if [ -f $templateSP ]; then
+    err=`$protoc $IMPORTS $SVC_PLUGIN $templateSP`
+    if [ ! -z "$err" ]; then
+      die "template generation failure: $err";
+    fi
+  fi
+
   #rm $templateDS
   rm $templateIP
   rm $templatePG
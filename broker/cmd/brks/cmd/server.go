@@ -25,6 +25,7 @@ type serverArgs struct {
 	port       uint16
 	apiPort    uint16
 	kubeconfig string
+	namespace  string
 }
 
 func serverCmd(printf, fatalf shared.FormatFn) *cobra.Command {
@@ -44,11 +45,13 @@ func serverCmd(printf, fatalf shared.FormatFn) *cobra.Command {
 	serverCmd.PersistentFlags().Uint16Var(&sa.apiPort, "apiPort", 9093, "TCP port to use for Broker's gRPC API")
 	serverCmd.PersistentFlags().StringVar(&sa.kubeconfig, "kubeconfig", "",
 		"Use a Kubernetes configuration file instead of in-cluster configuration")
+	serverCmd.PersistentFlags().StringVar(&sa.namespace, "namespace", "istio-system",
+		"Namespace where the provisioned service instances and bindings are stored")
 	return &serverCmd
 }
 
 func runServer(sa *serverArgs, printf, fatalf shared.FormatFn) {
-	if osb, err := server.CreateServer(sa.kubeconfig); err != nil {
+	if osb, err := server.CreateServer(sa.kubeconfig, sa.namespace); err != nil {
 		fatalf("Failed to create server: %s", err.Error())
 	} else {
 		printf("Server started, listening on port %d", sa.port)
@@ -0,0 +1,153 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/gorilla/mux"
+
+	brokerconfig "istio.io/api/broker/v1/config"
+	"istio.io/istio/broker/pkg/model/config"
+	"istio.io/istio/broker/pkg/model/config/state"
+	"istio.io/istio/broker/pkg/model/osb"
+	"istio.io/istio/pkg/log"
+)
+
+// Bind serves service binding requests. The credentials of a binding hold the mesh address
+// of the service, through which the bound application reaches it.
+func (c *Controller) Bind(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	instanceID, bindingID := vars["instance_id"], vars["binding_id"]
+	var req osb.ServiceBinding
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "", fmt.Sprintf("invalid bind request (%v)", err))
+		return
+	}
+
+	instance, exists := c.ServiceInstance(instanceID)
+	if !exists {
+		writeError(w, http.StatusNotFound, "", fmt.Sprintf("unknown service instance %q", instanceID))
+		return
+	}
+	if inProgress(instance, "") {
+		writeError(w, http.StatusUnprocessableEntity, errorConcurrency,
+			fmt.Sprintf("%s of service instance %q is in progress", instance.LastOperation.Operation, instanceID))
+		return
+	}
+	if req.ServiceID != instance.ServiceId {
+		writeError(w, http.StatusBadRequest, "",
+			fmt.Sprintf("service instance %q is not an instance of service %q", instanceID, req.ServiceID))
+		return
+	}
+	if req.ServicePlanID != instance.PlanId {
+		writeError(w, http.StatusBadRequest, "",
+			fmt.Sprintf("service instance %q is not an instance of plan %q", instanceID, req.ServicePlanID))
+		return
+	}
+	sc, err := c.findServicePlan(req.ServiceID, req.ServicePlanID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+	binding, err := newServiceBinding(instanceID, bindingID, &req, sc)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+
+	if existing, found := c.ServiceBinding(bindingID); found {
+		if !sameServiceBinding(existing, binding) {
+			writeResponse(w, http.StatusConflict, empty)
+			return
+		}
+		writeResponse(w, http.StatusOK, &osb.CreateServiceBindingResponse{Credentials: existing.Credentials})
+		return
+	}
+
+	log.Infof("Binding service instance %q with binding %q", instanceID, bindingID)
+	if err = c.PutServiceBinding(binding); err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	writeResponse(w, http.StatusCreated, &osb.CreateServiceBindingResponse{Credentials: binding.Credentials})
+}
+
+// Unbind serves service unbinding requests.
+func (c *Controller) Unbind(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	instanceID, bindingID := vars["instance_id"], vars["binding_id"]
+	query := r.URL.Query()
+	if query.Get("service_id") == "" || query.Get("plan_id") == "" {
+		writeError(w, http.StatusBadRequest, "", "service_id and plan_id are required")
+		return
+	}
+
+	binding, exists := c.ServiceBinding(bindingID)
+	if !exists || binding.InstanceId != instanceID {
+		writeResponse(w, http.StatusGone, empty)
+		return
+	}
+
+	log.Infof("Unbinding service instance %q from binding %q", instanceID, bindingID)
+	if err := c.DeleteServiceBinding(bindingID); err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	writeResponse(w, http.StatusOK, empty)
+}
+
+func newServiceBinding(instanceID, bindingID string, req *osb.ServiceBinding,
+	sc *brokerconfig.ServiceClass) (*state.ServiceBinding, error) {
+	params, err := encodeJSON(req.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters (%v)", err)
+	}
+	appGUID := req.AppID
+	if req.BindResource != nil && req.BindResource.AppGUID != "" {
+		appGUID = req.BindResource.AppGUID
+	}
+	binding := &state.ServiceBinding{
+		BindingId:   bindingID,
+		InstanceId:  instanceID,
+		ServiceId:   req.ServiceID,
+		PlanId:      req.ServicePlanID,
+		AppGuid:     appGUID,
+		Parameters:  params,
+		Credentials: credentials(sc),
+	}
+	if err = config.ServiceBinding.Validate(binding); err != nil {
+		return nil, err
+	}
+	return binding, nil
+}
+
+// credentials returns the mesh connection credentials of the deployment of a service class.
+// The deployment does not tell the protocol of the service, so only its host is given.
+func credentials(sc *brokerconfig.ServiceClass) map[string]string {
+	return map[string]string{
+		"host": sc.GetDeployment().GetInstance(),
+	}
+}
+
+// sameServiceBinding tells whether a bind request is identical to an existing binding.
+func sameServiceBinding(existing, binding *state.ServiceBinding) bool {
+	return existing.InstanceId == binding.InstanceId && existing.ServiceId == binding.ServiceId &&
+		existing.PlanId == binding.PlanId && existing.AppGuid == binding.AppGuid &&
+		existing.Parameters == binding.Parameters && reflect.DeepEqual(existing.Credentials, binding.Credentials)
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"istio.io/istio/broker/pkg/model/osb"
+)
+
+const bindingURL = instanceURL + "/service_bindings/binding-1"
+
+func bindRequest() *osb.ServiceBinding {
+	return &osb.ServiceBinding{
+		ServiceID:     testServiceID,
+		ServicePlanID: testPlanID,
+		BindResource:  &osb.BindResource{AppGUID: "app-1"},
+	}
+}
+
+func TestBind(t *testing.T) {
+	r, _ := newCatalogTestStore(t)
+
+	if code := r.serve(t, "PUT", bindingURL, bindRequest(), nil); code != http.StatusNotFound {
+		t.Errorf("Bind to an unknown instance => got status %d, want %d", code, http.StatusNotFound)
+	}
+	if code := r.serve(t, "PUT", instanceURL, provisionRequest(), nil); code != http.StatusCreated {
+		t.Fatalf("Provision => got status %d, want %d", code, http.StatusCreated)
+	}
+
+	otherPlan := bindRequest()
+	otherPlan.ServicePlanID = "other-plan"
+	if code := r.serve(t, "PUT", bindingURL, otherPlan, nil); code != http.StatusBadRequest {
+		t.Errorf("Bind with another plan => got status %d, want %d", code, http.StatusBadRequest)
+	}
+
+	want := map[string]interface{}{"host": "productpage"}
+	var resp struct {
+		Credentials map[string]interface{} `json:"credentials"`
+	}
+	if code := r.serve(t, "PUT", bindingURL, bindRequest(), &resp); code != http.StatusCreated ||
+		!reflect.DeepEqual(resp.Credentials, want) {
+		t.Errorf("Bind => got status %d and credentials %v, want %v", code, resp.Credentials, want)
+	}
+	if binding := r.mockStore.bindings["binding-1"]; binding == nil || binding.InstanceId != "instance-1" ||
+		binding.AppGuid != "app-1" {
+		t.Errorf("Unexpected service binding %v", binding)
+	}
+
+	// Identical requests return the same credentials, conflicting ones fail.
+	if code := r.serve(t, "PUT", bindingURL, bindRequest(), &resp); code != http.StatusOK ||
+		!reflect.DeepEqual(resp.Credentials, want) {
+		t.Errorf("Identical bind => got status %d and credentials %v, want %v", code, resp.Credentials, want)
+	}
+	conflicting := bindRequest()
+	conflicting.BindResource.AppGUID = "app-2"
+	if code := r.serve(t, "PUT", bindingURL, conflicting, nil); code != http.StatusConflict {
+		t.Errorf("Conflicting bind => got status %d, want %d", code, http.StatusConflict)
+	}
+
+	if code := r.serve(t, "DELETE", bindingURL+deprovisionQuery, nil, nil); code != http.StatusOK {
+		t.Errorf("Unbind => got status %d, want %d", code, http.StatusOK)
+	}
+	if code := r.serve(t, "DELETE", bindingURL+deprovisionQuery, nil, nil); code != http.StatusGone {
+		t.Errorf("Unbind => got status %d, want %d", code, http.StatusGone)
+	}
+}
+
+func TestDeprovisionRemovesBindings(t *testing.T) {
+	r, _ := newCatalogTestStore(t)
+
+	if code := r.serve(t, "PUT", instanceURL, provisionRequest(), nil); code != http.StatusCreated {
+		t.Fatalf("Provision => got status %d, want %d", code, http.StatusCreated)
+	}
+	if code := r.serve(t, "PUT", bindingURL, bindRequest(), nil); code != http.StatusCreated {
+		t.Fatalf("Bind => got status %d, want %d", code, http.StatusCreated)
+	}
+	if code := r.serve(t, "DELETE", instanceURL+deprovisionQuery, nil, nil); code != http.StatusOK {
+		t.Fatalf("Deprovision => got status %d, want %d", code, http.StatusOK)
+	}
+	if len(r.mockStore.bindings) != 0 {
+		t.Errorf("Unexpected service bindings %v", r.mockStore.bindings)
+	}
+}
@@ -19,6 +19,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+
 	// TODO(nmittler): Remove this
 	_ "github.com/golang/glog"
 
@@ -27,14 +30,47 @@ import (
 	"istio.io/istio/pkg/log"
 )
 
+// APIVersionHeader is the header carrying the OSB API version of the requests.
+const APIVersionHeader = "X-Broker-API-Version"
+
+// minAPIMinorVersion is the oldest supported 2.x OSB API version.
+const minAPIMinorVersion = 13
+
 // Controller data
 type Controller struct {
 	config.BrokerConfigStore
+
+	// async runs the asynchronous parts of the operations.
+	async func(func())
 }
 
 // CreateController creates a new controller instance.
 func CreateController(config config.BrokerConfigStore) (*Controller, error) {
-	return &Controller{config}, nil
+	return &Controller{
+		BrokerConfigStore: config,
+		async:             func(f func()) { go f() },
+	}, nil
+}
+
+// CheckAPIVersion rejects the requests without a supported X-Broker-API-Version header.
+func CheckAPIVersion(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if version := r.Header.Get(APIVersionHeader); !supportedAPIVersion(version) {
+			writeError(w, http.StatusPreconditionFailed, "",
+				fmt.Sprintf("unsupported %s %q, expecting 2.%d or later", APIVersionHeader, version, minAPIMinorVersion))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func supportedAPIVersion(version string) bool {
+	parts := strings.SplitN(version, ".", 2)
+	if len(parts) != 2 || parts[0] != "2" {
+		return false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	return err == nil && minor >= minAPIMinorVersion
 }
 
 // Catalog serves catalog request and generate response.
@@ -61,7 +97,10 @@ func (c *Controller) catalog() *osb.Catalog {
 	return jc
 }
 
-// nolint: unparam
+func writeError(w http.ResponseWriter, code int, errorCode, description string) {
+	writeResponse(w, code, &osb.ErrorResponse{Error: errorCode, Description: description})
+}
+
 func writeResponse(w http.ResponseWriter, code int, object interface{}) {
 	data, err := json.Marshal(object)
 	if err != nil {
@@ -71,7 +110,7 @@ func writeResponse(w http.ResponseWriter, code int, object interface{}) {
 	}
 	w.Header().Set("content-type", "application/json")
 	w.WriteHeader(code)
-	if _, err = fmt.Fprintf(w, string(data)); err != nil {
+	if _, err = w.Write(data); err != nil {
 		log.Errorf("Write response data error %s", err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
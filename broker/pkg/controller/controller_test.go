@@ -15,13 +15,20 @@
 package controller
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/gorilla/mux"
 
 	brokerconfig "istio.io/api/broker/v1/config"
 	"istio.io/istio/broker/pkg/model/config"
+	"istio.io/istio/broker/pkg/model/config/state"
 	"istio.io/istio/broker/pkg/model/osb"
 )
 
@@ -32,8 +39,10 @@ type testStore struct {
 
 type mockStore struct {
 	config.BrokerConfigStore
-	services map[string]*brokerconfig.ServiceClass
-	plans    map[string]*brokerconfig.ServicePlan
+	services  map[string]*brokerconfig.ServiceClass
+	plans     map[string]*brokerconfig.ServicePlan
+	instances map[string]*state.ServiceInstance
+	bindings  map[string]*state.ServiceBinding
 }
 
 func (m mockStore) ServiceClasses() map[string]*brokerconfig.ServiceClass {
@@ -48,12 +57,118 @@ func (m mockStore) ServicePlansByService(service string) map[string]*brokerconfi
 	return m.plans
 }
 
+func (m mockStore) ServiceInstance(id string) (*state.ServiceInstance, bool) {
+	instance, exists := m.instances[id]
+	return instance, exists
+}
+
+func (m mockStore) PutServiceInstance(instance *state.ServiceInstance) error {
+	m.instances[instance.InstanceId] = instance
+	return nil
+}
+
+func (m mockStore) DeleteServiceInstance(id string) error {
+	if _, exists := m.instances[id]; !exists {
+		return errors.New("not found")
+	}
+	delete(m.instances, id)
+	return nil
+}
+
+func (m mockStore) ServiceBinding(id string) (*state.ServiceBinding, bool) {
+	binding, exists := m.bindings[id]
+	return binding, exists
+}
+
+func (m mockStore) ServiceBindingsByInstance(instanceID string) map[string]*state.ServiceBinding {
+	out := make(map[string]*state.ServiceBinding)
+	for k, b := range m.bindings {
+		if b.InstanceId == instanceID {
+			out[k] = b
+		}
+	}
+	return out
+}
+
+func (m mockStore) PutServiceBinding(binding *state.ServiceBinding) error {
+	m.bindings[binding.BindingId] = binding
+	return nil
+}
+
+func (m mockStore) DeleteServiceBinding(id string) error {
+	if _, exists := m.bindings[id]; !exists {
+		return errors.New("not found")
+	}
+	delete(m.bindings, id)
+	return nil
+}
+
 func newTestStore(t *testing.T) *testStore {
-	ms := &mockStore{}
+	ms := &mockStore{
+		instances: make(map[string]*state.ServiceInstance),
+		bindings:  make(map[string]*state.ServiceBinding),
+	}
 	return &testStore{
 		ms,
-		&Controller{ms},
+		&Controller{BrokerConfigStore: ms},
+	}
+}
+
+const (
+	testServiceID = "4395a443-f49a-41b0-8d14-d17294cf612f"
+	testPlanID    = "cdd76b03-a28b-4638-b4e2-19ee44b36db7"
+)
+
+// newCatalogTestStore returns a test store holding the productpage service and its yearly plan.
+// The asynchronous parts of the operations are queued in pending.
+func newCatalogTestStore(t *testing.T) (*testStore, *[]func()) {
+	r := newTestStore(t)
+	r.mockStore.services = map[string]*brokerconfig.ServiceClass{
+		"service-class/default/productpage-service-class": {
+			Deployment: &brokerconfig.Deployment{Instance: "productpage"},
+			Entry:      &brokerconfig.CatalogEntry{Name: "istio-bookinfo-productpage", Id: testServiceID},
+		},
+	}
+	r.mockStore.plans = map[string]*brokerconfig.ServicePlan{
+		"service-plan/default/istio-yearly": {
+			Services: []string{"service-class/default/productpage-service-class"},
+			Plan:     &brokerconfig.CatalogPlan{Name: "istio-yearly", Id: testPlanID},
+		},
+	}
+	pending := &[]func(){}
+	r.controller.async = func(f func()) { *pending = append(*pending, f) }
+	return r, pending
+}
+
+// serve sends an OSB request to the controller and decodes the response into out, if not nil.
+func (r *testStore) serve(t *testing.T, method, url string, body interface{}, out interface{}) int {
+	t.Helper()
+	router := mux.NewRouter()
+	router.HandleFunc("/v2/service_instances/{instance_id}", r.controller.Provision).Methods("PUT")
+	router.HandleFunc("/v2/service_instances/{instance_id}", r.controller.Deprovision).Methods("DELETE")
+	router.HandleFunc("/v2/service_instances/{instance_id}/last_operation", r.controller.LastOperation).Methods("GET")
+	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", r.controller.Bind).
+		Methods("PUT")
+	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", r.controller.Unbind).
+		Methods("DELETE")
+
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			t.Fatal(err)
+		}
 	}
+	req := httptest.NewRequest(method, url, bytes.NewReader(data))
+	req.Header.Set(APIVersionHeader, "2.13")
+	w := httptest.NewRecorder()
+	CheckAPIVersion(router).ServeHTTP(w, req)
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			t.Fatalf("%s %s: invalid response %q (%v)", method, url, w.Body.String(), err)
+		}
+	}
+	return w.Code
 }
 
 func TestCatalog(t *testing.T) {
@@ -101,6 +216,7 @@ func TestCatalog(t *testing.T) {
 						Name:        "istio-bookinfo-productpage",
 						ID:          "4395a443-f49a-41b0-8d14-d17294cf612f",
 						Description: "A book info service",
+						Bindable:    true,
 						Plans: []osb.ServicePlan{
 							{
 								Name:        "istio-yearly",
@@ -116,3 +232,31 @@ func TestCatalog(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckAPIVersion(t *testing.T) {
+	handler := CheckAPIVersion(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	cases := []struct {
+		version string
+		want    int
+	}{
+		{"2.13", http.StatusOK},
+		{"2.14", http.StatusOK},
+		{"", http.StatusPreconditionFailed},
+		{"2.12", http.StatusPreconditionFailed},
+		{"3.0", http.StatusPreconditionFailed},
+		{"2.x", http.StatusPreconditionFailed},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest("GET", "/v2/catalog", nil)
+		if c.version != "" {
+			req.Header.Set(APIVersionHeader, c.version)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != c.want {
+			t.Errorf("version %q => got status %d, want %d", c.version, w.Code, c.want)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	brokerconfig "istio.io/api/broker/v1/config"
+	"istio.io/istio/broker/pkg/model/config"
+	"istio.io/istio/broker/pkg/model/config/state"
+	"istio.io/istio/broker/pkg/model/osb"
+	"istio.io/istio/pkg/log"
+)
+
+const (
+	operationProvision   = "provision"
+	operationDeprovision = "deprovision"
+
+	// OSB states of the last operation.
+	stateInProgress = "in progress"
+	stateSucceeded  = "succeeded"
+	stateFailed     = "failed"
+
+	// errorConcurrency is the OSB error code of the requests conflicting with an operation in progress.
+	errorConcurrency = "ConcurrencyError"
+)
+
+// empty is the body of the OSB responses without content.
+var empty = struct{}{}
+
+// Provision serves service instance provision requests. Instances are recorded in the config
+// store; when the platform accepts incomplete operations, the request completes asynchronously.
+func (c *Controller) Provision(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+	var req osb.ServiceInstance
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "", fmt.Sprintf("invalid provision request (%v)", err))
+		return
+	}
+	if _, err := c.findServicePlan(req.ServiceID, req.PlanID); err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+	instance, err := newServiceInstance(id, &req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+
+	if existing, exists := c.ServiceInstance(id); exists {
+		switch {
+		case !sameServiceInstance(existing, instance):
+			writeResponse(w, http.StatusConflict, empty)
+		case inProgress(existing, operationProvision):
+			writeResponse(w, http.StatusAccepted, &osb.CreateServiceInstanceResponse{Operation: operationProvision})
+		default:
+			writeResponse(w, http.StatusOK, &osb.CreateServiceInstanceResponse{})
+		}
+		return
+	}
+
+	log.Infof("Provisioning service instance %q of service %q and plan %q", id, req.ServiceID, req.PlanID)
+	if !acceptsIncomplete(r) {
+		instance.LastOperation = &state.LastOperation{Operation: operationProvision, State: stateSucceeded}
+		if err = c.PutServiceInstance(instance); err != nil {
+			writeError(w, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		writeResponse(w, http.StatusCreated, &osb.CreateServiceInstanceResponse{})
+		return
+	}
+
+	// The instance is recorded as in progress first, so that the platform can poll its state.
+	instance.LastOperation = &state.LastOperation{Operation: operationProvision, State: stateInProgress}
+	if err = c.PutServiceInstance(instance); err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	c.async(func() { c.completeOperation(instance, nil) })
+	writeResponse(w, http.StatusAccepted, &osb.CreateServiceInstanceResponse{Operation: operationProvision})
+}
+
+// Deprovision serves service instance deprovision requests. The remaining bindings of the
+// instance are removed along with it.
+func (c *Controller) Deprovision(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+	query := r.URL.Query()
+	if query.Get("service_id") == "" || query.Get("plan_id") == "" {
+		writeError(w, http.StatusBadRequest, "", "service_id and plan_id are required")
+		return
+	}
+
+	instance, exists := c.ServiceInstance(id)
+	if !exists {
+		writeResponse(w, http.StatusGone, empty)
+		return
+	}
+	if inProgress(instance, "") {
+		writeError(w, http.StatusUnprocessableEntity, errorConcurrency,
+			fmt.Sprintf("%s of service instance %q is in progress", instance.LastOperation.Operation, id))
+		return
+	}
+
+	log.Infof("Deprovisioning service instance %q", id)
+	if !acceptsIncomplete(r) {
+		if err := c.deprovision(id); err != nil {
+			writeError(w, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		writeResponse(w, http.StatusOK, empty)
+		return
+	}
+
+	instance.LastOperation = &state.LastOperation{Operation: operationDeprovision, State: stateInProgress}
+	if err := c.PutServiceInstance(instance); err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	c.async(func() {
+		// A successful deprovision deletes the instance, so only failures are recorded.
+		if err := c.deprovision(id); err != nil {
+			c.completeOperation(instance, err)
+		}
+	})
+	writeResponse(w, http.StatusAccepted, &osb.DeleteServiceInstanceResponse{Operation: operationDeprovision})
+}
+
+// LastOperation serves the polling requests of asynchronous service instance operations.
+func (c *Controller) LastOperation(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+	instance, exists := c.ServiceInstance(id)
+	if !exists {
+		// The instance has been deprovisioned.
+		writeResponse(w, http.StatusGone, empty)
+		return
+	}
+
+	op := instance.GetLastOperation()
+	if op == nil {
+		op = &state.LastOperation{Operation: operationProvision, State: stateSucceeded}
+	}
+	if operation := r.URL.Query().Get("operation"); operation != "" && operation != op.Operation {
+		writeError(w, http.StatusBadRequest, "", fmt.Sprintf("unknown operation %q", operation))
+		return
+	}
+	writeResponse(w, http.StatusOK, &osb.LastOperation{State: op.State, Description: op.Description})
+}
+
+// deprovision deletes the service instance and its bindings.
+func (c *Controller) deprovision(id string) error {
+	for _, b := range c.ServiceBindingsByInstance(id) {
+		if err := c.DeleteServiceBinding(b.BindingId); err != nil {
+			return err
+		}
+	}
+	return c.DeleteServiceInstance(id)
+}
+
+// completeOperation records the outcome of the last operation of the service instance.
+func (c *Controller) completeOperation(instance *state.ServiceInstance, opErr error) {
+	op := *instance.LastOperation
+	op.State, op.Description = stateSucceeded, ""
+	if opErr != nil {
+		log.Errorf("Failed to %s service instance %q: %v", op.Operation, instance.InstanceId, opErr)
+		op.State, op.Description = stateFailed, opErr.Error()
+	}
+	instance.LastOperation = &op
+	if err := c.PutServiceInstance(instance); err != nil {
+		log.Errorf("Failed to record the %s of service instance %q: %v", op.Operation, instance.InstanceId, err)
+	}
+}
+
+// findServicePlan returns the service class of the catalog service, after checking that
+// the catalog plan belongs to it.
+func (c *Controller) findServicePlan(serviceID, planID string) (*brokerconfig.ServiceClass, error) {
+	for k, s := range c.ServiceClasses() {
+		if s.GetEntry().GetId() != serviceID {
+			continue
+		}
+		for _, p := range c.ServicePlansByService(k) {
+			if p.GetPlan().GetId() == planID {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown plan %q of service %q", planID, serviceID)
+	}
+	return nil, fmt.Errorf("unknown service %q", serviceID)
+}
+
+func newServiceInstance(id string, req *osb.ServiceInstance) (*state.ServiceInstance, error) {
+	ctx, err := encodeJSON(req.Context)
+	if err != nil {
+		return nil, fmt.Errorf("invalid context (%v)", err)
+	}
+	params, err := encodeJSON(req.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters (%v)", err)
+	}
+	instance := &state.ServiceInstance{
+		InstanceId:       id,
+		ServiceId:        req.ServiceID,
+		PlanId:           req.PlanID,
+		OrganizationGuid: req.OrganizationGUID,
+		SpaceGuid:        req.SpaceGUID,
+		Context:          ctx,
+		Parameters:       params,
+	}
+	if err = config.ServiceInstance.Validate(instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// sameServiceInstance tells whether a provision request is identical to an existing instance.
+func sameServiceInstance(existing, instance *state.ServiceInstance) bool {
+	return existing.ServiceId == instance.ServiceId && existing.PlanId == instance.PlanId &&
+		existing.OrganizationGuid == instance.OrganizationGuid && existing.SpaceGuid == instance.SpaceGuid &&
+		existing.Parameters == instance.Parameters
+}
+
+// inProgress tells whether an operation of the service instance is in progress. Any
+// operation matches if the operation is empty.
+func inProgress(instance *state.ServiceInstance, operation string) bool {
+	op := instance.GetLastOperation()
+	return op != nil && op.State == stateInProgress && (operation == "" || op.Operation == operation)
+}
+
+func acceptsIncomplete(r *http.Request) bool {
+	return r.URL.Query().Get("accepts_incomplete") == "true"
+}
+
+// encodeJSON encodes an optional JSON object of a request as a string.
+func encodeJSON(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	// Maps are encoded with sorted keys, so that identical objects have identical encodings.
+	data, err := json.Marshal(v)
+	return string(data), err
+}
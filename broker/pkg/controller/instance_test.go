@@ -0,0 +1,135 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	"istio.io/istio/broker/pkg/model/osb"
+)
+
+const (
+	instanceURL      = "/v2/service_instances/instance-1"
+	deprovisionQuery = "?service_id=" + testServiceID + "&plan_id=" + testPlanID
+)
+
+func provisionRequest() *osb.ServiceInstance {
+	return &osb.ServiceInstance{
+		ServiceID:  testServiceID,
+		PlanID:     testPlanID,
+		Parameters: map[string]interface{}{"tier": "gold"},
+	}
+}
+
+func TestProvision(t *testing.T) {
+	r, _ := newCatalogTestStore(t)
+
+	if code := r.serve(t, "PUT", instanceURL, provisionRequest(), nil); code != http.StatusCreated {
+		t.Fatalf("Provision => got status %d, want %d", code, http.StatusCreated)
+	}
+	instance, exists := r.mockStore.instances["instance-1"]
+	if !exists || instance.ServiceId != testServiceID || instance.PlanId != testPlanID ||
+		instance.Parameters != `{"tier":"gold"}` || instance.LastOperation.State != stateSucceeded {
+		t.Errorf("Unexpected service instance %v", instance)
+	}
+
+	// Identical requests succeed, conflicting ones do not.
+	if code := r.serve(t, "PUT", instanceURL, provisionRequest(), nil); code != http.StatusOK {
+		t.Errorf("Identical provision => got status %d, want %d", code, http.StatusOK)
+	}
+	conflicting := provisionRequest()
+	conflicting.Parameters = map[string]interface{}{"tier": "silver"}
+	if code := r.serve(t, "PUT", instanceURL, conflicting, nil); code != http.StatusConflict {
+		t.Errorf("Conflicting provision => got status %d, want %d", code, http.StatusConflict)
+	}
+
+	if code := r.serve(t, "DELETE", instanceURL+deprovisionQuery, nil, nil); code != http.StatusOK {
+		t.Errorf("Deprovision => got status %d, want %d", code, http.StatusOK)
+	}
+	if _, exists = r.mockStore.instances["instance-1"]; exists {
+		t.Error("The service instance should be deleted")
+	}
+	if code := r.serve(t, "DELETE", instanceURL+deprovisionQuery, nil, nil); code != http.StatusGone {
+		t.Errorf("Deprovision => got status %d, want %d", code, http.StatusGone)
+	}
+}
+
+func TestProvisionInvalidRequests(t *testing.T) {
+	r, _ := newCatalogTestStore(t)
+
+	cases := []struct {
+		name string
+		url  string
+		req  *osb.ServiceInstance
+	}{
+		{"unknown service", instanceURL, &osb.ServiceInstance{ServiceID: "unknown", PlanID: testPlanID}},
+		{"unknown plan", instanceURL, &osb.ServiceInstance{ServiceID: testServiceID, PlanID: "unknown"}},
+		{"invalid id", "/v2/service_instances/not_a_guid", provisionRequest()},
+	}
+	for _, c := range cases {
+		var resp osb.ErrorResponse
+		if code := r.serve(t, "PUT", c.url, c.req, &resp); code != http.StatusBadRequest || resp.Description == "" {
+			t.Errorf("%s => got status %d and response %+v, want status %d", c.name, code, resp, http.StatusBadRequest)
+		}
+	}
+	if len(r.mockStore.instances) != 0 {
+		t.Errorf("Unexpected service instances %v", r.mockStore.instances)
+	}
+}
+
+func TestAsyncProvision(t *testing.T) {
+	r, pending := newCatalogTestStore(t)
+
+	var created osb.CreateServiceInstanceResponse
+	code := r.serve(t, "PUT", instanceURL+"?accepts_incomplete=true", provisionRequest(), &created)
+	if code != http.StatusAccepted || created.Operation != operationProvision {
+		t.Fatalf("Provision => got status %d and response %+v", code, created)
+	}
+
+	var op osb.LastOperation
+	lastOperationURL := instanceURL + "/last_operation?operation=" + operationProvision
+	if code = r.serve(t, "GET", lastOperationURL, nil, &op); code != http.StatusOK || op.State != stateInProgress {
+		t.Errorf("LastOperation => got status %d and response %+v", code, op)
+	}
+	code = r.serve(t, "DELETE", instanceURL+deprovisionQuery+"&accepts_incomplete=true", nil, nil)
+	if code != http.StatusUnprocessableEntity {
+		t.Errorf("Deprovision during provision => got status %d, want %d", code, http.StatusUnprocessableEntity)
+	}
+
+	for _, f := range *pending {
+		f()
+	}
+	*pending = nil
+	if code = r.serve(t, "GET", lastOperationURL, nil, &op); code != http.StatusOK || op.State != stateSucceeded {
+		t.Errorf("LastOperation => got status %d and response %+v", code, op)
+	}
+
+	var deleted osb.DeleteServiceInstanceResponse
+	code = r.serve(t, "DELETE", instanceURL+deprovisionQuery+"&accepts_incomplete=true", nil, &deleted)
+	if code != http.StatusAccepted || deleted.Operation != operationDeprovision {
+		t.Fatalf("Deprovision => got status %d and response %+v", code, deleted)
+	}
+	lastOperationURL = instanceURL + "/last_operation?operation=" + operationDeprovision
+	if code = r.serve(t, "GET", lastOperationURL, nil, &op); code != http.StatusOK || op.State != stateInProgress {
+		t.Errorf("LastOperation => got status %d and response %+v", code, op)
+	}
+	for _, f := range *pending {
+		f()
+	}
+	if code = r.serve(t, "GET", lastOperationURL, nil, nil); code != http.StatusGone {
+		t.Errorf("LastOperation => got status %d, want %d", code, http.StatusGone)
+	}
+}
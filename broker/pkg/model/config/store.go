@@ -15,11 +15,16 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+
 	// TODO(nmittler): Remove this
 	_ "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 
 	brokerconfig "istio.io/api/broker/v1/config"
+	"istio.io/istio/broker/pkg/model/config/state"
 	"istio.io/istio/pkg/log"
 )
 
@@ -100,6 +105,27 @@ type BrokerConfigStore interface {
 
 	// ServicePlansByService lists all service plans contains the specified service class
 	ServicePlansByService(service string) map[string]*brokerconfig.ServicePlan
+
+	// ServiceInstance retrieves a service instance by its OSB instance ID.
+	ServiceInstance(id string) (*state.ServiceInstance, bool)
+
+	// PutServiceInstance creates or updates a service instance.
+	PutServiceInstance(instance *state.ServiceInstance) error
+
+	// DeleteServiceInstance removes a service instance by its OSB instance ID.
+	DeleteServiceInstance(id string) error
+
+	// ServiceBinding retrieves a service binding by its OSB binding ID.
+	ServiceBinding(id string) (*state.ServiceBinding, bool)
+
+	// ServiceBindingsByInstance lists all service bindings of the specified service instance.
+	ServiceBindingsByInstance(instanceID string) map[string]*state.ServiceBinding
+
+	// PutServiceBinding creates or updates a service binding.
+	PutServiceBinding(binding *state.ServiceBinding) error
+
+	// DeleteServiceBinding removes a service binding by its OSB binding ID.
+	DeleteServiceBinding(id string) error
 }
 
 const (
@@ -125,22 +151,68 @@ var (
 		MessageName: "istio.broker.v1.config.ServicePlan",
 	}
 
+	// ServiceInstance describes service instance provisioned through the broker
+	ServiceInstance = Schema{
+		Type:        "service-instance",
+		Plural:      "service-instances",
+		MessageName: "istio.broker.v1.state.ServiceInstance",
+		AdditionalValidate: func(msg proto.Message) error {
+			return validateID(msg.(*state.ServiceInstance).InstanceId)
+		},
+	}
+
+	// ServiceBinding describes service binding created through the broker
+	ServiceBinding = Schema{
+		Type:        "service-binding",
+		Plural:      "service-bindings",
+		MessageName: "istio.broker.v1.state.ServiceBinding",
+		AdditionalValidate: func(msg proto.Message) error {
+			b := msg.(*state.ServiceBinding)
+			if err := validateID(b.BindingId); err != nil {
+				return err
+			}
+			return validateID(b.InstanceId)
+		},
+	}
+
 	// BrokerConfigTypes lists all types with schemas and validation
 	BrokerConfigTypes = Descriptor{
 		ServiceClass,
 		ServicePlan,
+		ServiceInstance,
+		ServiceBinding,
 	}
 )
 
+// entryName returns the name of the entry storing the resource with an OSB ID.
+// OSB IDs are usually GUIDs, which are valid names once lowercased.
+func entryName(id string) string {
+	return strings.ToLower(id)
+}
+
+func validateID(id string) error {
+	if id == "" {
+		return errors.New("empty ID")
+	}
+	if !isDNS1123Label(entryName(id)) {
+		return fmt.Errorf("invalid ID %q: must be a GUID or a DNS-1123 label", id)
+	}
+	return nil
+}
+
 // brokerConfigStore provides a simple adapter for Broker configuration types
 // from the generic config registry
 type brokerConfigStore struct {
 	Store
+
+	// namespace holds the service instances and bindings
+	namespace string
 }
 
-// MakeBrokerConfigStore creates a wrapper around a store
-func MakeBrokerConfigStore(store Store) BrokerConfigStore {
-	return &brokerConfigStore{store}
+// MakeBrokerConfigStore creates a wrapper around a store. Service instances and
+// bindings are stored in the namespace.
+func MakeBrokerConfigStore(store Store, namespace string) BrokerConfigStore {
+	return &brokerConfigStore{store, namespace}
 }
 
 func (i brokerConfigStore) ServiceClasses() map[string]*brokerconfig.ServiceClass {
@@ -187,3 +259,71 @@ func (i brokerConfigStore) ServicePlansByService(service string) map[string]*bro
 
 	return out
 }
+
+func (i brokerConfigStore) ServiceInstance(id string) (*state.ServiceInstance, bool) {
+	entry, exists := i.Get(ServiceInstance.Type, entryName(id), i.namespace)
+	if !exists {
+		return nil, false
+	}
+	instance, ok := entry.Spec.(*state.ServiceInstance)
+	return instance, ok
+}
+
+func (i brokerConfigStore) PutServiceInstance(instance *state.ServiceInstance) error {
+	return i.put(ServiceInstance.Type, instance.InstanceId, instance)
+}
+
+func (i brokerConfigStore) DeleteServiceInstance(id string) error {
+	return i.Delete(ServiceInstance.Type, entryName(id), i.namespace)
+}
+
+func (i brokerConfigStore) ServiceBinding(id string) (*state.ServiceBinding, bool) {
+	entry, exists := i.Get(ServiceBinding.Type, entryName(id), i.namespace)
+	if !exists {
+		return nil, false
+	}
+	binding, ok := entry.Spec.(*state.ServiceBinding)
+	return binding, ok
+}
+
+func (i brokerConfigStore) ServiceBindingsByInstance(instanceID string) map[string]*state.ServiceBinding {
+	out := make(map[string]*state.ServiceBinding)
+	rs, err := i.List(ServiceBinding.Type, i.namespace)
+	if err != nil {
+		log.Infof("ServiceBindings => %v", err)
+		return out
+	}
+	for _, r := range rs {
+		if b, ok := r.Spec.(*state.ServiceBinding); ok && b.InstanceId == instanceID {
+			out[r.Key()] = b
+		}
+	}
+	return out
+}
+
+func (i brokerConfigStore) PutServiceBinding(binding *state.ServiceBinding) error {
+	return i.put(ServiceBinding.Type, binding.BindingId, binding)
+}
+
+func (i brokerConfigStore) DeleteServiceBinding(id string) error {
+	return i.Delete(ServiceBinding.Type, entryName(id), i.namespace)
+}
+
+// put creates the entry, or updates it if it already exists.
+func (i brokerConfigStore) put(typ, id string, spec proto.Message) error {
+	entry := Entry{
+		Meta: Meta{
+			Type:      typ,
+			Name:      entryName(id),
+			Namespace: i.namespace,
+		},
+		Spec: spec,
+	}
+	if old, exists := i.Get(typ, entry.Name, entry.Namespace); exists {
+		entry.ResourceVersion = old.ResourceVersion
+		_, err := i.Update(entry)
+		return err
+	}
+	_, err := i.Create(entry)
+	return err
+}
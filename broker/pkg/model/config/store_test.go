@@ -22,6 +22,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 
 	brokerconfig "istio.io/api/broker/v1/config"
+	"istio.io/istio/broker/pkg/model/config/state"
 )
 
 type testStore struct {
@@ -39,11 +40,53 @@ func (m mockStore) List(typ, namespace string) ([]Entry, error) {
 	return m.entries, m.err
 }
 
+func (m mockStore) Get(typ, name, namespace string) (*Entry, bool) {
+	for _, e := range m.entries {
+		if e.Type == typ && e.Name == name && e.Namespace == namespace {
+			return &e, true
+		}
+	}
+	return nil, false
+}
+
+func (m *mockStore) Create(entry Entry) (string, error) {
+	if _, exists := m.Get(entry.Type, entry.Name, entry.Namespace); exists {
+		return "", errors.New("already exists")
+	}
+	entry.ResourceVersion = "1"
+	m.entries = append(m.entries, entry)
+	return entry.ResourceVersion, nil
+}
+
+func (m *mockStore) Update(entry Entry) (string, error) {
+	for i, e := range m.entries {
+		if e.Key() == entry.Key() {
+			if e.ResourceVersion != entry.ResourceVersion {
+				return "", errors.New("conflict")
+			}
+			entry.ResourceVersion += "1"
+			m.entries[i] = entry
+			return entry.ResourceVersion, nil
+		}
+	}
+	return "", errors.New("not found")
+}
+
+func (m *mockStore) Delete(typ, name, namespace string) error {
+	for i, e := range m.entries {
+		if e.Key() == Key(typ, name, namespace) {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
 func newTestStore() *testStore {
 	ms := &mockStore{}
 	return &testStore{
 		mock:  ms,
-		store: MakeBrokerConfigStore(ms),
+		store: MakeBrokerConfigStore(ms, "istio-system"),
 	}
 }
 
@@ -202,3 +245,67 @@ func TestServicePlansByService(t *testing.T) {
 		}
 	}
 }
+
+func TestServiceInstancesAndBindings(t *testing.T) {
+	r := newTestStore()
+
+	instance := &state.ServiceInstance{
+		InstanceId: "6F2A41A4-3C07-4E5A-A4A6-9B5BBC0F5A6C",
+		ServiceId:  "4395a443-f49a-41b0-8d14-d17294cf612f",
+		PlanId:     "cdd76b03-a28b-4638-b4e2-19ee44b36db7",
+	}
+	if err := r.store.PutServiceInstance(instance); err != nil {
+		t.Fatal(err)
+	}
+	instance.LastOperation = &state.LastOperation{Operation: "provision", State: "succeeded"}
+	if err := r.store.PutServiceInstance(instance); err != nil {
+		t.Fatal(err)
+	}
+	if got, exists := r.store.ServiceInstance(instance.InstanceId); !exists || !reflect.DeepEqual(got, instance) {
+		t.Errorf("ServiceInstance() => got %v, want %v", spew.Sdump(got), spew.Sdump(instance))
+	}
+	if len(r.mock.entries) != 1 || r.mock.entries[0].Name != "6f2a41a4-3c07-4e5a-a4a6-9b5bbc0f5a6c" ||
+		r.mock.entries[0].Namespace != "istio-system" {
+		t.Errorf("Unexpected entries %v", spew.Sdump(r.mock.entries))
+	}
+
+	bindings := []*state.ServiceBinding{
+		{BindingId: "binding-1", InstanceId: instance.InstanceId},
+		{BindingId: "binding-2", InstanceId: "other-instance"},
+	}
+	for _, b := range bindings {
+		if err := r.store.PutServiceBinding(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := map[string]*state.ServiceBinding{"service-binding/istio-system/binding-1": bindings[0]}
+	if got := r.store.ServiceBindingsByInstance(instance.InstanceId); !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceBindingsByInstance() => got %v, want %v", spew.Sdump(got), spew.Sdump(want))
+	}
+
+	if err := r.store.DeleteServiceBinding("binding-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := r.store.ServiceBinding("binding-1"); exists {
+		t.Error("The service binding should be deleted")
+	}
+	if err := r.store.DeleteServiceInstance(instance.InstanceId); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := r.store.ServiceInstance(instance.InstanceId); exists {
+		t.Error("The service instance should be deleted")
+	}
+}
+
+func TestValidateID(t *testing.T) {
+	for _, id := range []string{"6F2A41A4-3C07-4E5A-A4A6-9B5BBC0F5A6C", "my-instance"} {
+		if err := ServiceInstance.Validate(&state.ServiceInstance{InstanceId: id}); err != nil {
+			t.Errorf("Validate(%q) => unexpected error %v", id, err)
+		}
+	}
+	for _, id := range []string{"", "my_instance", "-instance"} {
+		if err := ServiceInstance.Validate(&state.ServiceInstance{InstanceId: id}); err == nil {
+			t.Errorf("Validate(%q) => expected an error", id)
+		}
+	}
+}
@@ -43,5 +43,7 @@ func NewService(sp *brokerconfig.ServiceClass) *Service {
 	s.Name = cs.GetName()
 	s.ID = cs.GetId()
 	s.Description = cs.GetDescription()
+	// Bindings return the mesh address of the service deployment.
+	s.Bindable = true
 	return s
 }
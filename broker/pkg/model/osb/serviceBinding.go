@@ -14,25 +14,28 @@
 
 package osb
 
-// ServiceBinding defines OSB service binding data structure.
+// ServiceBinding defines OSB service binding data structure. It is the body of
+// bind requests, the IDs being taken from the request path.
 type ServiceBinding struct {
-	ID                string `json:"id"`
+	ID                string `json:"-"`
+	ServiceInstanceID string `json:"-"`
 	ServiceID         string `json:"service_id"`
-	AppID             string `json:"app_id"`
-	ServicePlanID     string `json:"service_plan_id"`
-	PrivateKey        string `json:"private_key"`
-	ServiceInstanceID string `json:"service_instance_id"`
+	ServicePlanID     string `json:"plan_id"`
+	// AppID is deprecated in favor of BindResource.AppGUID.
+	AppID        string        `json:"app_guid,omitempty"`
+	BindResource *BindResource `json:"bind_resource,omitempty"`
+
+	Context    interface{} `json:"context,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// BindResource defines OSB bind resource data structure.
+type BindResource struct {
+	AppGUID string `json:"app_guid,omitempty"`
+	Route   string `json:"route,omitempty"`
 }
 
 // CreateServiceBindingResponse defines OSB service binding response data structure.
 type CreateServiceBindingResponse struct {
-	// SyslogDrainUrl string      `json:"syslog_drain_url, omitempty"`
 	Credentials interface{} `json:"credentials"`
 }
-
-// Credential defines OSB credential data structure.
-type Credential struct {
-	PublicIP   string `json:"public_ip"`
-	UserName   string `json:"username"`
-	PrivateKey string `json:"private_key"`
-}
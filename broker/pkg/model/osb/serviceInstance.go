@@ -14,30 +14,32 @@
 
 package osb
 
-// ServiceInstance defines OSB service instance data structure.
+// ServiceInstance defines OSB service instance data structure. It is the body of
+// provision requests, the ID being taken from the request path.
 type ServiceInstance struct {
-	ID               string `json:"id"`
-	DashboardURL     string `json:"dashboard_url"`
-	InternalID       string `json:"internalId, omitempty"`
+	ID               string `json:"-"`
 	ServiceID        string `json:"service_id"`
 	PlanID           string `json:"plan_id"`
-	OrganizationGUID string `json:"organization_guid"`
-	SpaceGUID        string `json:"space_guid"`
+	OrganizationGUID string `json:"organization_guid,omitempty"`
+	SpaceGUID        string `json:"space_guid,omitempty"`
 
-	LastOperation *LastOperation `json:"last_operation, omitempty"`
-
-	Parameters interface{} `json:"parameters, omitempty"`
+	Context    interface{} `json:"context,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
 }
 
 // LastOperation defines OSB last operation data structure.
 type LastOperation struct {
-	State                    string `json:"state"`
-	Description              string `json:"description"`
-	AsyncPollIntervalSeconds int    `json:"async_poll_interval_seconds, omitempty"`
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
 }
 
 // CreateServiceInstanceResponse defines OSB service instance response data structure.
 type CreateServiceInstanceResponse struct {
-	DashboardURL  string         `json:"dashboard_url"`
-	LastOperation *LastOperation `json:"last_operation, omitempty"`
+	DashboardURL string `json:"dashboard_url,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+}
+
+// DeleteServiceInstanceResponse defines OSB service instance deletion response data structure.
+type DeleteServiceInstanceResponse struct {
+	Operation string `json:"operation,omitempty"`
 }
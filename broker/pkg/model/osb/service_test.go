@@ -53,6 +53,7 @@ func TestNewService(t *testing.T) {
 		Name:        sp.GetEntry().GetName(),
 		ID:          sp.GetEntry().GetId(),
 		Description: sp.GetEntry().GetDescription(),
+		Bindable:    true,
 	}
 
 	if !reflect.DeepEqual(got, want) {
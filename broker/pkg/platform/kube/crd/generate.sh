@@ -62,7 +62,7 @@ var knownTypes = map[string]struct {
 }{
 EOF
 
-CRDS="ServiceClass ServicePlan"
+CRDS="ServiceClass ServicePlan ServiceInstance ServiceBinding"
 
 for crd in $CRDS; do
 cat << EOF
@@ -53,6 +53,24 @@ var knownTypes = map[string]struct {
 		},
 		collection: &ServicePlanList{},
 	},
+	config.ServiceInstance.Type: {
+		object: &ServiceInstance{
+			TypeMeta: meta_v1.TypeMeta{
+				Kind:       "ServiceInstance",
+				APIVersion: config.IstioAPIVersion,
+			},
+		},
+		collection: &ServiceInstanceList{},
+	},
+	config.ServiceBinding.Type: {
+		object: &ServiceBinding{
+			TypeMeta: meta_v1.TypeMeta{
+				Kind:       "ServiceBinding",
+				APIVersion: config.IstioAPIVersion,
+			},
+		},
+		collection: &ServiceBindingList{},
+	},
 	mock.FakeConfig.Type: {
 		object: &FakeConfig{
 			TypeMeta: meta_v1.TypeMeta{
@@ -254,6 +272,196 @@ func (in *ServicePlanList) DeepCopyObject() runtime.Object {
 	return in.DeepCopy()
 }
 
+// ServiceInstance is the generic Kubernetes API object wrapper
+type ServiceInstance struct {
+	meta_v1.TypeMeta   `json:",inline"`
+	meta_v1.ObjectMeta `json:"metadata"`
+	Spec               map[string]interface{} `json:"spec"`
+}
+
+// GetSpec from a wrapper
+func (in *ServiceInstance) GetSpec() map[string]interface{} {
+	return in.Spec
+}
+
+// SetSpec for a wrapper
+func (in *ServiceInstance) SetSpec(spec map[string]interface{}) {
+	in.Spec = spec
+}
+
+// GetObjectMeta from a wrapper
+func (in *ServiceInstance) GetObjectMeta() meta_v1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+// SetObjectMeta for a wrapper
+func (in *ServiceInstance) SetObjectMeta(metadata meta_v1.ObjectMeta) {
+	in.ObjectMeta = metadata
+}
+
+// ServiceInstanceList is the generic Kubernetes API list wrapper
+type ServiceInstanceList struct {
+	meta_v1.TypeMeta `json:",inline"`
+	meta_v1.ListMeta `json:"metadata"`
+	Items            []*ServiceInstance `json:"items"`
+}
+
+// GetItems from a wrapper
+func (in *ServiceInstanceList) GetItems() []IstioObject {
+	out := make([]IstioObject, len(in.Items))
+	for i, v := range in.Items {
+		out[i] = v
+	}
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstance.
+func (in *ServiceInstance) DeepCopy() *ServiceInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceInstance) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceList) DeepCopyInto(out *ServiceInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*ServiceInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto((*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceList.
+func (in *ServiceInstanceList) DeepCopy() *ServiceInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
+// ServiceBinding is the generic Kubernetes API object wrapper
+type ServiceBinding struct {
+	meta_v1.TypeMeta   `json:",inline"`
+	meta_v1.ObjectMeta `json:"metadata"`
+	Spec               map[string]interface{} `json:"spec"`
+}
+
+// GetSpec from a wrapper
+func (in *ServiceBinding) GetSpec() map[string]interface{} {
+	return in.Spec
+}
+
+// SetSpec for a wrapper
+func (in *ServiceBinding) SetSpec(spec map[string]interface{}) {
+	in.Spec = spec
+}
+
+// GetObjectMeta from a wrapper
+func (in *ServiceBinding) GetObjectMeta() meta_v1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+// SetObjectMeta for a wrapper
+func (in *ServiceBinding) SetObjectMeta(metadata meta_v1.ObjectMeta) {
+	in.ObjectMeta = metadata
+}
+
+// ServiceBindingList is the generic Kubernetes API list wrapper
+type ServiceBindingList struct {
+	meta_v1.TypeMeta `json:",inline"`
+	meta_v1.ListMeta `json:"metadata"`
+	Items            []*ServiceBinding `json:"items"`
+}
+
+// GetItems from a wrapper
+func (in *ServiceBindingList) GetItems() []IstioObject {
+	out := make([]IstioObject, len(in.Items))
+	for i, v := range in.Items {
+		out[i] = v
+	}
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBinding.
+func (in *ServiceBinding) DeepCopy() *ServiceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBinding) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingList) DeepCopyInto(out *ServiceBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*ServiceBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto((*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingList.
+func (in *ServiceBindingList) DeepCopy() *ServiceBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceBindingList) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
 // FakeConfig is the generic Kubernetes API object wrapper
 type FakeConfig struct {
 	meta_v1.TypeMeta   `json:",inline"`
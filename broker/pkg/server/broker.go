@@ -33,13 +33,13 @@ type Server struct {
 	ctr *controller.Controller
 }
 
-// CreateServer creates a broker server.
-func CreateServer(kubeconfig string) (*Server, error) {
+// CreateServer creates a broker server. The service instances and bindings are stored in the namespace.
+func CreateServer(kubeconfig, namespace string) (*Server, error) {
 	cc, err := crd.NewClient(kubeconfig, config.BrokerConfigTypes)
 	if err != nil {
 		return nil, err
 	}
-	c, err := controller.CreateController(config.MakeBrokerConfigStore(cc))
+	c, err := controller.CreateController(config.MakeBrokerConfigStore(cc, namespace))
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +54,14 @@ func (s *Server) Start(port uint16) {
 	router := mux.NewRouter()
 
 	router.HandleFunc("/v2/catalog", s.ctr.Catalog).Methods("GET")
+	router.HandleFunc("/v2/service_instances/{instance_id}", s.ctr.Provision).Methods("PUT")
+	router.HandleFunc("/v2/service_instances/{instance_id}", s.ctr.Deprovision).Methods("DELETE")
+	router.HandleFunc("/v2/service_instances/{instance_id}/last_operation", s.ctr.LastOperation).Methods("GET")
+	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", s.ctr.Bind).Methods("PUT")
+	router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", s.ctr.Unbind).
+		Methods("DELETE")
 
-	http.Handle("/", router)
+	http.Handle("/", controller.CheckAPIVersion(router))
 
 	if err := http.ListenAndServe(fmt.Sprintf(":%d", port), nil); err != nil {
 		log.Errorf("Unable to start server: %v", err)
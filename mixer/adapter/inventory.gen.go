@@ -27,10 +27,13 @@ import (
 	opa "istio.io/istio/mixer/adapter/opa"
 	prometheus "istio.io/istio/mixer/adapter/prometheus"
 	rbac "istio.io/istio/mixer/adapter/rbac"
+	redisquota "istio.io/istio/mixer/adapter/redisquota"
+	remote "istio.io/istio/mixer/adapter/remote"
 	servicecontrol "istio.io/istio/mixer/adapter/servicecontrol"
 	stackdriver "istio.io/istio/mixer/adapter/stackdriver"
 	statsd "istio.io/istio/mixer/adapter/statsd"
 	stdio "istio.io/istio/mixer/adapter/stdio"
+	tracing "istio.io/istio/mixer/adapter/tracing"
 	adptr "istio.io/istio/mixer/pkg/adapter"
 )
 
@@ -47,9 +50,12 @@ func Inventory() []adptr.InfoFn {
 		opa.GetInfo,
 		prometheus.GetInfo,
 		rbac.GetInfo,
+		redisquota.GetInfo,
+		remote.GetInfo,
 		servicecontrol.GetInfo,
 		stackdriver.GetInfo,
 		statsd.GetInfo,
 		stdio.GetInfo,
+		tracing.GetInfo,
 	}
 }
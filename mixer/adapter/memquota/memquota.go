@@ -35,6 +35,7 @@ import (
 
 	"istio.io/istio/mixer/adapter/memquota/config"
 	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/adapter/quotautil"
 	"istio.io/istio/mixer/pkg/status"
 	"istio.io/istio/mixer/template/quota"
 )
@@ -56,52 +57,15 @@ type handler struct {
 	logger adapter.Logger
 }
 
-// Limit is implemented by Quota and Override messages.
-type Limit interface {
-	GetMaxAmount() int64
-	GetValidDuration() time.Duration
-}
+// overrides adapts the configured overrides of a quota to quotautil.Overrides.
+type overrides []config.Params_Override
 
-// matchDimensions matches configured dimensions with dimensions of the instance.
-func matchDimensions(cfg map[string]string, inst map[string]interface{}) bool {
-	for k, val := range cfg {
-		rval := inst[k]
-		if rval == val { // this dimension matches, on to next comparison.
-			continue
-		}
-
-		// if rval has a string representation then compare it with val
-		// For example net.ip has a useful string representation.
-		switch v := rval.(type) {
-		case fmt.Stringer:
-			if v.String() == val {
-				continue
-			}
-		}
-		// rval does not match val.
-		return false
-	}
-	return true
-}
+func (o overrides) Len() int                    { return len(o) }
+func (o overrides) At(i int) quotautil.Override { return &o[i] }
 
 // limit returns the limit associated with this particular request.
-// Check if the instance matches an override, else return the default limit.
-func limit(cfg *config.Params_Quota, instance *quota.Instance, l adapter.Logger) Limit {
-	for idx := range cfg.Overrides {
-		o := cfg.Overrides[idx]
-		if matchDimensions(o.Dimensions, instance.Dimensions) {
-			if l.VerbosityLevel(4) {
-				l.Infof("quota override: %v selected for %v", o, *instance)
-			}
-			// all dimensions matched, we found the override.
-			return &o
-		}
-	}
-	if l.VerbosityLevel(4) {
-		l.Infof("quota default: %v selected for %v", cfg.MaxAmount, *instance)
-	}
-	// no overrides, use default limit.
-	return cfg
+func limit(cfg *config.Params_Quota, instance *quota.Instance, l adapter.Logger) quotautil.Limit {
+	return quotautil.SelectLimit(cfg, overrides(cfg.Overrides), instance, l)
 }
 
 func (h *handler) HandleQuota(context context.Context, instance *quota.Instance, args adapter.QuotaArgs) (adapter.QuotaResult, error) {
@@ -115,7 +79,7 @@ func (h *handler) HandleQuota(context context.Context, instance *quota.Instance,
 	return adapter.QuotaResult{}, nil
 }
 
-func (h *handler) alloc(instance *quota.Instance, args adapter.QuotaArgs, q Limit) (adapter.QuotaResult, error) {
+func (h *handler) alloc(instance *quota.Instance, args adapter.QuotaArgs, q quotautil.Limit) (adapter.QuotaResult, error) {
 	amount, exp, key, err := h.common.handleDedup(instance, args, func(key string, currentTime time.Time, currentTick int64) (int64, time.Time,
 		time.Duration) {
 		result := args.QuotaAmount
@@ -167,7 +131,7 @@ func (h *handler) alloc(instance *quota.Instance, args adapter.QuotaArgs, q Limi
 	}, err
 }
 
-func (h *handler) free(instance *quota.Instance, args adapter.QuotaArgs, q Limit) (adapter.QuotaResult, error) {
+func (h *handler) free(instance *quota.Instance, args adapter.QuotaArgs, q quotautil.Limit) (adapter.QuotaResult, error) {
 	amount, _, _, err := h.common.handleDedup(instance, args, func(key string, currentTime time.Time, currentTick int64) (int64, time.Time,
 		time.Duration) {
 		result := args.QuotaAmount
@@ -0,0 +1,312 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate $GOPATH/src/istio.io/istio/bin/mixer_codegen.sh -f mixer/adapter/redisquota/config/config.proto
+
+// Package redisquota provides a quota implementation backed by a Redis server.
+// Unlike memquota, the quota state is shared by all Mixer instances that use
+// the same Redis server, so limits hold across a replicated Mixer deployment.
+//
+// Rate limit quotas are enforced with either a fixed or a rolling window
+// algorithm. Each quota operation is a single Lua script run atomically on the
+// Redis server, including the deduplication of retried operations.
+package redisquota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-redis/redis"
+
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+	"istio.io/istio/mixer/adapter/redisquota/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/adapter/quotautil"
+	"istio.io/istio/mixer/pkg/status"
+	"istio.io/istio/mixer/template/quota"
+)
+
+type (
+	handler struct {
+		// client of the Redis server, safe for concurrent use
+		client *redis.Client
+
+		// the limits we know about
+		limits map[string]*config.Params_Quota
+
+		// lifetime of deduplication state
+		dedupDuration time.Duration
+
+		// whether quota is granted when Redis is unavailable
+		failOpen bool
+
+		// indirection to support deterministic tests
+		getTime func() time.Time
+
+		// logger provided by the framework
+		logger adapter.Logger
+	}
+
+	builder struct {
+		adapterConfig *config.Params
+		quotaTypes    map[string]*quota.Type
+	}
+)
+
+var (
+	fixedWindowAllocScript   = redis.NewScript(fixedWindowAlloc)
+	rollingWindowAllocScript = redis.NewScript(rollingWindowAlloc)
+	counterReleaseScript     = redis.NewScript(counterRelease)
+)
+
+// ensure our types implement the requisite interfaces
+var _ quota.HandlerBuilder = &builder{}
+var _ quota.Handler = &handler{}
+
+// overrides adapts the configured overrides of a quota to quotautil.Overrides.
+type overrides []config.Params_Override
+
+func (o overrides) Len() int                    { return len(o) }
+func (o overrides) At(i int) quotautil.Override { return &o[i] }
+
+// limit returns the limit associated with this particular request.
+func limit(cfg *config.Params_Quota, instance *quota.Instance, l adapter.Logger) quotautil.Limit {
+	return quotautil.SelectLimit(cfg, overrides(cfg.Overrides), instance, l)
+}
+
+// makeKey produces the Redis key holding the state of the quota cell for the given dimensions.
+func makeKey(name string, dimensions map[string]interface{}) string {
+	keys := make([]string, 0, len(dimensions))
+	for k := range dimensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	key := "quota;" + name
+	for _, k := range keys {
+		key += fmt.Sprintf(";%s=%v", k, dimensions[k])
+	}
+	return key
+}
+
+func (h *handler) HandleQuota(context context.Context, instance *quota.Instance, args adapter.QuotaArgs) (adapter.QuotaResult, error) {
+	cfg, found := h.limits[instance.Name]
+	if !found {
+		return adapter.QuotaResult{}, fmt.Errorf("no limit defined for quota %s", instance.Name)
+	}
+	q := limit(cfg, instance, h.logger)
+
+	var script *redis.Script
+	switch {
+	case args.QuotaAmount > 0 && q.GetValidDuration() > 0 && cfg.RateLimitAlgorithm == config.ROLLING_WINDOW:
+		script = rollingWindowAllocScript
+	case args.QuotaAmount > 0:
+		script = fixedWindowAllocScript
+	case args.QuotaAmount < 0 && cfg.RateLimitAlgorithm == config.ROLLING_WINDOW && q.GetValidDuration() > 0:
+		// allocations in a rolling window expire bucket by bucket and cannot be released
+		return adapter.QuotaResult{Status: status.OK}, nil
+	case args.QuotaAmount < 0:
+		args.QuotaAmount = -args.QuotaAmount
+		script = counterReleaseScript
+	default:
+		return adapter.QuotaResult{}, nil
+	}
+
+	key := makeKey(instance.Name, instance.Dimensions)
+	amount, validMillis, err := h.run(script, key, args, q, cfg.BucketDuration)
+	if err != nil {
+		return h.unavailable(key, args, q, err)
+	}
+
+	if h.logger.VerbosityLevel(2) {
+		h.logger.Infof(" AccessLog %d/%d %s", amount, args.QuotaAmount, key)
+	}
+
+	result := adapter.QuotaResult{
+		Status: status.OK,
+		Amount: amount,
+	}
+	if script != counterReleaseScript && q.GetValidDuration() > 0 {
+		result.ValidDuration = q.GetValidDuration()
+		if validMillis >= 0 {
+			result.ValidDuration = time.Duration(validMillis) * time.Millisecond
+		}
+	}
+	return result, nil
+}
+
+// run runs a quota script and returns the amount and the number of milliseconds it is valid for.
+func (h *handler) run(script *redis.Script, key string, args adapter.QuotaArgs, q quotautil.Limit,
+	bucket time.Duration) (int64, int64, error) {
+	best := 0
+	if args.BestEffort {
+		best = 1
+	}
+	// operations without a deduplication ID are never treated as repeated
+	dedupMillis := toMillis(h.dedupDuration)
+	if args.DeduplicationID == "" {
+		dedupMillis = 0
+	}
+	keys := []string{key, "dedup;" + args.DeduplicationID + ";" + key}
+	out, err := script.Run(h.client, keys,
+		q.GetMaxAmount(),
+		toMillis(q.GetValidDuration()),
+		args.QuotaAmount,
+		best,
+		dedupMillis,
+		toMillis(time.Duration(h.getTime().UnixNano())),
+		toMillis(bucket),
+	).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	values, ok := out.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, 0, fmt.Errorf("unexpected result %v from quota script", out)
+	}
+	amount, ok := values[0].(int64)
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected amount %v from quota script", values[0])
+	}
+	valid, ok := values[1].(int64)
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected duration %v from quota script", values[1])
+	}
+	return amount, valid, nil
+}
+
+// unavailable produces the result of an operation that failed to reach Redis. Depending on
+// the configuration, allocations are either granted in full or denied.
+func (h *handler) unavailable(key string, args adapter.QuotaArgs, q quotautil.Limit, err error) (adapter.QuotaResult, error) {
+	if !h.failOpen || args.QuotaAmount < 0 {
+		return adapter.QuotaResult{
+			Status: status.WithMessage(rpc.UNAVAILABLE, fmt.Sprintf("quota server unavailable: %v", err)),
+		}, h.logger.Errorf("unable to run quota operation for %s: %v", key, err)
+	}
+
+	h.logger.Warningf("granting quota for %s, unable to reach quota server: %v", key, err)
+	return adapter.QuotaResult{
+		Status:        status.OK,
+		Amount:        args.QuotaAmount,
+		ValidDuration: q.GetValidDuration(),
+	}, nil
+}
+
+func toMillis(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
+func (h *handler) Close() error {
+	return h.client.Close()
+}
+
+////////////////// Config //////////////////////////
+
+// GetInfo returns the Info associated with this adapter implementation.
+func GetInfo() adapter.Info {
+	return adapter.Info{
+		Name:        "redisquota",
+		Impl:        "istio.io/istio/mixer/adapter/redisquota",
+		Description: "Redis-based quotas shared by Mixer instances",
+		SupportedTemplates: []string{
+			quota.TemplateName,
+		},
+		DefaultConfig: &config.Params{
+			RedisServerUrl:           "localhost:6379",
+			MinDeduplicationDuration: 1 * time.Second,
+		},
+
+		NewBuilder: func() adapter.HandlerBuilder { return &builder{} },
+	}
+}
+
+func (b *builder) SetQuotaTypes(types map[string]*quota.Type) { b.quotaTypes = types }
+func (b *builder) SetAdapterConfig(cfg adapter.Config)        { b.adapterConfig = cfg.(*config.Params) }
+
+func (b *builder) Validate() (ce *adapter.ConfigErrors) {
+	ac := b.adapterConfig
+
+	if ac.RedisServerUrl == "" {
+		ce = ce.Appendf("redisServerUrl", "redis server url must be specified")
+	}
+	if ac.ConnectionPoolSize < 0 {
+		ce = ce.Appendf("connectionPoolSize", "connection pool size of %d is invalid, must be >= 0", ac.ConnectionPoolSize)
+	}
+	if ac.MinDeduplicationDuration <= 0 {
+		ce = ce.Appendf("minDeduplicationDuration", "deduplication window of %v is invalid, must be > 0", ac.MinDeduplicationDuration)
+	}
+
+	for _, q := range ac.Quotas {
+		if q.MaxAmount < 0 {
+			ce = ce.Appendf("quotas", "max amount of %d for quota %s is invalid, must be >= 0", q.MaxAmount, q.Name)
+		}
+		if q.ValidDuration < 0 {
+			ce = ce.Appendf("quotas", "valid duration of %v for quota %s is invalid, must be >= 0", q.ValidDuration, q.Name)
+		}
+		if q.RateLimitAlgorithm == config.ROLLING_WINDOW && q.ValidDuration > 0 &&
+			(q.BucketDuration <= 0 || q.BucketDuration > q.ValidDuration) {
+			ce = ce.Appendf("quotas", "bucket duration of %v for quota %s is invalid, must be > 0 and <= %v",
+				q.BucketDuration, q.Name, q.ValidDuration)
+		}
+		for _, o := range q.Overrides {
+			if o.MaxAmount < 0 {
+				ce = ce.Appendf("quotas", "max amount of %d for override of quota %s is invalid, must be >= 0",
+					o.MaxAmount, q.Name)
+			}
+		}
+	}
+	return
+}
+
+func (b *builder) Build(context context.Context, env adapter.Env) (adapter.Handler, error) {
+	ac := b.adapterConfig
+
+	limits := make(map[string]*config.Params_Quota, len(ac.Quotas))
+	for idx := range ac.Quotas {
+		l := ac.Quotas[idx]
+		limits[l.Name] = &l
+	}
+
+	for k := range b.quotaTypes {
+		if _, ok := limits[k]; !ok {
+			return nil, fmt.Errorf("did not find limit defined for quota %s", k)
+		}
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     ac.RedisServerUrl,
+		PoolSize: int(ac.ConnectionPoolSize),
+	})
+
+	// Scripts are loaded again on first use if this fails, so an unavailable
+	// server does not prevent the handler from being built.
+	for _, script := range []*redis.Script{fixedWindowAllocScript, rollingWindowAllocScript, counterReleaseScript} {
+		if err := script.Load(client).Err(); err != nil {
+			env.Logger().Warningf("unable to load quota script into redis server %s: %v", ac.RedisServerUrl, err)
+			break
+		}
+	}
+
+	return &handler{
+		client:        client,
+		limits:        limits,
+		dedupDuration: ac.MinDeduplicationDuration,
+		failOpen:      ac.FailOpen,
+		getTime:       time.Now,
+		logger:        env.Logger(),
+	}, nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisquota
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+
+	"istio.io/istio/mixer/adapter/redisquota/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/adapter/test"
+	"istio.io/istio/mixer/template/quota"
+)
+
+// newTestHandler builds a handler for the quotas against a local Redis stand-in.
+func newTestHandler(t *testing.T, s *miniredis.Miniredis, failOpen bool, quotas ...config.Params_Quota) *handler {
+	b := GetInfo().NewBuilder().(*builder)
+	b.SetAdapterConfig(&config.Params{
+		Quotas:                   quotas,
+		RedisServerUrl:           s.Addr(),
+		MinDeduplicationDuration: time.Second,
+		FailOpen:                 failOpen,
+	})
+	b.SetQuotaTypes(map[string]*quota.Type{})
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Validate() => %v", err)
+	}
+	h, err := b.Build(context.Background(), test.NewEnv(t))
+	if err != nil {
+		t.Fatalf("Build() => %v", err)
+	}
+	return h.(*handler)
+}
+
+func newRedis(t *testing.T) *miniredis.Miniredis {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("unable to start redis stand-in: %v", err)
+	}
+	return s
+}
+
+type allocation struct {
+	dedup  string
+	amount int64
+	best   bool
+	want   int64
+}
+
+func runAllocations(t *testing.T, h *handler, instance *quota.Instance, allocs []allocation) {
+	for i, a := range allocs {
+		args := adapter.QuotaArgs{DeduplicationID: a.dedup, QuotaAmount: a.amount, BestEffort: a.best}
+		result, err := h.HandleQuota(context.Background(), instance, args)
+		if err != nil {
+			t.Fatalf("%d: HandleQuota(%v) => %v", i, args, err)
+		}
+		if result.Amount != a.want {
+			t.Errorf("%d: HandleQuota(%v) => got amount %d, want %d", i, args, result.Amount, a.want)
+		}
+	}
+}
+
+func TestBasic(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+
+	info := GetInfo()
+	cfg := info.DefaultConfig.(*config.Params)
+	cfg.RedisServerUrl = s.Addr()
+
+	b := info.NewBuilder()
+	b.SetAdapterConfig(cfg)
+	if err := b.Validate(); err != nil {
+		t.Errorf("Got error %v, expecting success", err)
+	}
+
+	handler, err := b.Build(context.Background(), test.NewEnv(t))
+	if err != nil {
+		t.Errorf("Got error %v, expecting success", err)
+	}
+	if err = handler.Close(); err != nil {
+		t.Errorf("Got error %v, expecting success", err)
+	}
+
+	b.(*builder).SetQuotaTypes(map[string]*quota.Type{"unknown": {}})
+	if _, err = b.Build(context.Background(), test.NewEnv(t)); err == nil {
+		t.Error("Expected error for quota without a limit")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		params *config.Params
+		errors int
+	}{
+		{"valid", &config.Params{
+			RedisServerUrl:           "localhost:6379",
+			MinDeduplicationDuration: time.Second,
+			Quotas: []config.Params_Quota{{
+				Name:               "rolling",
+				MaxAmount:          10,
+				ValidDuration:      time.Second,
+				RateLimitAlgorithm: config.ROLLING_WINDOW,
+				BucketDuration:     100 * time.Millisecond,
+			}},
+		}, 0},
+		{"missing", &config.Params{}, 2},
+		{"invalid", &config.Params{
+			RedisServerUrl:           "localhost:6379",
+			ConnectionPoolSize:       -1,
+			MinDeduplicationDuration: time.Second,
+			Quotas: []config.Params_Quota{
+				{Name: "negative", MaxAmount: -1, ValidDuration: -time.Second},
+				{Name: "bucket", MaxAmount: 1, ValidDuration: time.Second, RateLimitAlgorithm: config.ROLLING_WINDOW},
+				{Name: "override", MaxAmount: 1, Overrides: []config.Params_Override{{MaxAmount: -1}}},
+			},
+		}, 5},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := &builder{}
+			b.SetAdapterConfig(c.params)
+			ce := b.Validate()
+			got := 0
+			if ce != nil {
+				got = len(ce.Multi.Errors)
+			}
+			if got != c.errors {
+				t.Errorf("Validate() => %v, want %d errors", ce, c.errors)
+			}
+		})
+	}
+}
+
+func TestFixedWindow(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+	h := newTestHandler(t, s, false, config.Params_Quota{Name: "q", MaxAmount: 10, ValidDuration: time.Minute})
+	defer func() { _ = h.Close() }()
+
+	instance := &quota.Instance{Name: "q", Dimensions: map[string]interface{}{"source": "a"}}
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "1", amount: 6, want: 6},
+		{dedup: "2", amount: 6, want: 0},
+		{dedup: "3", amount: 6, best: true, want: 4},
+		{dedup: "4", amount: 1, best: true, want: 0},
+	})
+
+	result, err := h.HandleQuota(context.Background(), instance, adapter.QuotaArgs{DeduplicationID: "5", QuotaAmount: 1})
+	if err != nil || result.ValidDuration <= 0 || result.ValidDuration > time.Minute {
+		t.Errorf("got result %v, error %v; want valid duration within the window", result, err)
+	}
+
+	// other dimensions are counted separately
+	runAllocations(t, h, &quota.Instance{Name: "q", Dimensions: map[string]interface{}{"source": "b"}}, []allocation{
+		{dedup: "6", amount: 10, want: 10},
+	})
+
+	// the counter is reset at the end of the window
+	s.FastForward(time.Minute)
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "7", amount: 10, want: 10},
+	})
+}
+
+func TestRollingWindow(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+	h := newTestHandler(t, s, false, config.Params_Quota{
+		Name:               "q",
+		MaxAmount:          10,
+		ValidDuration:      time.Second,
+		RateLimitAlgorithm: config.ROLLING_WINDOW,
+		BucketDuration:     100 * time.Millisecond,
+	})
+	defer func() { _ = h.Close() }()
+
+	now := time.Unix(1500000000, 0)
+	h.getTime = func() time.Time { return now }
+	instance := &quota.Instance{Name: "q"}
+
+	runAllocations(t, h, instance, []allocation{{dedup: "1", amount: 6, want: 6}})
+
+	now = now.Add(500 * time.Millisecond)
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "2", amount: 6, want: 0},
+		{dedup: "3", amount: 3, want: 3},
+	})
+
+	// the first allocation has left the window, the second has not
+	now = now.Add(500 * time.Millisecond)
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "4", amount: 8, want: 0},
+		{dedup: "5", amount: 7, want: 7},
+	})
+
+	// rolling window allocations cannot be released
+	runAllocations(t, h, instance, []allocation{{dedup: "6", amount: -5, want: 0}})
+}
+
+func TestDeduplication(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+	h := newTestHandler(t, s, false, config.Params_Quota{Name: "q", MaxAmount: 10})
+	defer func() { _ = h.Close() }()
+
+	instance := &quota.Instance{Name: "q"}
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "1", amount: 6, want: 6},
+		{dedup: "1", amount: 6, want: 6},
+		{dedup: "2", amount: 6, want: 0},
+		{dedup: "3", amount: 4, want: 4},
+	})
+
+	// the result of a repeated operation is forgotten after the deduplication window
+	s.FastForward(time.Second)
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "1", amount: 6, want: 0},
+		{amount: 1, best: true, want: 0},
+	})
+}
+
+func TestRelease(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+	h := newTestHandler(t, s, false, config.Params_Quota{Name: "q", MaxAmount: 10})
+	defer func() { _ = h.Close() }()
+
+	instance := &quota.Instance{Name: "q"}
+	runAllocations(t, h, instance, []allocation{
+		{dedup: "1", amount: 10, want: 10},
+		{dedup: "2", amount: -4, want: 4},
+		{dedup: "2", amount: -4, want: 4},
+		{dedup: "3", amount: 5, want: 0},
+		{dedup: "4", amount: 4, want: 4},
+		{dedup: "5", amount: -20, want: 10},
+	})
+	if s.Exists(makeKey("q", nil)) {
+		t.Error("expected empty counter to be deleted")
+	}
+}
+
+func TestOverride(t *testing.T) {
+	s := newRedis(t)
+	defer s.Close()
+	h := newTestHandler(t, s, false, config.Params_Quota{
+		Name:      "q",
+		MaxAmount: 10,
+		Overrides: []config.Params_Override{
+			{Dimensions: map[string]string{"source": "10.0.0.1"}, MaxAmount: 2},
+		},
+	})
+	defer func() { _ = h.Close() }()
+
+	runAllocations(t, h, &quota.Instance{Name: "q", Dimensions: map[string]interface{}{"source": net.ParseIP("10.0.0.1")}},
+		[]allocation{{dedup: "1", amount: 5, best: true, want: 2}})
+	runAllocations(t, h, &quota.Instance{Name: "q", Dimensions: map[string]interface{}{"source": net.ParseIP("10.0.0.2")}},
+		[]allocation{{dedup: "2", amount: 5, best: true, want: 5}})
+}
+
+func TestUnavailable(t *testing.T) {
+	q := config.Params_Quota{Name: "q", MaxAmount: 10, ValidDuration: time.Second}
+	instance := &quota.Instance{Name: "q"}
+	args := adapter.QuotaArgs{DeduplicationID: "1", QuotaAmount: 5}
+
+	s := newRedis(t)
+	open := newTestHandler(t, s, true, q)
+	defer func() { _ = open.Close() }()
+	closed := newTestHandler(t, s, false, q)
+	defer func() { _ = closed.Close() }()
+	s.Close()
+
+	result, err := open.HandleQuota(context.Background(), instance, args)
+	if err != nil || result.Amount != 5 || result.ValidDuration != time.Second {
+		t.Errorf("fail open => got result %v, error %v; want quota to be granted", result, err)
+	}
+
+	result, err = closed.HandleQuota(context.Background(), instance, args)
+	if err == nil || result.Amount != 0 || result.Status.Code != int32(rpc.UNAVAILABLE) {
+		t.Errorf("fail closed => got result %v, error %v; want quota to be denied", result, err)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisquota
+
+// The quota operations run as Lua scripts so that each one is atomic on the
+// Redis server, regardless of how many Mixer instances share the server.
+//
+// All scripts take the same arguments:
+//
+//   KEYS[1]  the key holding the quota state
+//   KEYS[2]  the key holding the deduplication state of the operation
+//   ARGV[1]  the quota limit
+//   ARGV[2]  the window in milliseconds, 0 for non-expiring quotas
+//   ARGV[3]  the amount to allocate or release
+//   ARGV[4]  1 to grant as much as possible when the amount is not available
+//   ARGV[5]  the lifetime of the deduplication state in milliseconds, 0 to disable deduplication
+//   ARGV[6]  the current time in milliseconds
+//   ARGV[7]  the rolling window bucket size in milliseconds
+//
+// and return a table of the granted amount and the number of milliseconds the
+// amount is valid for, or a negative number if unknown. Operations repeated
+// with the same deduplication key return the result of the first operation.
+
+// dedupPrelude returns the result of a repeated operation.
+const dedupPrelude = `
+local dedupMillis = tonumber(ARGV[5])
+if dedupMillis > 0 then
+  local dedup = redis.call("GET", KEYS[2])
+  if dedup then
+    return {tonumber(dedup), redis.call("PTTL", KEYS[1])}
+  end
+end
+local function remember(result)
+  if dedupMillis > 0 then
+    redis.call("SET", KEYS[2], result, "PX", dedupMillis)
+  end
+end
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local amount = tonumber(ARGV[3])
+local bestEffort = ARGV[4] == "1"
+`
+
+// grantPrelude computes the amount to grant given the amount in use.
+const grantPrelude = `
+local function grant(used)
+  if used + amount <= limit then
+    return amount
+  end
+  if bestEffort and used < limit then
+    return limit - used
+  end
+  return 0
+end
+`
+
+// fixedWindowAlloc allocates from a counter that expires at the end of the window.
+const fixedWindowAlloc = dedupPrelude + grantPrelude + `
+local granted = grant(tonumber(redis.call("GET", KEYS[1]) or "0"))
+if granted > 0 then
+  redis.call("INCRBY", KEYS[1], granted)
+  if window > 0 and redis.call("PTTL", KEYS[1]) < 0 then
+    redis.call("PEXPIRE", KEYS[1], window)
+  end
+end
+remember(granted)
+return {granted, redis.call("PTTL", KEYS[1])}
+`
+
+// rollingWindowAlloc allocates from a hash of per-bucket counters, where
+// buckets that have left the window are discarded.
+const rollingWindowAlloc = dedupPrelude + grantPrelude + `
+local bucket = tonumber(ARGV[7])
+local current = math.floor(tonumber(ARGV[6]) / bucket)
+local oldest = current - math.ceil(window / bucket) + 1
+
+local used = 0
+local buckets = redis.call("HGETALL", KEYS[1])
+for i = 1, #buckets, 2 do
+  if tonumber(buckets[i]) < oldest then
+    redis.call("HDEL", KEYS[1], buckets[i])
+  else
+    used = used + tonumber(buckets[i + 1])
+  end
+end
+
+local granted = grant(used)
+if granted > 0 then
+  redis.call("HINCRBY", KEYS[1], current, granted)
+  redis.call("PEXPIRE", KEYS[1], window + bucket)
+end
+remember(granted)
+return {granted, window}
+`
+
+// counterRelease releases quota allocated from a counter. The counter is
+// deleted when it drops to zero, as it contains no useful state.
+const counterRelease = dedupPrelude + `
+local used = tonumber(redis.call("GET", KEYS[1]) or "0")
+local released = math.min(amount, used)
+if released == used then
+  redis.call("DEL", KEYS[1])
+elseif released > 0 then
+  redis.call("DECRBY", KEYS[1], released)
+end
+remember(released)
+return {released, -1}
+`
@@ -0,0 +1,372 @@
+// Copyright 2018 Istio Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate $GOPATH/src/istio.io/istio/bin/mixer_codegen.sh -f mixer/adapter/remote/config/config.proto
+
+// Package remote provides an adapter that forwards the instances it receives to an adapter
+// deployed out of process, through the handler services generated for each template. This
+// lets adapters be developed, released and scaled independently of Mixer, and isolates
+// Mixer from their failures.
+package remote // import "istio.io/istio/mixer/adapter/remote"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"istio.io/istio/mixer/adapter/remote/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	protocol "istio.io/istio/mixer/pkg/adapter/remote"
+	"istio.io/istio/mixer/template/apikey"
+	"istio.io/istio/mixer/template/authorization"
+	"istio.io/istio/mixer/template/checknothing"
+	"istio.io/istio/mixer/template/listentry"
+	"istio.io/istio/mixer/template/logentry"
+	"istio.io/istio/mixer/template/metric"
+	"istio.io/istio/mixer/template/quota"
+	"istio.io/istio/mixer/template/reportnothing"
+	"istio.io/istio/mixer/template/tracespan"
+)
+
+type (
+	builder struct {
+		adapterConfig *config.Params
+	}
+
+	handler struct {
+		conn    *grpc.ClientConn
+		address string
+		timeout time.Duration
+		env     adapter.Env
+
+		// unhealthy is set to 1 while the health checks of the adapter service fail.
+		unhealthy int32
+
+		// cancel stops the health checks.
+		cancel context.CancelFunc
+	}
+)
+
+// ensure types implement the requisite interfaces
+var _ apikey.HandlerBuilder = &builder{}
+var _ apikey.Handler = &handler{}
+var _ authorization.HandlerBuilder = &builder{}
+var _ authorization.Handler = &handler{}
+var _ checknothing.HandlerBuilder = &builder{}
+var _ checknothing.Handler = &handler{}
+var _ listentry.HandlerBuilder = &builder{}
+var _ listentry.Handler = &handler{}
+var _ logentry.HandlerBuilder = &builder{}
+var _ logentry.Handler = &handler{}
+var _ metric.HandlerBuilder = &builder{}
+var _ metric.Handler = &handler{}
+var _ quota.HandlerBuilder = &builder{}
+var _ quota.Handler = &handler{}
+var _ reportnothing.HandlerBuilder = &builder{}
+var _ reportnothing.Handler = &handler{}
+var _ tracespan.HandlerBuilder = &builder{}
+var _ tracespan.Handler = &handler{}
+
+////////////////// Runtime Methods //////////////////////////
+
+// callContext returns the context of a call to the adapter service, which fails right away
+// if the adapter service is known to be unhealthy.
+func (h *handler) callContext(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	if atomic.LoadInt32(&h.unhealthy) != 0 {
+		return nil, nil, fmt.Errorf("remote adapter at %s is unhealthy", h.address)
+	}
+	if h.timeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, h.timeout)
+		return ctx, cancel, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return ctx, cancel, nil
+}
+
+func (h *handler) check(ctx context.Context,
+	call func(context.Context) (*protocol.CheckResult, error)) (adapter.CheckResult, error) {
+	ctx, cancel, err := h.callContext(ctx)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	defer cancel()
+
+	result, err := call(ctx)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	return protocol.DecodeCheckResult(result), nil
+}
+
+func (h *handler) report(ctx context.Context, call func(context.Context) (*protocol.ReportResult, error)) error {
+	ctx, cancel, err := h.callContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	_, err = call(ctx)
+	return err
+}
+
+func (h *handler) HandleApiKey(ctx context.Context, inst *apikey.Instance) (adapter.CheckResult, error) {
+	msg, err := apikey.EncodeInstance(inst)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	client := apikey.NewHandleApiKeyServiceClient(h.conn)
+	return h.check(ctx, func(ctx context.Context) (*protocol.CheckResult, error) {
+		return client.HandleApiKey(ctx, &apikey.HandleApiKeyRequest{Instance: msg})
+	})
+}
+
+func (h *handler) HandleAuthorization(ctx context.Context, inst *authorization.Instance) (adapter.CheckResult, error) {
+	msg, err := authorization.EncodeInstance(inst)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	client := authorization.NewHandleAuthorizationServiceClient(h.conn)
+	return h.check(ctx, func(ctx context.Context) (*protocol.CheckResult, error) {
+		return client.HandleAuthorization(ctx, &authorization.HandleAuthorizationRequest{Instance: msg})
+	})
+}
+
+func (h *handler) HandleCheckNothing(ctx context.Context, inst *checknothing.Instance) (adapter.CheckResult, error) {
+	msg, err := checknothing.EncodeInstance(inst)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	client := checknothing.NewHandleCheckNothingServiceClient(h.conn)
+	return h.check(ctx, func(ctx context.Context) (*protocol.CheckResult, error) {
+		return client.HandleCheckNothing(ctx, &checknothing.HandleCheckNothingRequest{Instance: msg})
+	})
+}
+
+func (h *handler) HandleListEntry(ctx context.Context, inst *listentry.Instance) (adapter.CheckResult, error) {
+	msg, err := listentry.EncodeInstance(inst)
+	if err != nil {
+		return adapter.CheckResult{}, err
+	}
+	client := listentry.NewHandleListEntryServiceClient(h.conn)
+	return h.check(ctx, func(ctx context.Context) (*protocol.CheckResult, error) {
+		return client.HandleListEntry(ctx, &listentry.HandleListEntryRequest{Instance: msg})
+	})
+}
+
+func (h *handler) HandleLogEntry(ctx context.Context, insts []*logentry.Instance) error {
+	msgs := make([]*logentry.InstanceMsg, 0, len(insts))
+	for _, inst := range insts {
+		msg, err := logentry.EncodeInstance(inst)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	client := logentry.NewHandleLogEntryServiceClient(h.conn)
+	return h.report(ctx, func(ctx context.Context) (*protocol.ReportResult, error) {
+		return client.HandleLogEntry(ctx, &logentry.HandleLogEntryRequest{Instances: msgs})
+	})
+}
+
+func (h *handler) HandleMetric(ctx context.Context, insts []*metric.Instance) error {
+	msgs := make([]*metric.InstanceMsg, 0, len(insts))
+	for _, inst := range insts {
+		msg, err := metric.EncodeInstance(inst)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	client := metric.NewHandleMetricServiceClient(h.conn)
+	return h.report(ctx, func(ctx context.Context) (*protocol.ReportResult, error) {
+		return client.HandleMetric(ctx, &metric.HandleMetricRequest{Instances: msgs})
+	})
+}
+
+func (h *handler) HandleQuota(ctx context.Context, inst *quota.Instance,
+	args adapter.QuotaArgs) (adapter.QuotaResult, error) {
+	msg, err := quota.EncodeInstance(inst)
+	if err != nil {
+		return adapter.QuotaResult{}, err
+	}
+	ctx, cancel, err := h.callContext(ctx)
+	if err != nil {
+		return adapter.QuotaResult{}, err
+	}
+	defer cancel()
+
+	result, err := quota.NewHandleQuotaServiceClient(h.conn).HandleQuota(ctx,
+		&quota.HandleQuotaRequest{Instance: msg, QuotaArgs: protocol.EncodeQuotaArgs(args)})
+	if err != nil {
+		return adapter.QuotaResult{}, err
+	}
+	return protocol.DecodeQuotaResult(result), nil
+}
+
+func (h *handler) HandleReportNothing(ctx context.Context, insts []*reportnothing.Instance) error {
+	msgs := make([]*reportnothing.InstanceMsg, 0, len(insts))
+	for _, inst := range insts {
+		msg, err := reportnothing.EncodeInstance(inst)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	client := reportnothing.NewHandleReportNothingServiceClient(h.conn)
+	return h.report(ctx, func(ctx context.Context) (*protocol.ReportResult, error) {
+		return client.HandleReportNothing(ctx, &reportnothing.HandleReportNothingRequest{Instances: msgs})
+	})
+}
+
+func (h *handler) HandleTraceSpan(ctx context.Context, insts []*tracespan.Instance) error {
+	msgs := make([]*tracespan.InstanceMsg, 0, len(insts))
+	for _, inst := range insts {
+		msg, err := tracespan.EncodeInstance(inst)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	client := tracespan.NewHandleTraceSpanServiceClient(h.conn)
+	return h.report(ctx, func(ctx context.Context) (*protocol.ReportResult, error) {
+		return client.HandleTraceSpan(ctx, &tracespan.HandleTraceSpanRequest{Instances: msgs})
+	})
+}
+
+func (h *handler) Close() error {
+	h.cancel()
+	return h.conn.Close()
+}
+
+// healthCheck probes the adapter service every interval, until the context is done.
+func (h *handler) healthCheck(ctx context.Context, interval time.Duration, service string) {
+	client := grpc_health_v1.NewHealthClient(h.conn)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		resp, err := client.Check(checkCtx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		cancel()
+		if err == nil && resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+			err = fmt.Errorf("service status is %v", resp.Status)
+		}
+
+		if err != nil {
+			if atomic.SwapInt32(&h.unhealthy, 1) == 0 {
+				h.env.Logger().Warningf("remote adapter at %s is unhealthy: %v", h.address, err)
+			}
+		} else if atomic.SwapInt32(&h.unhealthy, 0) != 0 {
+			h.env.Logger().Infof("remote adapter at %s is healthy again", h.address)
+		}
+	}
+}
+
+////////////////// Bootstrap //////////////////////////
+
+// GetInfo returns the Info associated with this adapter implementation.
+func GetInfo() adapter.Info {
+	return adapter.Info{
+		Name:        "remote",
+		Impl:        "istio.io/istio/mixer/adapter/remote",
+		Description: "Forwards instances to an adapter deployed out of process over gRPC",
+		SupportedTemplates: []string{
+			apikey.TemplateName,
+			authorization.TemplateName,
+			checknothing.TemplateName,
+			listentry.TemplateName,
+			logentry.TemplateName,
+			metric.TemplateName,
+			quota.TemplateName,
+			reportnothing.TemplateName,
+			tracespan.TemplateName,
+		},
+		DefaultConfig: &config.Params{
+			Timeout: time.Second,
+		},
+		NewBuilder: func() adapter.HandlerBuilder { return &builder{} },
+	}
+}
+
+func (*builder) SetApiKeyTypes(map[string]*apikey.Type)               {}
+func (*builder) SetAuthorizationTypes(map[string]*authorization.Type) {}
+func (*builder) SetCheckNothingTypes(map[string]*checknothing.Type)   {}
+func (*builder) SetListEntryTypes(map[string]*listentry.Type)         {}
+func (*builder) SetLogEntryTypes(map[string]*logentry.Type)           {}
+func (*builder) SetMetricTypes(map[string]*metric.Type)               {}
+func (*builder) SetQuotaTypes(map[string]*quota.Type)                 {}
+func (*builder) SetReportNothingTypes(map[string]*reportnothing.Type) {}
+func (*builder) SetTraceSpanTypes(map[string]*tracespan.Type)         {}
+func (b *builder) SetAdapterConfig(cfg adapter.Config)                { b.adapterConfig = cfg.(*config.Params) }
+
+// Validate checks that the adapter service address is set and that the durations are not negative.
+func (b *builder) Validate() (ce *adapter.ConfigErrors) {
+	ac := b.adapterConfig
+	if ac.Address == "" {
+		ce = ce.Append("address", errors.New("the address of the adapter service must be specified"))
+	}
+	if ac.Timeout < 0 {
+		ce = ce.Appendf("timeout", "must not be negative: %v", ac.Timeout)
+	}
+	if ac.HealthCheckInterval < 0 {
+		ce = ce.Appendf("health_check_interval", "must not be negative: %v", ac.HealthCheckInterval)
+	}
+	return
+}
+
+// Build connects to the adapter service and starts checking its health.
+func (b *builder) Build(ctx context.Context, env adapter.Env) (adapter.Handler, error) {
+	ac := b.adapterConfig
+
+	opt := grpc.WithInsecure()
+	if ac.CaCertificatePath != "" {
+		creds, err := credentials.NewClientTLSFromFile(ac.CaCertificatePath, "")
+		if err != nil {
+			return nil, env.Logger().Errorf("could not load the CA certificate %s: %v", ac.CaCertificatePath, err)
+		}
+		opt = grpc.WithTransportCredentials(creds)
+	}
+
+	conn, err := grpc.Dial(ac.Address, opt)
+	if err != nil {
+		return nil, env.Logger().Errorf("could not connect to the remote adapter at %s: %v", ac.Address, err)
+	}
+
+	healthCtx, cancel := context.WithCancel(context.Background())
+	h := &handler{
+		conn:    conn,
+		address: ac.Address,
+		timeout: ac.Timeout,
+		env:     env,
+		cancel:  cancel,
+	}
+	if ac.HealthCheckInterval > 0 {
+		env.ScheduleDaemon(func() {
+			h.healthCheck(healthCtx, ac.HealthCheckInterval, ac.HealthCheckService)
+		})
+	}
+	return h, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2018 Istio Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"istio.io/istio/mixer/adapter/remote/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/adapter/test"
+	"istio.io/istio/mixer/pkg/status"
+	"istio.io/istio/mixer/template/checknothing"
+	"istio.io/istio/mixer/template/metric"
+	"istio.io/istio/mixer/template/quota"
+)
+
+// fakeAdapter is the out of process adapter the tests dispatch to.
+type fakeAdapter struct {
+	checkResult adapter.CheckResult
+	quotaResult adapter.QuotaResult
+
+	checked  *checknothing.Instance
+	reported []*metric.Instance
+	quota    *quota.Instance
+	args     adapter.QuotaArgs
+}
+
+func (f *fakeAdapter) HandleCheckNothing(_ context.Context, inst *checknothing.Instance) (adapter.CheckResult, error) {
+	f.checked = inst
+	return f.checkResult, nil
+}
+
+func (f *fakeAdapter) HandleMetric(_ context.Context, insts []*metric.Instance) error {
+	f.reported = insts
+	return nil
+}
+
+func (f *fakeAdapter) HandleQuota(_ context.Context, inst *quota.Instance,
+	args adapter.QuotaArgs) (adapter.QuotaResult, error) {
+	f.quota, f.args = inst, args
+	return f.quotaResult, nil
+}
+
+func (*fakeAdapter) Close() error { return nil }
+
+func startServer(t *testing.T, f *fakeAdapter) (string, *health.Server, func()) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	checknothing.RegisterHandleCheckNothingServiceServer(s, checknothing.NewHandleCheckNothingServiceServer(f))
+	metric.RegisterHandleMetricServiceServer(s, metric.NewHandleMetricServiceServer(f))
+	quota.RegisterHandleQuotaServiceServer(s, quota.NewHandleQuotaServiceServer(f))
+	hs := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, hs)
+	go func() { _ = s.Serve(l) }()
+	return l.Addr().String(), hs, s.Stop
+}
+
+func build(t *testing.T, cfg *config.Params) adapter.Handler {
+	t.Helper()
+	b := GetInfo().NewBuilder().(*builder)
+	b.SetAdapterConfig(cfg)
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Validate() => unexpected error %v", err)
+	}
+	h, err := b.Build(context.Background(), test.NewEnv(t))
+	if err != nil {
+		t.Fatalf("Build() => unexpected error %v", err)
+	}
+	return h
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		cfg   *config.Params
+		field string
+	}{
+		{"no address", &config.Params{}, "address"},
+		{"negative timeout", &config.Params{Address: "a:1", Timeout: -time.Second}, "timeout"},
+		{"negative interval", &config.Params{Address: "a:1", HealthCheckInterval: -time.Second}, "health_check_interval"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := GetInfo().NewBuilder().(*builder)
+			b.SetAdapterConfig(c.cfg)
+			if err := b.Validate(); err == nil || !strings.Contains(err.Error(), c.field) {
+				t.Errorf("Validate() => got %v, want an error about %s", err, c.field)
+			}
+		})
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	f := &fakeAdapter{
+		checkResult: adapter.CheckResult{Status: status.WithPermissionDenied("denied"), ValidUseCount: 3},
+		quotaResult: adapter.QuotaResult{ValidDuration: time.Minute, Amount: 7},
+	}
+	addr, _, stop := startServer(t, f)
+	defer stop()
+
+	h := build(t, &config.Params{Address: addr, Timeout: 10 * time.Second})
+	defer func() { _ = h.Close() }()
+	ctx := context.Background()
+
+	result, err := h.(checknothing.Handler).HandleCheckNothing(ctx, &checknothing.Instance{Name: "check"})
+	if err != nil || !reflect.DeepEqual(result, f.checkResult) {
+		t.Errorf("HandleCheckNothing() => got %v, %v, want %v", result, err, f.checkResult)
+	}
+	if f.checked == nil || f.checked.Name != "check" {
+		t.Errorf("HandleCheckNothing() dispatched %v", f.checked)
+	}
+
+	insts := []*metric.Instance{{
+		Name:       "request_count",
+		Value:      int64(1),
+		Dimensions: map[string]interface{}{"source": "a", "code": int64(200)},
+	}}
+	if err = h.(metric.Handler).HandleMetric(ctx, insts); err != nil {
+		t.Errorf("HandleMetric() => unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(f.reported, insts) {
+		t.Errorf("HandleMetric() dispatched %v, want %v", f.reported, insts)
+	}
+
+	args := adapter.QuotaArgs{DeduplicationID: "id", QuotaAmount: 10}
+	qr, err := h.(quota.Handler).HandleQuota(ctx, &quota.Instance{Name: "requests"}, args)
+	if err != nil || !reflect.DeepEqual(qr, f.quotaResult) {
+		t.Errorf("HandleQuota() => got %v, %v, want %v", qr, err, f.quotaResult)
+	}
+	if f.quota == nil || f.quota.Name != "requests" || f.args != args {
+		t.Errorf("HandleQuota() dispatched %v with %v", f.quota, f.args)
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	addr, hs, stop := startServer(t, &fakeAdapter{})
+	defer stop()
+
+	h := build(t, &config.Params{Address: addr, Timeout: 10 * time.Second, HealthCheckInterval: 10 * time.Millisecond})
+	defer func() { _ = h.Close() }()
+
+	waitFor := func(healthy bool) {
+		t.Helper()
+		for i := 0; i < 500; i++ {
+			_, err := h.(checknothing.Handler).HandleCheckNothing(context.Background(), &checknothing.Instance{})
+			if (err == nil) == healthy {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("the remote adapter did not become healthy=%t", healthy)
+	}
+
+	hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	waitFor(false)
+	hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	waitFor(true)
+}
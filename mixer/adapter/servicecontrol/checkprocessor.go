@@ -60,7 +60,7 @@ func (c *checkImpl) ProcessCheck(ctx context.Context, instance *apikey.Instance)
 					"instance:%s, api key and api operation must not be empty", instance.Name))), nil
 	}
 	consumerID := generateConsumerIDFromAPIKey(instance.ApiKey)
-	response, err := c.doCheck(consumerID, instance.ApiOperation, instance.Timestamp)
+	response, err := c.doCheck(ctx, consumerID, instance.ApiOperation, instance.Timestamp)
 	if err != nil {
 		return c.checkResult(status.WithPermissionDenied(err.Error())), nil
 	}
@@ -69,8 +69,8 @@ func (c *checkImpl) ProcessCheck(ctx context.Context, instance *apikey.Instance)
 }
 
 // ResolveConsumerProjectID resolves consumer project ID from consumer ID and operation name.
-func (c *checkImpl) ResolveConsumerProjectID(consumerID, opName string) (string, error) {
-	response, err := c.doCheck(consumerID, opName, time.Now())
+func (c *checkImpl) ResolveConsumerProjectID(ctx context.Context, consumerID, opName string) (string, error) {
+	response, err := c.doCheck(ctx, consumerID, opName, time.Now())
 	if err != nil {
 		return "", nil
 	}
@@ -84,7 +84,8 @@ func (c *checkImpl) ResolveConsumerProjectID(consumerID, opName string) (string,
 }
 
 // doCheck calls Check on Google ServiceControl client.
-func (c *checkImpl) doCheck(consumerID, operationName string, timestamp time.Time) (*sc.CheckResponse, error) {
+func (c *checkImpl) doCheck(ctx context.Context, consumerID, operationName string,
+	timestamp time.Time) (*sc.CheckResponse, error) {
 	cacheKey := checkCacheKey{
 		googleServiceName: c.serviceConfig.GoogleServiceName,
 		consumerID:        consumerID,
@@ -111,7 +112,7 @@ func (c *checkImpl) doCheck(consumerID, operationName string, timestamp time.Tim
 		}
 	}
 
-	response, err := c.client.Check(c.serviceConfig.GoogleServiceName, request)
+	response, err := c.client.Check(ctx, c.serviceConfig.GoogleServiceName, request)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +159,7 @@ func TestResolveConsumerProjectID(t *testing.T) {
 		},
 	})
 	{
-		id, err := test.checkProc.ResolveConsumerProjectID(apiKeyPrefix+"test_key", "/echo")
+		id, err := test.checkProc.ResolveConsumerProjectID(context.Background(), apiKeyPrefix+"test_key", "/echo")
 		if err != nil {
 			t.Fatalf(`ResolveConsumerProjectID(...) failed with error %v`, err)
 		}
@@ -171,7 +171,7 @@ func TestResolveConsumerProjectID(t *testing.T) {
 		// Repeat the same test but set injected response to nil. Without check cache, the following
 		// test would fail.
 		test.mockClient.checkResponse = nil
-		id, err := test.checkProc.ResolveConsumerProjectID(apiKeyPrefix+"test_key", "/echo")
+		id, err := test.checkProc.ResolveConsumerProjectID(context.Background(), apiKeyPrefix+"test_key", "/echo")
 		test.mockClient.setCheckResponse(nil)
 		if err != nil {
 			t.Fatalf(`ResolveConsumerProjectID(...) failed with error %v`, err)
@@ -15,11 +15,11 @@
 package servicecontrol
 
 import (
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
 
-	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	sc "google.golang.org/api/servicecontrol/v1"
@@ -29,16 +29,17 @@ type client struct {
 	serviceControl *sc.Service
 }
 
-func (c *client) Check(serviceName string, request *sc.CheckRequest) (*sc.CheckResponse, error) {
-	return c.serviceControl.Services.Check(serviceName, request).Do()
+func (c *client) Check(ctx context.Context, serviceName string, request *sc.CheckRequest) (*sc.CheckResponse, error) {
+	return c.serviceControl.Services.Check(serviceName, request).Context(ctx).Do()
 }
 
-func (c *client) Report(serviceName string, request *sc.ReportRequest) (*sc.ReportResponse, error) {
-	return c.serviceControl.Services.Report(serviceName, request).Do()
+func (c *client) Report(ctx context.Context, serviceName string, request *sc.ReportRequest) (*sc.ReportResponse, error) {
+	return c.serviceControl.Services.Report(serviceName, request).Context(ctx).Do()
 }
 
-func (c *client) AllocateQuota(serviceName string, request *sc.AllocateQuotaRequest) (*sc.AllocateQuotaResponse, error) {
-	return c.serviceControl.Services.AllocateQuota(serviceName, request).Do()
+func (c *client) AllocateQuota(ctx context.Context, serviceName string,
+	request *sc.AllocateQuotaRequest) (*sc.AllocateQuotaResponse, error) {
+	return c.serviceControl.Services.AllocateQuota(serviceName, request).Context(ctx).Do()
 }
 
 func getTokenSource(ctx context.Context, jsonKey []byte) (oauth2.TokenSource, error) {
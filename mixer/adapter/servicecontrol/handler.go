@@ -33,10 +33,12 @@ import (
 )
 
 type (
+	// serviceControlClient calls Google ServiceControl. The calls are abandoned when their context is done.
 	serviceControlClient interface {
-		Check(googleServiceName string, request *sc.CheckRequest) (*sc.CheckResponse, error)
-		Report(googleServiceName string, request *sc.ReportRequest) (*sc.ReportResponse, error)
-		AllocateQuota(googleServiceName string, request *sc.AllocateQuotaRequest) (*sc.AllocateQuotaResponse, error)
+		Check(ctx context.Context, googleServiceName string, request *sc.CheckRequest) (*sc.CheckResponse, error)
+		Report(ctx context.Context, googleServiceName string, request *sc.ReportRequest) (*sc.ReportResponse, error)
+		AllocateQuota(ctx context.Context, googleServiceName string,
+			request *sc.AllocateQuotaRequest) (*sc.AllocateQuotaResponse, error)
 	}
 
 	checkProcessor interface {
@@ -186,7 +186,7 @@ func (p *quotaImpl) ProcessQuota(ctx context.Context,
 		}
 	}
 
-	response, err := p.client.AllocateQuota(
+	response, err := p.client.AllocateQuota(ctx,
 		p.serviceSetting.GoogleServiceName, request)
 	if err != nil {
 		err = p.env.Logger().Errorf("allocate quota failed: %v", err)
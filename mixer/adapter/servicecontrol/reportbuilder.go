@@ -15,6 +15,7 @@
 package servicecontrol
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 	"time"
@@ -37,7 +38,7 @@ const (
 type (
 	consumerProjectIDResolver interface {
 		// ResolveConsumerProjectID resolves consumer project ID from API key and operation.
-		ResolveConsumerProjectID(rawAPIKey, OpName string) (string, error)
+		ResolveConsumerProjectID(ctx context.Context, rawAPIKey, OpName string) (string, error)
 	}
 
 	// Label generator function prototype
@@ -201,19 +202,19 @@ func generateLogErrorCause(instance *servicecontrolreport.Instance) string {
 }
 
 /////// reportBuilder methods ///////
-func (b *reportBuilder) build(op *sc.Operation) {
-	b.addMetricValues(op)
+func (b *reportBuilder) build(ctx context.Context, op *sc.Operation) {
+	b.addMetricValues(ctx, op)
 	b.addLogEntry(op)
 }
 
 // addMetricValues adds metric value sets to operation
 // TODO(manlinl): if API key is missing, don't include consumer metrics.
-func (b *reportBuilder) addMetricValues(op *sc.Operation) {
+func (b *reportBuilder) addMetricValues(ctx context.Context, op *sc.Operation) {
 	if b.supportedMetrics == nil {
 		return
 	}
 
-	op.Labels = b.generateAPIResourceLabels()
+	op.Labels = b.generateAPIResourceLabels(ctx)
 	metricValueSets := make([]*sc.MetricValueSet, 0, len(b.supportedMetrics))
 	for _, metric := range b.supportedMetrics {
 		metricSet := new(sc.MetricValueSet)
@@ -290,12 +291,12 @@ func (b *reportBuilder) generateLogJSONPayload() ([]byte, error) {
 	return json.Marshal(payload)
 }
 
-func (b *reportBuilder) generateAPIResourceLabels() map[string]string {
+func (b *reportBuilder) generateAPIResourceLabels(ctx context.Context) map[string]string {
 	labels := make(map[string]string)
 	if b.instance.ApiKey != "" {
 		consumerID := generateConsumerIDFromAPIKey(b.instance.ApiKey)
 		if b.instance.ApiOperation != "" {
-			consumerProjID, err := b.resolver.ResolveConsumerProjectID(consumerID, b.instance.ApiOperation)
+			consumerProjID, err := b.resolver.ResolveConsumerProjectID(ctx, consumerID, b.instance.ApiOperation)
 			if err == nil {
 				labels["serviceruntime.googleapis.com/consumer_project"] = consumerProjID
 			}
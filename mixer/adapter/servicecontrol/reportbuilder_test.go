@@ -15,6 +15,7 @@
 package servicecontrol
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -106,7 +107,7 @@ func TestBuildLogEntry(t *testing.T) {
 func TestBuildMetricValue(t *testing.T) {
 	rb := getTestReportBuilder()
 	op := &sc.Operation{}
-	rb.addMetricValues(op)
+	rb.addMetricValues(context.Background(), op)
 	expected :=
 		`{
 			"labels":{
@@ -42,7 +42,7 @@ func (r *reportImpl) ProcessReport(ctx context.Context, instances []*servicecont
 	for _, inst := range instances {
 		builder := newReportBuilder(inst, supportedMetrics, r.resolver)
 		op := initializeOperation(inst)
-		builder.build(op)
+		builder.build(ctx, op)
 		if len(op.MetricValueSets) == 0 && len(op.LogEntries) == 0 {
 			logger.Warningf("no metric or log entry is generated, dimensions: %v", inst)
 			continue
@@ -64,7 +64,8 @@ func (r *reportImpl) scheduleReport(op *sc.Operation) {
 			Operations: []*sc.Operation{op},
 		}
 
-		response, err := r.client.Report(r.serviceConfig.GoogleServiceName, request)
+		// the report is sent after the call to the handler completed, so it is not bound to its context.
+		response, err := r.client.Report(context.Background(), r.serviceConfig.GoogleServiceName, request)
 		if err != nil || response.ReportErrors != nil {
 			_ = logger.Errorf("fail to send report: %v", err)
 		}
@@ -15,6 +15,7 @@
 package servicecontrol
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"reflect"
@@ -32,7 +33,7 @@ type mockSvcctrlClient struct {
 	allocateQuotaResponse *sc.AllocateQuotaResponse
 }
 
-func (c *mockSvcctrlClient) Check(serviceName string, request *sc.CheckRequest) (*sc.CheckResponse, error) {
+func (c *mockSvcctrlClient) Check(_ context.Context, serviceName string, request *sc.CheckRequest) (*sc.CheckResponse, error) {
 	c.serviceName = serviceName
 	c.checkRequest = request
 	if c.checkResponse != nil {
@@ -41,7 +42,7 @@ func (c *mockSvcctrlClient) Check(serviceName string, request *sc.CheckRequest)
 	return nil, errors.New("injected error")
 }
 
-func (c *mockSvcctrlClient) Report(serviceName string, request *sc.ReportRequest) (*sc.ReportResponse, error) {
+func (c *mockSvcctrlClient) Report(_ context.Context, serviceName string, request *sc.ReportRequest) (*sc.ReportResponse, error) {
 	c.serviceName = serviceName
 	c.reportRequest = request
 	if c.reportResponse != nil {
@@ -50,7 +51,7 @@ func (c *mockSvcctrlClient) Report(serviceName string, request *sc.ReportRequest
 	return nil, errors.New("injected error")
 }
 
-func (c *mockSvcctrlClient) AllocateQuota(serviceName string,
+func (c *mockSvcctrlClient) AllocateQuota(_ context.Context, serviceName string,
 	request *sc.AllocateQuotaRequest) (*sc.AllocateQuotaResponse, error) {
 	c.serviceName = serviceName
 	c.allocateQuotaRequest = request
@@ -83,7 +84,7 @@ type mockConsumerProjectIDResolver struct {
 }
 
 func (r *mockConsumerProjectIDResolver) ResolveConsumerProjectID(
-	rawAPIKey, OpName string) (string, error) {
+	_ context.Context, rawAPIKey, OpName string) (string, error) {
 	if r.consumerProjectID == "" {
 		return "", errors.New("injected error")
 	}
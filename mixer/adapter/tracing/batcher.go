@@ -0,0 +1,159 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"sync"
+	"time"
+
+	"istio.io/istio/mixer/pkg/adapter"
+)
+
+// batcher buffers spans and sends them to the exporters in batches from a
+// background daemon. The number of spans held, including the spans of the
+// batch being sent, is bounded; spans added while the buffer is full are dropped.
+type batcher struct {
+	exporters     []exporter
+	batchSize     int
+	bufferSize    int
+	flushInterval time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+	env           adapter.Env
+
+	mu      sync.Mutex // guards pending and held
+	pending []*span
+	held    int
+
+	flushCh chan struct{} // signals that a full batch is pending
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+func newBatcher(exporters []exporter, batchSize, bufferSize, maxRetries int,
+	flushInterval, retryBackoff time.Duration, env adapter.Env) *batcher {
+	b := &batcher{
+		exporters:     exporters,
+		batchSize:     batchSize,
+		bufferSize:    bufferSize,
+		flushInterval: flushInterval,
+		maxRetries:    maxRetries,
+		retryBackoff:  retryBackoff,
+		env:           env,
+		flushCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	env.ScheduleDaemon(b.run)
+	return b
+}
+
+// add queues the spans for export.
+func (b *batcher) add(spans []*span) {
+	b.mu.Lock()
+	dropped := 0
+	if free := b.bufferSize - b.held; len(spans) > free {
+		dropped = len(spans) - free
+		spans = spans[:free]
+	}
+	b.pending = append(b.pending, spans...)
+	b.held += len(spans)
+	full := len(b.pending) >= b.batchSize
+	b.mu.Unlock()
+
+	if dropped > 0 {
+		b.env.Logger().Warningf("tracing buffer is full, dropping %d spans", dropped)
+	}
+	if full {
+		select {
+		case b.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *batcher) run() {
+	defer close(b.doneCh)
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-b.flushCh:
+		case <-b.stopCh:
+			b.flush()
+			return
+		}
+		b.flush()
+	}
+}
+
+// flush sends all pending spans.
+func (b *batcher) flush() {
+	for {
+		b.mu.Lock()
+		n := len(b.pending)
+		if n > b.batchSize {
+			n = b.batchSize
+		}
+		batch := b.pending[:n]
+		b.pending = b.pending[n:]
+		b.mu.Unlock()
+
+		if n == 0 {
+			return
+		}
+		for _, e := range b.exporters {
+			b.send(e, batch)
+		}
+
+		b.mu.Lock()
+		b.held -= n
+		b.mu.Unlock()
+	}
+}
+
+// send exports a batch, retrying failed requests with exponential backoff.
+// Retries are abandoned once the batcher is stopped.
+func (b *batcher) send(e exporter, batch []*span) {
+	backoff := b.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := e.export(batch)
+		if err == nil {
+			return
+		}
+		if attempt >= b.maxRetries || !retryable(err) {
+			b.env.Logger().Warningf("dropping %d spans after %d attempts to send them to %s: %v",
+				len(batch), attempt+1, e.name(), err)
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-b.stopCh:
+			b.env.Logger().Warningf("dropping %d spans on shutdown, failed to send them to %s: %v",
+				len(batch), e.name(), err)
+			return
+		}
+		backoff *= 2
+	}
+}
+
+// close stops the daemon once the pending spans have been sent.
+func (b *batcher) close() {
+	close(b.stopCh)
+	<-b.doneCh
+}
@@ -0,0 +1,262 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	jaeger "github.com/uber/jaeger-client-go/thrift-gen/jaeger"
+)
+
+type (
+	// span is the backend-neutral representation of a trace span.
+	span struct {
+		traceID  string // 16 or 32 lower case hex digits
+		spanID   string // 16 lower case hex digits
+		parentID string // 16 lower case hex digits, empty for root spans
+		name     string
+		start    time.Time
+		duration time.Duration
+		service  string
+		tags     map[string]interface{}
+	}
+
+	// exporter sends a batch of spans to a tracing backend.
+	exporter interface {
+		name() string
+		export(spans []*span) error
+	}
+
+	// httpError is returned for requests rejected by a tracing backend.
+	httpError struct {
+		url    string
+		status int
+	}
+
+	zipkinExporter struct {
+		url    string
+		client *http.Client
+	}
+
+	jaegerExporter struct {
+		url    string
+		client *http.Client
+	}
+
+	// zipkinSpan is the JSON encoding of a span in the Zipkin v2 API.
+	zipkinSpan struct {
+		TraceID       string            `json:"traceId"`
+		ID            string            `json:"id"`
+		ParentID      string            `json:"parentId,omitempty"`
+		Name          string            `json:"name"`
+		Kind          string            `json:"kind"`
+		Timestamp     int64             `json:"timestamp"`
+		Duration      int64             `json:"duration"`
+		LocalEndpoint zipkinEndpoint    `json:"localEndpoint"`
+		Tags          map[string]string `json:"tags,omitempty"`
+	}
+
+	zipkinEndpoint struct {
+		ServiceName string `json:"serviceName"`
+	}
+)
+
+const (
+	// zipkinKindServer marks spans as recorded by the server side of a request,
+	// which is where Mixer observes the traffic.
+	zipkinKindServer = "SERVER"
+
+	// jaegerFlagSampled marks spans as sampled; Mixer only sees spans of sampled traces.
+	jaegerFlagSampled = 1
+)
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("request to %s failed with status %d", e.url, e.status)
+}
+
+// retryable checks whether a failed export may succeed when repeated. Requests
+// rejected as malformed are not retried.
+func retryable(err error) bool {
+	if e, ok := err.(*httpError); ok {
+		return e.status >= http.StatusInternalServerError || e.status == http.StatusTooManyRequests
+	}
+	return true
+}
+
+func post(client *http.Client, url, contentType string, body io.Reader) error {
+	resp, err := client.Post(url, contentType, body)
+	if err != nil {
+		return err
+	}
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &httpError{url: url, status: resp.StatusCode}
+	}
+	return nil
+}
+
+func (z *zipkinExporter) name() string { return "zipkin" }
+
+func (z *zipkinExporter) export(spans []*span) error {
+	out := make([]*zipkinSpan, 0, len(spans))
+	for _, s := range spans {
+		zs := &zipkinSpan{
+			TraceID:       s.traceID,
+			ID:            s.spanID,
+			ParentID:      s.parentID,
+			Name:          s.name,
+			Kind:          zipkinKindServer,
+			Timestamp:     s.start.UnixNano() / int64(time.Microsecond),
+			Duration:      toMicros(s.duration),
+			LocalEndpoint: zipkinEndpoint{ServiceName: s.service},
+		}
+		if len(s.tags) > 0 {
+			zs.Tags = make(map[string]string, len(s.tags))
+			for k, v := range s.tags {
+				zs.Tags[k] = fmt.Sprint(v)
+			}
+		}
+		out = append(out, zs)
+	}
+
+	body, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return post(z.client, z.url, "application/json", bytes.NewReader(body))
+}
+
+func (j *jaegerExporter) name() string { return "jaeger" }
+
+// export sends one Thrift encoded batch per service, as a Jaeger batch carries a single process.
+func (j *jaegerExporter) export(spans []*span) error {
+	var services []string
+	byService := make(map[string][]*jaeger.Span)
+	for _, s := range spans {
+		js, err := toJaegerSpan(s)
+		if err != nil {
+			return err
+		}
+		if _, ok := byService[s.service]; !ok {
+			services = append(services, s.service)
+		}
+		byService[s.service] = append(byService[s.service], js)
+	}
+
+	for _, service := range services {
+		batch := &jaeger.Batch{
+			Process: &jaeger.Process{ServiceName: service},
+			Spans:   byService[service],
+		}
+		body, err := serializeThrift(batch)
+		if err != nil {
+			return err
+		}
+		if err = post(j.client, j.url, "application/x-thrift", body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func serializeThrift(obj thrift.TStruct) (*bytes.Buffer, error) {
+	t := thrift.NewTMemoryBuffer()
+	p := thrift.NewTBinaryProtocolTransport(t)
+	if err := obj.Write(p); err != nil {
+		return nil, err
+	}
+	return t.Buffer, nil
+}
+
+func toJaegerSpan(s *span) (*jaeger.Span, error) {
+	high, low, err := parseTraceID(s.traceID)
+	if err != nil {
+		return nil, err
+	}
+	spanID, err := parseID(s.spanID)
+	if err != nil {
+		return nil, err
+	}
+	var parentID int64
+	if s.parentID != "" {
+		if parentID, err = parseID(s.parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	js := &jaeger.Span{
+		TraceIdHigh:   high,
+		TraceIdLow:    low,
+		SpanId:        spanID,
+		ParentSpanId:  parentID,
+		OperationName: s.name,
+		Flags:         jaegerFlagSampled,
+		StartTime:     s.start.UnixNano() / int64(time.Microsecond),
+		Duration:      toMicros(s.duration),
+	}
+	for k, v := range s.tags {
+		js.Tags = append(js.Tags, toJaegerTag(k, v))
+	}
+	return js, nil
+}
+
+func toJaegerTag(key string, value interface{}) *jaeger.Tag {
+	switch v := value.(type) {
+	case bool:
+		return &jaeger.Tag{Key: key, VType: jaeger.TagType_BOOL, VBool: &v}
+	case int64:
+		return &jaeger.Tag{Key: key, VType: jaeger.TagType_LONG, VLong: &v}
+	case float64:
+		return &jaeger.Tag{Key: key, VType: jaeger.TagType_DOUBLE, VDouble: &v}
+	default:
+		str := fmt.Sprint(v)
+		return &jaeger.Tag{Key: key, VType: jaeger.TagType_STRING, VStr: &str}
+	}
+}
+
+// parseTraceID splits a 16 or 32 digit hex trace ID into its high and low 64 bits.
+func parseTraceID(id string) (high, low int64, err error) {
+	if len(id) > 16 {
+		if high, err = parseID(id[:len(id)-16]); err != nil {
+			return 0, 0, err
+		}
+		id = id[len(id)-16:]
+	}
+	low, err = parseID(id)
+	return high, low, err
+}
+
+func parseID(id string) (int64, error) {
+	v, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: %v", id, err)
+	}
+	return int64(v), nil
+}
+
+func toMicros(d time.Duration) int64 {
+	return int64(d / time.Microsecond)
+}
@@ -0,0 +1,277 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate $GOPATH/src/istio.io/istio/bin/mixer_codegen.sh -f mixer/adapter/tracing/config/config.proto
+
+// Package tracing provides an adapter that implements the tracespan template
+// to export trace spans to Zipkin and Jaeger.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+
+	"istio.io/istio/mixer/adapter/tracing/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/template/tracespan"
+)
+
+const (
+	defaultServiceName   = "istio-mesh"
+	defaultBatchSize     = 100
+	defaultFlushInterval = time.Second
+	defaultBufferSize    = 10000
+	defaultMaxRetries    = 3
+	defaultRetryBackoff  = 100 * time.Millisecond
+	defaultTimeout       = 5 * time.Second
+)
+
+type (
+	builder struct {
+		adapterConfig *config.Params
+	}
+
+	handler struct {
+		service string
+		batcher *batcher
+	}
+)
+
+// ensure our types implement the requisite interfaces
+var _ tracespan.HandlerBuilder = &builder{}
+var _ tracespan.Handler = &handler{}
+
+///////////////// Configuration-time Methods ///////////////
+
+func (b *builder) SetAdapterConfig(cfg adapter.Config)          { b.adapterConfig = cfg.(*config.Params) }
+func (b *builder) SetTraceSpanTypes(map[string]*tracespan.Type) {}
+
+func (b *builder) Validate() (ce *adapter.ConfigErrors) {
+	ac := b.adapterConfig
+	if ac.ZipkinUrl == "" && ac.JaegerUrl == "" {
+		ce = ce.Appendf("zipkinUrl", "at least one of zipkinUrl and jaegerUrl must be specified")
+	}
+	if ac.ZipkinUrl != "" {
+		if _, err := url.Parse(ac.ZipkinUrl); err != nil {
+			ce = ce.Appendf("zipkinUrl", "invalid URL '%s': %v", ac.ZipkinUrl, err)
+		}
+	}
+	if ac.JaegerUrl != "" {
+		if _, err := url.Parse(ac.JaegerUrl); err != nil {
+			ce = ce.Appendf("jaegerUrl", "invalid URL '%s': %v", ac.JaegerUrl, err)
+		}
+	}
+	if ac.BatchSize < 0 {
+		ce = ce.Appendf("batchSize", "batch size must be >= 0")
+	}
+	if ac.FlushInterval < 0 {
+		ce = ce.Appendf("flushInterval", "flush interval must be >= 0")
+	}
+	if ac.BufferSize < 0 {
+		ce = ce.Appendf("bufferSize", "buffer size must be >= 0")
+	}
+	if ac.BatchSize > 0 && ac.BufferSize > 0 && ac.BufferSize < ac.BatchSize {
+		ce = ce.Appendf("bufferSize", "buffer size must be >= batch size")
+	}
+	if ac.MaxRetries < -1 {
+		ce = ce.Appendf("maxRetries", "max retries must be >= 0, or -1 to disable retries")
+	}
+	if ac.RetryBackoff < 0 {
+		ce = ce.Appendf("retryBackoff", "retry backoff must be >= 0")
+	}
+	if ac.Timeout < 0 {
+		ce = ce.Appendf("timeout", "timeout must be >= 0")
+	}
+	return
+}
+
+func (b *builder) Build(context context.Context, env adapter.Env) (adapter.Handler, error) {
+	ac := b.adapterConfig
+
+	timeout := ac.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+
+	var exporters []exporter
+	if ac.ZipkinUrl != "" {
+		exporters = append(exporters, &zipkinExporter{url: ac.ZipkinUrl, client: client})
+	}
+	if ac.JaegerUrl != "" {
+		exporters = append(exporters, &jaegerExporter{url: ac.JaegerUrl, client: client})
+	}
+
+	service := ac.ServiceName
+	if service == "" {
+		service = defaultServiceName
+	}
+
+	return &handler{
+		service: service,
+		batcher: newBatcher(exporters,
+			intOrDefault(ac.BatchSize, defaultBatchSize),
+			intOrDefault(ac.BufferSize, defaultBufferSize),
+			maxRetries(ac.MaxRetries),
+			durationOrDefault(ac.FlushInterval, defaultFlushInterval),
+			durationOrDefault(ac.RetryBackoff, defaultRetryBackoff),
+			env),
+	}, nil
+}
+
+// maxRetries returns the configured number of retries. An explicit 0 cannot be told
+// apart from an unset field, so retries are disabled with -1 instead.
+func maxRetries(v int32) int {
+	switch {
+	case v < 0:
+		return 0
+	case v == 0:
+		return defaultMaxRetries
+	}
+	return int(v)
+}
+
+func intOrDefault(v int32, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return int(v)
+}
+
+func durationOrDefault(v, def time.Duration) time.Duration {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+////////////////// Request-time Methods //////////////////////////
+
+// HandleTraceSpan queues the spans for export. Invalid instances are reported and skipped.
+func (h *handler) HandleTraceSpan(_ context.Context, values []*tracespan.Instance) error {
+	var result *multierror.Error
+	spans := make([]*span, 0, len(values))
+	for _, v := range values {
+		s, err := h.toSpan(v)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid span %s: %v", v.Name, err))
+			continue
+		}
+		spans = append(spans, s)
+	}
+	h.batcher.add(spans)
+	return result.ErrorOrNil()
+}
+
+func (h *handler) toSpan(v *tracespan.Instance) (*span, error) {
+	if v.SpanName == "" {
+		return nil, fmt.Errorf("missing span name")
+	}
+	if v.StartTime.IsZero() || v.EndTime.Before(v.StartTime) {
+		return nil, fmt.Errorf("invalid span time range [%v, %v]", v.StartTime, v.EndTime)
+	}
+
+	width := 16
+	if len(v.TraceId) > width {
+		width = 32
+	}
+	traceID, err := normalizeID(v.TraceId, width)
+	if err != nil {
+		return nil, fmt.Errorf("trace ID: %v", err)
+	}
+
+	var spanID string
+	if v.SpanId == "" {
+		spanID = randomID()
+	} else if spanID, err = normalizeID(v.SpanId, 16); err != nil {
+		return nil, fmt.Errorf("span ID: %v", err)
+	}
+
+	var parentID string
+	if v.ParentSpanId != "" {
+		if parentID, err = normalizeID(v.ParentSpanId, 16); err != nil {
+			return nil, fmt.Errorf("parent span ID: %v", err)
+		}
+	}
+
+	return &span{
+		traceID:  traceID,
+		spanID:   spanID,
+		parentID: parentID,
+		name:     v.SpanName,
+		start:    v.StartTime,
+		duration: v.EndTime.Sub(v.StartTime),
+		service:  h.service,
+		tags:     v.SpanTags,
+	}, nil
+}
+
+// normalizeID converts a hex ID to lower case, left padded with zeros to the given number of digits.
+func normalizeID(id string, width int) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("missing ID")
+	}
+	if len(id) > width {
+		return "", fmt.Errorf("'%s' is longer than %d hex digits", id, width)
+	}
+	if _, err := hex.DecodeString(strings.Repeat("0", len(id)%2) + id); err != nil {
+		return "", fmt.Errorf("'%s' is not a hex string", id)
+	}
+	return strings.Repeat("0", width-len(id)) + strings.ToLower(id), nil
+}
+
+// randomID generates a span ID for spans that were not assigned one by the proxy.
+func randomID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+func (h *handler) Close() error {
+	h.batcher.close()
+	return nil
+}
+
+////////////////// Bootstrap //////////////////////////
+
+// GetInfo returns the adapter.Info specific to this adapter.
+func GetInfo() adapter.Info {
+	return adapter.Info{
+		Name:        "tracing",
+		Impl:        "istio.io/istio/mixer/adapter/tracing",
+		Description: "Exports trace spans to Zipkin and Jaeger",
+		SupportedTemplates: []string{
+			tracespan.TemplateName,
+		},
+		NewBuilder: func() adapter.HandlerBuilder { return &builder{} },
+		DefaultConfig: &config.Params{
+			ZipkinUrl:     "http://zipkin:9411/api/v2/spans",
+			ServiceName:   defaultServiceName,
+			BatchSize:     defaultBatchSize,
+			FlushInterval: defaultFlushInterval,
+			BufferSize:    defaultBufferSize,
+			MaxRetries:    defaultMaxRetries,
+			RetryBackoff:  defaultRetryBackoff,
+			Timeout:       defaultTimeout,
+		},
+	}
+}
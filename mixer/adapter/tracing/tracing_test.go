@@ -0,0 +1,361 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	jaeger "github.com/uber/jaeger-client-go/thrift-gen/jaeger"
+
+	"istio.io/istio/mixer/adapter/tracing/config"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/adapter/test"
+	"istio.io/istio/mixer/template/tracespan"
+)
+
+// collector is a local stand-in for a tracing backend. It fails the first
+// failures requests with the given status.
+type collector struct {
+	mu       sync.Mutex
+	failures int
+	status   int
+	requests int
+	bodies   [][]byte
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests++
+	if c.failures > 0 {
+		c.failures--
+		w.WriteHeader(c.status)
+		return
+	}
+	c.bodies = append(c.bodies, body)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (c *collector) received() (int, [][]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests, c.bodies
+}
+
+func newHandler(t *testing.T, params *config.Params) (*handler, *test.Env) {
+	env := test.NewEnv(t)
+	b := GetInfo().NewBuilder().(*builder)
+	b.SetAdapterConfig(params)
+	b.SetTraceSpanTypes(nil)
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Validate() => %v", err)
+	}
+	h, err := b.Build(context.Background(), env)
+	if err != nil {
+		t.Fatalf("Build() => %v", err)
+	}
+	return h.(*handler), env
+}
+
+func closeHandler(t *testing.T, h *handler, env *test.Env) {
+	if err := h.Close(); err != nil {
+		t.Errorf("Close() => %v", err)
+	}
+	<-env.GetDoneChan()
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		params *config.Params
+		errors []string
+	}{
+		{"default", GetInfo().DefaultConfig.(*config.Params), nil},
+		{"no endpoints", &config.Params{}, []string{"zipkinUrl"}},
+		{"bad url", &config.Params{JaegerUrl: "http://%zz"}, []string{"jaegerUrl"}},
+		{"negative", &config.Params{
+			ZipkinUrl:     "http://zipkin",
+			BatchSize:     -1,
+			FlushInterval: -time.Second,
+			BufferSize:    -1,
+			MaxRetries:    -2,
+			RetryBackoff:  -time.Second,
+			Timeout:       -time.Second,
+		}, []string{"batchSize", "flushInterval", "bufferSize", "maxRetries", "retryBackoff", "timeout"}},
+		{"small buffer", &config.Params{ZipkinUrl: "http://zipkin", BatchSize: 10, BufferSize: 5}, []string{"bufferSize"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := &builder{}
+			b.SetAdapterConfig(c.params)
+			ce := b.Validate()
+			if len(c.errors) == 0 {
+				if ce != nil {
+					t.Errorf("Validate() => %v, want no errors", ce)
+				}
+				return
+			}
+			if ce == nil || len(ce.Multi.Errors) != len(c.errors) {
+				t.Fatalf("Validate() => %v, want errors for %v", ce, c.errors)
+			}
+			for i, field := range c.errors {
+				if ce.Multi.Errors[i].(adapter.ConfigError).Field != field {
+					t.Errorf("got error %v, want error for field %s", ce.Multi.Errors[i], field)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleTraceSpan(t *testing.T) {
+	zipkin, jaegerCollector := &collector{}, &collector{}
+	zipkinServer := httptest.NewServer(zipkin)
+	defer zipkinServer.Close()
+	jaegerServer := httptest.NewServer(jaegerCollector)
+	defer jaegerServer.Close()
+
+	h, env := newHandler(t, &config.Params{
+		ZipkinUrl:   zipkinServer.URL,
+		JaegerUrl:   jaegerServer.URL,
+		ServiceName: "productpage",
+		BatchSize:   10,
+	})
+
+	start := time.Unix(1500000000, 0)
+	err := h.HandleTraceSpan(context.Background(), []*tracespan.Instance{
+		{
+			Name:         "span",
+			TraceId:      "463AC35C9F6413AD48485A3953BB6124",
+			SpanId:       "a2fb4a1d1a96d312",
+			ParentSpanId: "10",
+			SpanName:     "/productpage",
+			StartTime:    start,
+			EndTime:      start.Add(10 * time.Millisecond),
+			SpanTags: map[string]interface{}{
+				"http.status_code": int64(200),
+				"source.ip":        net.ParseIP("10.1.1.1"),
+			},
+		},
+		{
+			Name:      "span",
+			TraceId:   "abc",
+			SpanName:  "/reviews",
+			StartTime: start,
+			EndTime:   start,
+		},
+		{
+			Name:      "span",
+			TraceId:   "not-hex",
+			SpanName:  "/ratings",
+			StartTime: start,
+			EndTime:   start,
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "trace ID") {
+		t.Errorf("HandleTraceSpan() => %v, want error for invalid trace ID", err)
+	}
+	closeHandler(t, h, env)
+
+	_, bodies := zipkin.received()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d zipkin requests, want 1", len(bodies))
+	}
+	var spans []*zipkinSpan
+	if err = json.Unmarshal(bodies[0], &spans); err != nil {
+		t.Fatal(err)
+	}
+	if len(spans) != 2 {
+		t.Fatalf("got %d zipkin spans, want 2", len(spans))
+	}
+	got := spans[0]
+	if got.TraceID != "463ac35c9f6413ad48485a3953bb6124" || got.ID != "a2fb4a1d1a96d312" ||
+		got.ParentID != "0000000000000010" || got.Name != "/productpage" {
+		t.Errorf("got zipkin span %+v", got)
+	}
+	if got.Timestamp != 1500000000000000 || got.Duration != 10000 {
+		t.Errorf("got timestamp %d and duration %d", got.Timestamp, got.Duration)
+	}
+	if got.LocalEndpoint.ServiceName != "productpage" {
+		t.Errorf("got service %q", got.LocalEndpoint.ServiceName)
+	}
+	if got.Tags["http.status_code"] != "200" || got.Tags["source.ip"] != "10.1.1.1" {
+		t.Errorf("got tags %v", got.Tags)
+	}
+	if spans[1].TraceID != "0000000000000abc" || len(spans[1].ID) != 16 || spans[1].ParentID != "" {
+		t.Errorf("got zipkin span %+v", spans[1])
+	}
+
+	_, bodies = jaegerCollector.received()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d jaeger requests, want 1", len(bodies))
+	}
+	batch := jaeger.NewBatch()
+	buf := thrift.NewTMemoryBuffer()
+	_, _ = buf.Write(bodies[0])
+	if err = batch.Read(thrift.NewTBinaryProtocolTransport(buf)); err != nil {
+		t.Fatal(err)
+	}
+	if batch.Process.ServiceName != "productpage" || len(batch.Spans) != 2 {
+		t.Fatalf("got jaeger batch %v", batch)
+	}
+	js := batch.Spans[0]
+	if uint64(js.TraceIdHigh) != 0x463ac35c9f6413ad || uint64(js.TraceIdLow) != 0x48485a3953bb6124 ||
+		uint64(js.SpanId) != 0xa2fb4a1d1a96d312 || js.ParentSpanId != 0x10 {
+		t.Errorf("got jaeger span IDs %v", js)
+	}
+	if js.StartTime != 1500000000000000 || js.Duration != 10000 || js.OperationName != "/productpage" {
+		t.Errorf("got jaeger span %v", js)
+	}
+	for _, tag := range js.Tags {
+		switch tag.Key {
+		case "http.status_code":
+			if tag.VType != jaeger.TagType_LONG || *tag.VLong != 200 {
+				t.Errorf("got tag %v", tag)
+			}
+		case "source.ip":
+			if tag.VType != jaeger.TagType_STRING || *tag.VStr != "10.1.1.1" {
+				t.Errorf("got tag %v", tag)
+			}
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	cases := []struct {
+		name       string
+		maxRetries int32
+		failures   int
+		status     int
+		requests   int
+		received   int
+	}{
+		{"recovers", 3, 2, http.StatusServiceUnavailable, 3, 1},
+		{"gives up", 3, 5, http.StatusServiceUnavailable, 4, 0},
+		{"rejected", 3, 1, http.StatusBadRequest, 1, 0},
+		{"disabled", -1, 1, http.StatusServiceUnavailable, 1, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			zipkin := &collector{failures: c.failures, status: c.status}
+			server := httptest.NewServer(zipkin)
+			defer server.Close()
+
+			h, env := newHandler(t, &config.Params{
+				ZipkinUrl:     server.URL,
+				BatchSize:     1,
+				MaxRetries:    c.maxRetries,
+				RetryBackoff:  time.Millisecond,
+				FlushInterval: time.Hour,
+			})
+			now := time.Now()
+			if err := h.HandleTraceSpan(context.Background(), []*tracespan.Instance{
+				{TraceId: "1", SpanName: "/", StartTime: now, EndTime: now},
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			// the full batch is sent without waiting for the flush interval
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				requests, bodies := zipkin.received()
+				if requests == c.requests && len(bodies) == c.received {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("got %d requests and %d successful, want %d and %d",
+						requests, len(bodies), c.requests, c.received)
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+			closeHandler(t, h, env)
+		})
+	}
+}
+
+type fakeExporter struct {
+	spans []*span
+}
+
+func (f *fakeExporter) name() string { return "fake" }
+
+func (f *fakeExporter) export(spans []*span) error {
+	f.spans = append(f.spans, spans...)
+	return nil
+}
+
+func TestMaxRetries(t *testing.T) {
+	for in, want := range map[int32]int{-1: 0, 0: defaultMaxRetries, 5: 5} {
+		if got := maxRetries(in); got != want {
+			t.Errorf("maxRetries(%d) => %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestBatcherBounded(t *testing.T) {
+	env := test.NewEnv(t)
+	e := &fakeExporter{}
+	b := newBatcher([]exporter{e}, 10, 2, 0, time.Hour, time.Millisecond, env)
+
+	b.add([]*span{{name: "a"}, {name: "b"}, {name: "c"}})
+	b.close()
+	<-env.GetDoneChan()
+
+	if len(e.spans) != 2 || e.spans[0].name != "a" || e.spans[1].name != "b" {
+		t.Errorf("got exported spans %v, want a and b", e.spans)
+	}
+	logs := env.GetLogs()
+	if len(logs) != 1 || !strings.Contains(logs[0], "dropping 1 spans") {
+		t.Errorf("got logs %v, want a dropped span warning", logs)
+	}
+}
+
+func TestNormalizeID(t *testing.T) {
+	cases := []struct {
+		in    string
+		width int
+		want  string
+		err   bool
+	}{
+		{in: "ABC", width: 16, want: "0000000000000abc"},
+		{in: "463ac35c9f6413ad48485a3953bb6124", width: 32, want: "463ac35c9f6413ad48485a3953bb6124"},
+		{in: "", width: 16, err: true},
+		{in: "463ac35c9f6413ad4", width: 16, err: true},
+		{in: "xyz", width: 16, err: true},
+	}
+	for _, c := range cases {
+		got, err := normalizeID(c.in, c.width)
+		if c.err {
+			if err == nil {
+				t.Errorf("normalizeID(%q) => got %q, want error", c.in, got)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("normalizeID(%q) => got (%q, %v), want %q", c.in, got, err, c.want)
+		}
+	}
+}
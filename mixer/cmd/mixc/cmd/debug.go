@@ -0,0 +1,151 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/cmd/shared"
+	"istio.io/istio/mixer/pkg/runtime"
+)
+
+// debugRulesPath is the path of the rule debugging endpoint of Mixer's monitoring server.
+const debugRulesPath = "/debug/rules"
+
+func debugCmd(rootArgs *rootArgs, printf, fatalf shared.FormatFn) *cobra.Command {
+	monitoringAddress := ""
+	dispatch := false
+
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Explains how Mixer's configuration applies to a set of attributes.",
+		Long: "The debug command sends a set of attributes to the monitoring\n" +
+			"port of Mixer, which evaluates the match conditions of its rules\n" +
+			"against them, and returns the matched rules along with the\n" +
+			"instances they build. Mixer must run with --enableDebugRules.\n" +
+			"The attribute generation instances are always dispatched. The\n" +
+			"check instances can optionally be dispatched to their handlers,\n" +
+			"in which case the results of the handlers are displayed as well.\n" +
+			"Quota and report instances are never dispatched.",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			debug(rootArgs, printf, fatalf, monitoringAddress, dispatch)
+		}}
+
+	cmd.PersistentFlags().StringVarP(&monitoringAddress, "monitoring_address", "", "localhost:9093",
+		"Address and port of the monitoring server of a running Mixer instance")
+	cmd.PersistentFlags().BoolVarP(&dispatch, "dispatch", "", false,
+		"Whether to dispatch the check instances to the handlers, and display their results")
+
+	return cmd
+}
+
+func debug(rootArgs *rootArgs, printf, fatalf shared.FormatFn, monitoringAddress string, dispatch bool) {
+	var attrs *mixerpb.CompressedAttributes
+	var err error
+
+	if attrs, err = parseAttributes(rootArgs); err != nil {
+		fatalf("%v", err)
+	}
+
+	body, err := proto.Marshal(attrs)
+	if err != nil {
+		fatalf("Unable to encode the attributes: %v", err)
+	}
+
+	url := "http://" + monitoringAddress + debugRulesPath + "?dispatch=" + strconv.FormatBool(dispatch)
+	resp, err := http.Post(url, "application/x-protobuf", bytes.NewReader(body))
+	if err != nil {
+		fatalf("Unable to reach %s: %v", monitoringAddress, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fatalf("Unable to read the response of %s: %v", monitoringAddress, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		fatalf("Debug request failed with %s: %s", resp.Status, bytes.TrimSpace(b))
+	}
+
+	var info runtime.DebugInfo
+	if err = json.Unmarshal(b, &info); err != nil {
+		fatalf("Unable to decode the response of %s: %v", monitoringAddress, err)
+	}
+	dumpDebugInfo(printf, &info)
+}
+
+func dumpDebugInfo(printf shared.FormatFn, info *runtime.DebugInfo) {
+	buf := bytes.Buffer{}
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Configuration %d\n", info.Resolver)
+	for _, e := range info.Errors {
+		fmt.Fprintf(tw, "  Error: %s\n", e)
+	}
+
+	fmt.Fprint(tw, "  Rule\tMatched\tDry Run\tMatch\n")
+	for _, r := range info.Rules {
+		fmt.Fprintf(tw, "  %s\t%v\t%v\t%s\n", r.Name, r.Matched, r.DryRun, r.Match)
+	}
+	_ = tw.Flush()
+
+	for _, r := range info.Rules {
+		if r.Error != "" {
+			fmt.Fprintf(&buf, "\nRule %s\n  Error: %s\n", r.Name, r.Error)
+			continue
+		}
+		if len(r.Actions) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "\nRule %s\n", r.Name)
+		for _, a := range r.Actions {
+			fmt.Fprintf(&buf, "  %s %s -> %s (%s)\n", a.Variety, a.Template, a.Handler, a.Adapter)
+			for _, i := range a.Instances {
+				fmt.Fprintf(&buf, "    Instance %s\n", i.Name)
+				if i.Fields != nil {
+					fmt.Fprintf(&buf, "      Fields: %s\n", toJSON(i.Fields))
+				}
+				if i.Result != nil {
+					fmt.Fprintf(&buf, "      Result: %s\n", toJSON(i.Result))
+				}
+				if i.Error != "" {
+					fmt.Fprintf(&buf, "      Error: %s\n", i.Error)
+				}
+			}
+		}
+	}
+
+	printf("%s", buf.String())
+}
+
+func toJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
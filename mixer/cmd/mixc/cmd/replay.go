@@ -0,0 +1,287 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/cmd/shared"
+	"istio.io/istio/mixer/pkg/recording"
+)
+
+// latencyBuckets are the upper bounds of the buckets of the latency histograms.
+var latencyBuckets = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+type replayArgs struct {
+	// file is the recording to replay.
+	file string
+
+	// rate scales the rate at which the requests were recorded. The requests are sent as fast as possible if 0.
+	rate float64
+
+	// compareAddress is the address of a second Mixer, whose check outcomes are compared to the ones of the first.
+	compareAddress string
+
+	// concurrency is the maximum number of requests in flight.
+	concurrency int
+}
+
+func replayCmd(rootArgs *rootArgs, printf, fatalf shared.FormatFn) *cobra.Command {
+	ra := &replayArgs{}
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replays the requests recorded by a Mixer against a running Mixer.",
+		Long: "The replay command sends the Check and Report requests of a\n" +
+			"recording made by Mixer's --recordFile option, at the rate they\n" +
+			"were recorded or at a scaled rate. It reports the latency of the\n" +
+			"requests and, when a second Mixer is given, the differences\n" +
+			"between the check outcomes of both Mixers.",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			replay(rootArgs, ra, printf, fatalf)
+		}}
+
+	cmd.PersistentFlags().StringVarP(&ra.file, "file", "f", "", "Recording to replay")
+	cmd.PersistentFlags().Float64VarP(&ra.rate, "rate", "", 1,
+		"Factor applied to the recorded rate of the requests, 2 replays twice as fast. If 0, the requests are sent as fast as possible")
+	cmd.PersistentFlags().StringVarP(&ra.compareAddress, "compare", "", "",
+		"Address and port of a second Mixer instance, whose check outcomes are compared to the ones of the first")
+	cmd.PersistentFlags().IntVarP(&ra.concurrency, "concurrency", "", 16, "Maximum number of requests in flight")
+
+	return cmd
+}
+
+// replayTarget is a Mixer the requests are replayed against.
+type replayTarget struct {
+	address string
+	cs      *clientState
+	check   latencyHistogram
+	report  latencyHistogram
+}
+
+func replay(rootArgs *rootArgs, ra *replayArgs, printf, fatalf shared.FormatFn) {
+	if ra.file == "" {
+		fatalf("A recording must be specified with --file")
+	}
+	if ra.rate < 0 || ra.concurrency <= 0 {
+		fatalf("The rate must be >= 0 and the concurrency > 0")
+	}
+
+	f, err := os.Open(ra.file)
+	if err != nil {
+		fatalf("Unable to open the recording: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	r, err := recording.NewReader(f)
+	if err != nil {
+		fatalf("Unable to read %s: %v", ra.file, err)
+	}
+
+	addresses := []string{rootArgs.mixerAddress}
+	if ra.compareAddress != "" {
+		addresses = append(addresses, ra.compareAddress)
+	}
+	targets := make([]*replayTarget, 0, len(addresses))
+	for _, address := range addresses {
+		var cs *clientState
+		if cs, err = createAPIClient(address, rootArgs.tracingOptions); err != nil {
+			fatalf("Unable to establish connection to %s: %v", address, err)
+		}
+		defer deleteAPIClient(cs)
+		targets = append(targets, &replayTarget{address: address, cs: cs})
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	diffs := 0
+	inFlight := make(chan struct{}, ra.concurrency)
+
+	start := time.Now()
+	var first time.Duration
+	for n := 0; ; n++ {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fatalf("Unable to read %s: %v", ra.file, err)
+		}
+		if err = r.Localize(e); err != nil {
+			fatalf("Unable to decode the attributes of request %d: %v", n, err)
+		}
+
+		if n == 0 {
+			first = e.Offset
+		}
+		if ra.rate > 0 {
+			time.Sleep(time.Until(start.Add(time.Duration(float64(e.Offset-first) / ra.rate))))
+		}
+
+		inFlight <- struct{}{}
+		wg.Add(1)
+		go func(n int, e *recording.Entry) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			outcomes := make([]string, len(targets))
+			for i, t := range targets {
+				outcomes[i] = t.send(e)
+			}
+			if e.Kind == recording.Check && len(targets) > 1 && outcomes[0] != outcomes[1] {
+				mu.Lock()
+				diffs++
+				mu.Unlock()
+				printf("Check %d differs:\n  %s: %s\n  %s: %s", n, targets[0].address, outcomes[0],
+					targets[1].address, outcomes[1])
+			}
+		}(n, e)
+	}
+	wg.Wait()
+
+	printf("Replayed %s in %v", ra.file, time.Since(start))
+	for _, t := range targets {
+		t.check.dump(printf, fmt.Sprintf("Check latency of %s", t.address))
+		t.report.dump(printf, fmt.Sprintf("Report latency of %s", t.address))
+	}
+	if len(targets) > 1 {
+		printf("%d of %d checks differ between %s and %s", diffs, targets[0].check.count(),
+			targets[0].address, targets[1].address)
+	}
+}
+
+// send sends the request of the entry, and returns the outcome of checks.
+func (t *replayTarget) send(e *recording.Entry) string {
+	start := time.Now()
+	switch e.Kind {
+	case recording.Check:
+		resp, err := t.cs.client.Check(context.Background(), e.Check)
+		t.check.record(time.Since(start), err)
+		return checkOutcome(resp, err)
+	case recording.Report:
+		_, err := t.cs.client.Report(context.Background(), e.Report)
+		t.report.record(time.Since(start), err)
+	}
+	return ""
+}
+
+// checkOutcome describes the status of the preconditions, and the granted quotas of a check.
+func checkOutcome(resp *mixerpb.CheckResponse, err error) string {
+	if err != nil {
+		return "RPC failed with " + decodeError(err)
+	}
+
+	out := decodeStatus(resp.Precondition.Status)
+	names := make([]string, 0, len(resp.Quotas))
+	for name := range resp.Quotas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out += fmt.Sprintf(", quota %s granted %d", name, resp.Quotas[name].GrantedAmount)
+	}
+	return out
+}
+
+// latencyHistogram collects the latencies of a kind of requests.
+type latencyHistogram struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	errors    int
+}
+
+func (h *latencyHistogram) record(d time.Duration, err error) {
+	h.mu.Lock()
+	h.latencies = append(h.latencies, d)
+	if err != nil {
+		h.errors++
+	}
+	h.mu.Unlock()
+}
+
+func (h *latencyHistogram) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.latencies)
+}
+
+func (h *latencyHistogram) dump(printf shared.FormatFn, title string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) == 0 {
+		return
+	}
+	sort.Slice(h.latencies, func(i, j int) bool { return h.latencies[i] < h.latencies[j] })
+	percentile := func(p int) time.Duration {
+		return h.latencies[(len(h.latencies)-1)*p/100]
+	}
+
+	buf := bytes.Buffer{}
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "%s: %d requests, %d errors\n", title, len(h.latencies), h.errors)
+	fmt.Fprintf(tw, "  p50 %v, p90 %v, p99 %v, max %v\n", percentile(50), percentile(90), percentile(99),
+		h.latencies[len(h.latencies)-1])
+	fmt.Fprint(tw, "  Latency\tCount\tPercent\t\n")
+
+	i := 0
+	for b := 0; b <= len(latencyBuckets); b++ {
+		n := 0
+		label := "> " + latencyBuckets[len(latencyBuckets)-1].String()
+		if b < len(latencyBuckets) {
+			label = "<= " + latencyBuckets[b].String()
+			for ; i < len(h.latencies) && h.latencies[i] <= latencyBuckets[b]; i++ {
+				n++
+			}
+		} else {
+			n = len(h.latencies) - i
+		}
+		if n == 0 {
+			continue
+		}
+		pct := 100 * n / len(h.latencies)
+		fmt.Fprintf(tw, "  %s\t%d\t%d%%\t%s\n", label, n, pct, strings.Repeat("#", pct/2))
+	}
+
+	_ = tw.Flush()
+	printf("%s", buf.String())
+}
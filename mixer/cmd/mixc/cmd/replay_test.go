@@ -0,0 +1,91 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mixerpb "istio.io/api/mixer/v1"
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+)
+
+func TestCheckOutcome(t *testing.T) {
+	cases := []struct {
+		resp *mixerpb.CheckResponse
+		err  error
+		want string
+	}{
+		{
+			resp: &mixerpb.CheckResponse{Precondition: mixerpb.CheckResponse_PreconditionResult{
+				Status: rpc.Status{Code: int32(rpc.PERMISSION_DENIED), Message: "denied"},
+			}},
+			want: "PERMISSION_DENIED (denied)",
+		},
+		{
+			resp: &mixerpb.CheckResponse{Quotas: map[string]mixerpb.CheckResponse_QuotaResult{
+				"b": {GrantedAmount: 2},
+				"a": {GrantedAmount: 1},
+			}},
+			want: "OK, quota a granted 1, quota b granted 2",
+		},
+		{
+			err:  status.Errorf(codes.Unavailable, "down"),
+			want: "RPC failed with Unavailable (down)",
+		},
+	}
+
+	for _, c := range cases {
+		if got := checkOutcome(c.resp, c.err); got != c.want {
+			t.Errorf("checkOutcome() => %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	var h latencyHistogram
+	for i := 1; i <= 100; i++ {
+		var err error
+		if i%10 == 0 {
+			err = errors.New("failed")
+		}
+		h.record(time.Duration(i)*time.Millisecond, err)
+	}
+
+	var out string
+	h.dump(func(format string, args ...interface{}) {
+		out += fmt.Sprintf(format, args...)
+	}, "Check")
+
+	for _, want := range []string{
+		"Check: 100 requests, 10 errors",
+		"p50 50ms, p90 90ms, p99 99ms, max 100ms",
+		"<= 1ms    1      1%",
+		"<= 100ms  50     50%",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dump() =>\n%s\nwant it to contain %q", out, want)
+		}
+	}
+	if strings.Contains(out, "> 1s") {
+		t.Errorf("dump() =>\n%s\nwant no empty buckets", out)
+	}
+}
@@ -118,6 +118,8 @@ func GetRootCmd(args []string, printf, fatalf shared.FormatFn) *cobra.Command {
 
 	rootCmd.AddCommand(checkCmd(rootArgs, printf, fatalf))
 	rootCmd.AddCommand(reportCmd(rootArgs, printf, fatalf))
+	rootCmd.AddCommand(debugCmd(rootArgs, printf, fatalf))
+	rootCmd.AddCommand(replayCmd(rootArgs, printf, fatalf))
 	rootCmd.AddCommand(version.CobraCommand())
 
 	rootArgs.tracingOptions.AttachCobraFlags(rootCmd)
@@ -15,6 +15,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"istio.io/istio/mixer/cmd/shared"
@@ -47,14 +49,37 @@ func serverCmd(info map[string]template.Info, adapters []adapter.InfoFn, printf,
 	serverCmd.PersistentFlags().UintVarP(&sa.MaxConcurrentStreams, "maxConcurrentStreams", "", 1024, "Maximum number of outstanding RPCs per connection")
 	serverCmd.PersistentFlags().IntVarP(&sa.APIWorkerPoolSize, "apiWorkerPoolSize", "", 1024, "Max number of goroutines in the API worker pool")
 	serverCmd.PersistentFlags().IntVarP(&sa.AdapterWorkerPoolSize, "adapterWorkerPoolSize", "", 1024, "Max number of goroutines in the adapter worker pool")
+	serverCmd.PersistentFlags().DurationVarP(&sa.AdapterCheckTimeout, "adapterCheckTimeout", "", 0,
+		"Default maximum duration of the check calls to the adapters. No timeout is enforced if 0")
+	serverCmd.PersistentFlags().DurationVarP(&sa.AdapterReportTimeout, "adapterReportTimeout", "", 0,
+		"Default maximum duration of the report calls to the adapters. No timeout is enforced if 0")
+	serverCmd.PersistentFlags().DurationVarP(&sa.AdapterQuotaTimeout, "adapterQuotaTimeout", "", 0,
+		"Default maximum duration of the quota calls to the adapters. No timeout is enforced if 0")
+	serverCmd.PersistentFlags().DurationVarP(&sa.AdapterPreprocessTimeout, "adapterPreprocessTimeout", "", 0,
+		"Default maximum duration of the attribute generation calls to the adapters. No timeout is enforced if 0")
 	// TODO: what is the right default value for expressionEvalCacheSize.
 	serverCmd.PersistentFlags().IntVarP(&sa.ExpressionEvalCacheSize, "expressionEvalCacheSize", "", evaluator.DefaultCacheSize,
 		"Number of entries in the expression cache")
+	serverCmd.PersistentFlags().IntVarP(&sa.CheckCacheMaxEntries, "checkCacheMaxEntries", "", 0,
+		"Maximum number of Check results cached by Mixer, keyed on the attributes they depend on. The cache is disabled if 0. "+
+			"The bound counts entries, not bytes: an entry takes a few hundred bytes plus the size of its status message")
+	serverCmd.PersistentFlags().StringVarP(&sa.RecordFile, "recordFile", "", "",
+		"Path of the file into which a sample of the Check and Report requests is recorded, to be replayed with mixc. Recording is disabled if empty")
+	serverCmd.PersistentFlags().Float64VarP(&sa.RecordSampleRate, "recordSampleRate", "", 0.01,
+		"Fraction of the Check and Report requests which are recorded. The sampled requests are dropped if the recording falls behind")
+	serverCmd.PersistentFlags().Int64VarP(&sa.RecordMaxSize, "recordMaxSize", "", 100*1024*1024,
+		"Number of bytes of requests, before compression, after which the recording stops. The size is not bounded if 0")
+	serverCmd.PersistentFlags().DurationVarP(&sa.RecordMaxDuration, "recordMaxDuration", "", time.Hour,
+		"Duration after which the recording stops. The duration is not bounded if 0")
 	serverCmd.PersistentFlags().BoolVarP(&sa.SingleThreaded, "singleThreaded", "", false,
 		"If true, each request to Mixer will be executed in a single go routine (useful for debugging)")
+	serverCmd.PersistentFlags().BoolVarP(&sa.EnableDebugRules, "enableDebugRules", "", false,
+		"If true, the /debug/rules endpoint of the monitoring port explains how the rules apply to posted attributes, "+
+			"and runs the attribute generation and check adapters on demand. Quota and report adapters are never called")
 
 	serverCmd.PersistentFlags().StringVarP(&sa.ConfigStoreURL, "configStoreURL", "", "",
-		"URL of the config store. Use k8s://path_to_kubeconfig or fs:// for file system. If path_to_kubeconfig is empty, in-cluster kubeconfig is used.")
+		"URL of the config store. Use k8s://path_to_kubeconfig or fs:// for file system. If path_to_kubeconfig is empty, in-cluster kubeconfig is used. "+
+			"Use etcd://host:port/prefix or consul://host:port/prefix for resources stored in etcd or Consul.")
 
 	serverCmd.PersistentFlags().StringVarP(&sa.ConfigDefaultNamespace, "configDefaultNamespace", "", mixerRuntime.DefaultConfigNamespace,
 		"Namespace used to store mesh wide configuration.")
@@ -56,8 +56,11 @@ type (
 		// use this method or ScheduleWork instead.
 		ScheduleDaemon(fn DaemonFunc)
 
+		// The time remaining until Mixer considers a call to a handler as timed out is not part of Env:
+		// it is the deadline of the context.Context the handler is called with, which is cancelled when
+		// the call times out. Handlers should pass the context on to their remote calls.
+
 		// Possible other features for Env:
-		// Return how much time remains until Mixer considers the aspect call having timed out and kills it
 		// Return true/false to indicate this is a 'recovery mode' execution following a prior crash of the aspect
 		// ?
 	}
@@ -0,0 +1,84 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quotautil provides the selection of quota limits shared by the quota adapters.
+package quotautil
+
+import (
+	"fmt"
+	"time"
+
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/template/quota"
+)
+
+type (
+	// Limit is implemented by Quota and Override messages.
+	Limit interface {
+		GetMaxAmount() int64
+		GetValidDuration() time.Duration
+	}
+
+	// Override is implemented by Override messages, it applies to the instances
+	// whose dimensions match its dimensions.
+	Override interface {
+		Limit
+		GetDimensions() map[string]string
+	}
+
+	// Overrides gives access to the configured overrides of a quota, in order.
+	Overrides interface {
+		Len() int
+		At(i int) Override
+	}
+)
+
+// MatchDimensions matches configured dimensions with dimensions of the instance.
+func MatchDimensions(cfg map[string]string, inst map[string]interface{}) bool {
+	for k, val := range cfg {
+		rval := inst[k]
+		if rval == val { // this dimension matches, on to next comparison.
+			continue
+		}
+
+		// if rval has a string representation then compare it with val
+		// For example net.ip has a useful string representation.
+		if v, ok := rval.(fmt.Stringer); ok && v.String() == val {
+			continue
+		}
+		// rval does not match val.
+		return false
+	}
+	return true
+}
+
+// SelectLimit returns the limit associated with this particular request.
+// Check if the instance matches an override, else return the default limit.
+func SelectLimit(def Limit, overrides Overrides, instance *quota.Instance, l adapter.Logger) Limit {
+	for idx := 0; idx < overrides.Len(); idx++ {
+		o := overrides.At(idx)
+		if MatchDimensions(o.GetDimensions(), instance.Dimensions) {
+			if l.VerbosityLevel(4) {
+				l.Infof("quota override: %v selected for %v", o, *instance)
+			}
+			// all dimensions matched, we found the override.
+			return o
+		}
+	}
+	if l.VerbosityLevel(4) {
+		l.Infof("quota default: %v selected for %v", def.GetMaxAmount(), *instance)
+	}
+	// no overrides, use default limit.
+	return def
+}
@@ -0,0 +1,71 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotautil
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"istio.io/istio/mixer/pkg/adapter/test"
+	"istio.io/istio/mixer/template/quota"
+)
+
+type testLimit struct {
+	maxAmount  int64
+	dimensions map[string]string
+}
+
+func (l *testLimit) GetMaxAmount() int64              { return l.maxAmount }
+func (l *testLimit) GetValidDuration() time.Duration  { return time.Second }
+func (l *testLimit) GetDimensions() map[string]string { return l.dimensions }
+
+type testOverrides []testLimit
+
+func (o testOverrides) Len() int          { return len(o) }
+func (o testOverrides) At(i int) Override { return &o[i] }
+
+func TestSelectLimit(t *testing.T) {
+	def := &testLimit{maxAmount: 42}
+	inst := &quota.Instance{
+		Dimensions: map[string]interface{}{
+			"destination": "dest1",
+			"source.ip":   net.ParseIP("192.10.1.118"),
+		},
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		overrides testOverrides
+		limit     int64
+	}{
+		{"no override", nil, 42},
+		{"override no match", testOverrides{{75, map[string]string{"destination": "dest2"}}}, 42},
+		{"override match", testOverrides{{75, map[string]string{"destination": "dest1"}}}, 75},
+		{"override match ip", testOverrides{{75, map[string]string{"source.ip": "192.10.1.118"}}}, 75},
+		{"override no dim", testOverrides{{75, nil}}, 75},
+		{"first match", testOverrides{{75, nil}, {80, map[string]string{"destination": "dest1"}}}, 75},
+		{"missing dimension", testOverrides{{75, map[string]string{"source": "src1"}}}, 42},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			env := test.NewEnv(t)
+			l := SelectLimit(def, tc.overrides, inst, env.Logger())
+
+			if l.GetMaxAmount() != tc.limit {
+				t.Fatalf("got %v, want %v", l.GetMaxAmount(), tc.limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate $GOPATH/src/istio.io/istio/bin/mixer_codegen.sh -f mixer/pkg/adapter/remote/remote.proto
+
+// Package remote defines the protocol through which Mixer dispatches instances to the adapters
+// deployed out of process. Each template has a generated handler service, whose messages are built
+// from the types of this package; the functions below convert between them and the Go types that
+// Mixer hands to the in-process adapters.
+package remote // import "istio.io/istio/mixer/pkg/adapter/remote"
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"istio.io/istio/mixer/pkg/adapter"
+)
+
+// EncodeValue converts the value of an instance field into its wire representation.
+func EncodeValue(v interface{}) (*Value, error) {
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &Value{Value: &Value_StringValue{StringValue: t}}, nil
+	case int64:
+		return &Value{Value: &Value_Int64Value{Int64Value: t}}, nil
+	case int:
+		return &Value{Value: &Value_Int64Value{Int64Value: int64(t)}}, nil
+	case float64:
+		return &Value{Value: &Value_DoubleValue{DoubleValue: t}}, nil
+	case bool:
+		return &Value{Value: &Value_BoolValue{BoolValue: t}}, nil
+	case []byte:
+		return &Value{Value: &Value_IpAddressValue{IpAddressValue: t}}, nil
+	case net.IP:
+		return &Value{Value: &Value_IpAddressValue{IpAddressValue: t}}, nil
+	case time.Time:
+		ts, err := types.TimestampProto(t)
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Value: &Value_TimestampValue{TimestampValue: ts}}, nil
+	case time.Duration:
+		return &Value{Value: &Value_DurationValue{DurationValue: types.DurationProto(t)}}, nil
+	case adapter.EmailAddress:
+		return &Value{Value: &Value_EmailAddressValue{EmailAddressValue: string(t)}}, nil
+	case adapter.DNSName:
+		return &Value{Value: &Value_DnsNameValue{DnsNameValue: string(t)}}, nil
+	case adapter.URI:
+		return &Value{Value: &Value_UriValue{UriValue: string(t)}}, nil
+	case map[string]string:
+		return &Value{Value: &Value_StringMapValue{StringMapValue: &StringMap{Entries: t}}}, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
+
+// DecodeValue converts a value back into the Go type Mixer uses for it. IP addresses are
+// returned as byte slices, as they are by the expression evaluator.
+func DecodeValue(v *Value) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch t := v.Value.(type) {
+	case *Value_StringValue:
+		return t.StringValue, nil
+	case *Value_Int64Value:
+		return t.Int64Value, nil
+	case *Value_DoubleValue:
+		return t.DoubleValue, nil
+	case *Value_BoolValue:
+		return t.BoolValue, nil
+	case *Value_IpAddressValue:
+		return t.IpAddressValue, nil
+	case *Value_TimestampValue:
+		return types.TimestampFromProto(t.TimestampValue)
+	case *Value_DurationValue:
+		return types.DurationFromProto(t.DurationValue)
+	case *Value_EmailAddressValue:
+		return adapter.EmailAddress(t.EmailAddressValue), nil
+	case *Value_DnsNameValue:
+		return adapter.DNSName(t.DnsNameValue), nil
+	case *Value_UriValue:
+		return adapter.URI(t.UriValue), nil
+	case *Value_StringMapValue:
+		return t.StringMapValue.GetEntries(), nil
+	}
+	return nil, fmt.Errorf("unsupported value %v", v)
+}
+
+// DecodeDuration decodes the value of a field of type istio.mixer.v1.template.Duration.
+func DecodeDuration(v *Value) (time.Duration, error) {
+	if v == nil {
+		return 0, nil
+	}
+	d, ok := v.Value.(*Value_DurationValue)
+	if !ok {
+		return 0, typeError(v, "duration")
+	}
+	return types.DurationFromProto(d.DurationValue)
+}
+
+// DecodeTimestamp decodes the value of a field of type istio.mixer.v1.template.TimeStamp.
+func DecodeTimestamp(v *Value) (time.Time, error) {
+	if v == nil {
+		return time.Time{}, nil
+	}
+	ts, ok := v.Value.(*Value_TimestampValue)
+	if !ok {
+		return time.Time{}, typeError(v, "timestamp")
+	}
+	return types.TimestampFromProto(ts.TimestampValue)
+}
+
+// DecodeIPAddress decodes the value of a field of type istio.mixer.v1.template.IPAddress.
+func DecodeIPAddress(v *Value) (net.IP, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ip, ok := v.Value.(*Value_IpAddressValue)
+	if !ok {
+		return nil, typeError(v, "IP address")
+	}
+	return net.IP(ip.IpAddressValue), nil
+}
+
+// DecodeDNSName decodes the value of a field of type istio.mixer.v1.template.DNSName.
+func DecodeDNSName(v *Value) (adapter.DNSName, error) {
+	if v == nil {
+		return "", nil
+	}
+	name, ok := v.Value.(*Value_DnsNameValue)
+	if !ok {
+		return "", typeError(v, "DNS name")
+	}
+	return adapter.DNSName(name.DnsNameValue), nil
+}
+
+// DecodeEmailAddress decodes the value of a field of type istio.mixer.v1.template.EmailAddress.
+func DecodeEmailAddress(v *Value) (adapter.EmailAddress, error) {
+	if v == nil {
+		return "", nil
+	}
+	addr, ok := v.Value.(*Value_EmailAddressValue)
+	if !ok {
+		return "", typeError(v, "email address")
+	}
+	return adapter.EmailAddress(addr.EmailAddressValue), nil
+}
+
+// DecodeURI decodes the value of a field of type istio.mixer.v1.template.Uri.
+func DecodeURI(v *Value) (adapter.URI, error) {
+	if v == nil {
+		return "", nil
+	}
+	uri, ok := v.Value.(*Value_UriValue)
+	if !ok {
+		return "", typeError(v, "URI")
+	}
+	return adapter.URI(uri.UriValue), nil
+}
+
+func typeError(v *Value, want string) error {
+	return fmt.Errorf("value %v is not a %s", v, want)
+}
+
+// EncodeCheckResult converts the result of a check handler into its wire representation.
+func EncodeCheckResult(r adapter.CheckResult) *CheckResult {
+	return &CheckResult{
+		Status:        r.Status,
+		ValidDuration: r.ValidDuration,
+		ValidUseCount: r.ValidUseCount,
+	}
+}
+
+// DecodeCheckResult converts the response of a check handler service.
+func DecodeCheckResult(r *CheckResult) adapter.CheckResult {
+	return adapter.CheckResult{
+		Status:        r.GetStatus(),
+		ValidDuration: r.GetValidDuration(),
+		ValidUseCount: r.GetValidUseCount(),
+	}
+}
+
+// EncodeQuotaArgs converts the arguments of a quota allocation into their wire representation.
+func EncodeQuotaArgs(args adapter.QuotaArgs) *QuotaArgs {
+	return &QuotaArgs{
+		DeduplicationId: args.DeduplicationID,
+		QuotaAmount:     args.QuotaAmount,
+		BestEffort:      args.BestEffort,
+	}
+}
+
+// DecodeQuotaArgs converts the arguments of a quota handler service request.
+func DecodeQuotaArgs(args *QuotaArgs) adapter.QuotaArgs {
+	return adapter.QuotaArgs{
+		DeduplicationID: args.GetDeduplicationId(),
+		QuotaAmount:     args.GetQuotaAmount(),
+		BestEffort:      args.GetBestEffort(),
+	}
+}
+
+// EncodeQuotaResult converts the result of a quota handler into its wire representation.
+func EncodeQuotaResult(r adapter.QuotaResult) *QuotaResult {
+	return &QuotaResult{
+		Status:        r.Status,
+		ValidDuration: r.ValidDuration,
+		Amount:        r.Amount,
+	}
+}
+
+// DecodeQuotaResult converts the response of a quota handler service.
+func DecodeQuotaResult(r *QuotaResult) adapter.QuotaResult {
+	return adapter.QuotaResult{
+		Status:        r.GetStatus(),
+		ValidDuration: r.GetValidDuration(),
+		Amount:        r.GetAmount(),
+	}
+}
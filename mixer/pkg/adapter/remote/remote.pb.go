@@ -0,0 +1,3043 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mixer/pkg/adapter/remote/remote.proto
+
+/*
+	Package remote is a generated protocol buffer package.
+
+	The messages shared by the handler services of all the templates, through which Mixer
+	dispatches instances to the adapters deployed out of process.
+
+	It is generated from these files:
+		mixer/pkg/adapter/remote/remote.proto
+
+	It has these top-level messages:
+		Value
+		StringMap
+		CheckResult
+		QuotaArgs
+		QuotaResult
+		ReportResult
+*/
+package remote
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/gogo/protobuf/types"
+import google_protobuf2 "github.com/gogo/protobuf/types"
+import google_rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+
+import time "time"
+
+import bytes "bytes"
+
+import strings "strings"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import encoding_binary "encoding/binary"
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Value is the wire representation of the dynamically typed fields of the instances, as
+// well as of the fields of the standard template types.
+type Value struct {
+	// Types that are valid to be assigned to Value:
+	//	*Value_StringValue
+	//	*Value_Int64Value
+	//	*Value_DoubleValue
+	//	*Value_BoolValue
+	//	*Value_IpAddressValue
+	//	*Value_TimestampValue
+	//	*Value_DurationValue
+	//	*Value_EmailAddressValue
+	//	*Value_DnsNameValue
+	//	*Value_UriValue
+	//	*Value_StringMapValue
+	Value isValue_Value `protobuf_oneof:"value"`
+}
+
+func (m *Value) Reset()                    { *m = Value{} }
+func (*Value) ProtoMessage()               {}
+func (*Value) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{0} }
+
+type isValue_Value interface {
+	isValue_Value()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+type Value_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+type Value_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+type Value_IpAddressValue struct {
+	IpAddressValue []byte `protobuf:"bytes,5,opt,name=ip_address_value,json=ipAddressValue,proto3,oneof"`
+}
+type Value_TimestampValue struct {
+	TimestampValue *google_protobuf2.Timestamp `protobuf:"bytes,6,opt,name=timestamp_value,json=timestampValue,oneof"`
+}
+type Value_DurationValue struct {
+	DurationValue *google_protobuf1.Duration `protobuf:"bytes,7,opt,name=duration_value,json=durationValue,oneof"`
+}
+type Value_EmailAddressValue struct {
+	EmailAddressValue string `protobuf:"bytes,8,opt,name=email_address_value,json=emailAddressValue,proto3,oneof"`
+}
+type Value_DnsNameValue struct {
+	DnsNameValue string `protobuf:"bytes,9,opt,name=dns_name_value,json=dnsNameValue,proto3,oneof"`
+}
+type Value_UriValue struct {
+	UriValue string `protobuf:"bytes,10,opt,name=uri_value,json=uriValue,proto3,oneof"`
+}
+type Value_StringMapValue struct {
+	StringMapValue *StringMap `protobuf:"bytes,11,opt,name=string_map_value,json=stringMapValue,oneof"`
+}
+
+func (*Value_StringValue) isValue_Value()       {}
+func (*Value_Int64Value) isValue_Value()        {}
+func (*Value_DoubleValue) isValue_Value()       {}
+func (*Value_BoolValue) isValue_Value()         {}
+func (*Value_IpAddressValue) isValue_Value()    {}
+func (*Value_TimestampValue) isValue_Value()    {}
+func (*Value_DurationValue) isValue_Value()     {}
+func (*Value_EmailAddressValue) isValue_Value() {}
+func (*Value_DnsNameValue) isValue_Value()      {}
+func (*Value_UriValue) isValue_Value()          {}
+func (*Value_StringMapValue) isValue_Value()    {}
+
+func (m *Value) GetValue() isValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Value) GetStringValue() string {
+	if x, ok := m.GetValue().(*Value_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (m *Value) GetInt64Value() int64 {
+	if x, ok := m.GetValue().(*Value_Int64Value); ok {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (m *Value) GetDoubleValue() float64 {
+	if x, ok := m.GetValue().(*Value_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (m *Value) GetBoolValue() bool {
+	if x, ok := m.GetValue().(*Value_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (m *Value) GetIpAddressValue() []byte {
+	if x, ok := m.GetValue().(*Value_IpAddressValue); ok {
+		return x.IpAddressValue
+	}
+	return nil
+}
+
+func (m *Value) GetTimestampValue() *google_protobuf2.Timestamp {
+	if x, ok := m.GetValue().(*Value_TimestampValue); ok {
+		return x.TimestampValue
+	}
+	return nil
+}
+
+func (m *Value) GetDurationValue() *google_protobuf1.Duration {
+	if x, ok := m.GetValue().(*Value_DurationValue); ok {
+		return x.DurationValue
+	}
+	return nil
+}
+
+func (m *Value) GetEmailAddressValue() string {
+	if x, ok := m.GetValue().(*Value_EmailAddressValue); ok {
+		return x.EmailAddressValue
+	}
+	return ""
+}
+
+func (m *Value) GetDnsNameValue() string {
+	if x, ok := m.GetValue().(*Value_DnsNameValue); ok {
+		return x.DnsNameValue
+	}
+	return ""
+}
+
+func (m *Value) GetUriValue() string {
+	if x, ok := m.GetValue().(*Value_UriValue); ok {
+		return x.UriValue
+	}
+	return ""
+}
+
+func (m *Value) GetStringMapValue() *StringMap {
+	if x, ok := m.GetValue().(*Value_StringMapValue); ok {
+		return x.StringMapValue
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Value) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Value_OneofMarshaler, _Value_OneofUnmarshaler, _Value_OneofSizer, []interface{}{
+		(*Value_StringValue)(nil),
+		(*Value_Int64Value)(nil),
+		(*Value_DoubleValue)(nil),
+		(*Value_BoolValue)(nil),
+		(*Value_IpAddressValue)(nil),
+		(*Value_TimestampValue)(nil),
+		(*Value_DurationValue)(nil),
+		(*Value_EmailAddressValue)(nil),
+		(*Value_DnsNameValue)(nil),
+		(*Value_UriValue)(nil),
+		(*Value_StringMapValue)(nil),
+	}
+}
+
+func _Value_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Value)
+	// value
+	switch x := m.Value.(type) {
+	case *Value_StringValue:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.StringValue)
+	case *Value_Int64Value:
+		_ = b.EncodeVarint(2<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Int64Value))
+	case *Value_DoubleValue:
+		_ = b.EncodeVarint(3<<3 | proto.WireFixed64)
+		_ = b.EncodeFixed64(math.Float64bits(x.DoubleValue))
+	case *Value_BoolValue:
+		t := uint64(0)
+		if x.BoolValue {
+			t = 1
+		}
+		_ = b.EncodeVarint(4<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *Value_IpAddressValue:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.IpAddressValue)
+	case *Value_TimestampValue:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TimestampValue); err != nil {
+			return err
+		}
+	case *Value_DurationValue:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DurationValue); err != nil {
+			return err
+		}
+	case *Value_EmailAddressValue:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.EmailAddressValue)
+	case *Value_DnsNameValue:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.DnsNameValue)
+	case *Value_UriValue:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.UriValue)
+	case *Value_StringMapValue:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StringMapValue); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Value.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Value_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Value)
+	switch tag {
+	case 1: // value.string_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Value_StringValue{x}
+		return true, err
+	case 2: // value.int64_value
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Value_Int64Value{int64(x)}
+		return true, err
+	case 3: // value.double_value
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.Value = &Value_DoubleValue{math.Float64frombits(x)}
+		return true, err
+	case 4: // value.bool_value
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Value_BoolValue{x != 0}
+		return true, err
+	case 5: // value.ip_address_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &Value_IpAddressValue{x}
+		return true, err
+	case 6: // value.timestamp_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(google_protobuf2.Timestamp)
+		err := b.DecodeMessage(msg)
+		m.Value = &Value_TimestampValue{msg}
+		return true, err
+	case 7: // value.duration_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(google_protobuf1.Duration)
+		err := b.DecodeMessage(msg)
+		m.Value = &Value_DurationValue{msg}
+		return true, err
+	case 8: // value.email_address_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Value_EmailAddressValue{x}
+		return true, err
+	case 9: // value.dns_name_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Value_DnsNameValue{x}
+		return true, err
+	case 10: // value.uri_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Value_UriValue{x}
+		return true, err
+	case 11: // value.string_map_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StringMap)
+		err := b.DecodeMessage(msg)
+		m.Value = &Value_StringMapValue{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Value_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Value)
+	// value
+	switch x := m.Value.(type) {
+	case *Value_StringValue:
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.StringValue)))
+		n += len(x.StringValue)
+	case *Value_Int64Value:
+		n += proto.SizeVarint(2<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Int64Value))
+	case *Value_DoubleValue:
+		n += proto.SizeVarint(3<<3 | proto.WireFixed64)
+		n += 8
+	case *Value_BoolValue:
+		n += proto.SizeVarint(4<<3 | proto.WireVarint)
+		n += 1
+	case *Value_IpAddressValue:
+		n += proto.SizeVarint(5<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.IpAddressValue)))
+		n += len(x.IpAddressValue)
+	case *Value_TimestampValue:
+		s := proto.Size(x.TimestampValue)
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Value_DurationValue:
+		s := proto.Size(x.DurationValue)
+		n += proto.SizeVarint(7<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Value_EmailAddressValue:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.EmailAddressValue)))
+		n += len(x.EmailAddressValue)
+	case *Value_DnsNameValue:
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.DnsNameValue)))
+		n += len(x.DnsNameValue)
+	case *Value_UriValue:
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.UriValue)))
+		n += len(x.UriValue)
+	case *Value_StringMapValue:
+		s := proto.Size(x.StringMapValue)
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// StringMap holds the values of type STRING_MAP.
+type StringMap struct {
+	Entries map[string]string `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *StringMap) Reset()                    { *m = StringMap{} }
+func (*StringMap) ProtoMessage()               {}
+func (*StringMap) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{1} }
+
+func (m *StringMap) GetEntries() map[string]string {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// CheckResult is the response of the handler services of the check templates.
+type CheckResult struct {
+	// The outcome status of the operation.
+	Status google_rpc.Status `protobuf:"bytes,1,opt,name=status" json:"status"`
+	// The amount of time for which the result can be considered valid.
+	ValidDuration time.Duration `protobuf:"bytes,2,opt,name=valid_duration,json=validDuration,stdduration" json:"valid_duration"`
+	// The number of uses for which the result can be considered valid.
+	ValidUseCount int32 `protobuf:"varint,3,opt,name=valid_use_count,json=validUseCount,proto3" json:"valid_use_count,omitempty"`
+}
+
+func (m *CheckResult) Reset()                    { *m = CheckResult{} }
+func (*CheckResult) ProtoMessage()               {}
+func (*CheckResult) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{2} }
+
+func (m *CheckResult) GetStatus() google_rpc.Status {
+	if m != nil {
+		return m.Status
+	}
+	return google_rpc.Status{}
+}
+
+func (m *CheckResult) GetValidDuration() time.Duration {
+	if m != nil {
+		return m.ValidDuration
+	}
+	return 0
+}
+
+func (m *CheckResult) GetValidUseCount() int32 {
+	if m != nil {
+		return m.ValidUseCount
+	}
+	return 0
+}
+
+// QuotaArgs supplies the arguments of the quota allocations.
+type QuotaArgs struct {
+	// Deduplicates the retries of a quota allocation.
+	DeduplicationId string `protobuf:"bytes,1,opt,name=deduplication_id,json=deduplicationId,proto3" json:"deduplication_id,omitempty"`
+	// The amount of quota being allocated.
+	QuotaAmount int64 `protobuf:"varint,2,opt,name=quota_amount,json=quotaAmount,proto3" json:"quota_amount,omitempty"`
+	// Whether less quota than requested may be allocated.
+	BestEffort bool `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+}
+
+func (m *QuotaArgs) Reset()                    { *m = QuotaArgs{} }
+func (*QuotaArgs) ProtoMessage()               {}
+func (*QuotaArgs) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{3} }
+
+func (m *QuotaArgs) GetDeduplicationId() string {
+	if m != nil {
+		return m.DeduplicationId
+	}
+	return ""
+}
+
+func (m *QuotaArgs) GetQuotaAmount() int64 {
+	if m != nil {
+		return m.QuotaAmount
+	}
+	return 0
+}
+
+func (m *QuotaArgs) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+// QuotaResult is the response of the handler services of the quota templates.
+type QuotaResult struct {
+	// The outcome status of the operation.
+	Status google_rpc.Status `protobuf:"bytes,1,opt,name=status" json:"status"`
+	// The amount of time until which the allocated quota expires.
+	ValidDuration time.Duration `protobuf:"bytes,2,opt,name=valid_duration,json=validDuration,stdduration" json:"valid_duration"`
+	// The amount of quota allocated.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QuotaResult) Reset()                    { *m = QuotaResult{} }
+func (*QuotaResult) ProtoMessage()               {}
+func (*QuotaResult) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{4} }
+
+func (m *QuotaResult) GetStatus() google_rpc.Status {
+	if m != nil {
+		return m.Status
+	}
+	return google_rpc.Status{}
+}
+
+func (m *QuotaResult) GetValidDuration() time.Duration {
+	if m != nil {
+		return m.ValidDuration
+	}
+	return 0
+}
+
+func (m *QuotaResult) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// ReportResult is the response of the handler services of the report templates.
+type ReportResult struct {
+}
+
+func (m *ReportResult) Reset()                    { *m = ReportResult{} }
+func (*ReportResult) ProtoMessage()               {}
+func (*ReportResult) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{5} }
+
+func init() {
+	proto.RegisterType((*Value)(nil), "istio.mixer.adapter.remote.v1.Value")
+	proto.RegisterType((*StringMap)(nil), "istio.mixer.adapter.remote.v1.StringMap")
+	proto.RegisterType((*CheckResult)(nil), "istio.mixer.adapter.remote.v1.CheckResult")
+	proto.RegisterType((*QuotaArgs)(nil), "istio.mixer.adapter.remote.v1.QuotaArgs")
+	proto.RegisterType((*QuotaResult)(nil), "istio.mixer.adapter.remote.v1.QuotaResult")
+	proto.RegisterType((*ReportResult)(nil), "istio.mixer.adapter.remote.v1.ReportResult")
+}
+func (this *Value) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value)
+	if !ok {
+		that2, ok := that.(Value)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if that1.Value == nil {
+		if this.Value != nil {
+			return false
+		}
+	} else if this.Value == nil {
+		return false
+	} else if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	return true
+}
+func (this *Value_StringValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_StringValue)
+	if !ok {
+		that2, ok := that.(Value_StringValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.StringValue != that1.StringValue {
+		return false
+	}
+	return true
+}
+func (this *Value_Int64Value) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_Int64Value)
+	if !ok {
+		that2, ok := that.(Value_Int64Value)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Int64Value != that1.Int64Value {
+		return false
+	}
+	return true
+}
+func (this *Value_DoubleValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_DoubleValue)
+	if !ok {
+		that2, ok := that.(Value_DoubleValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DoubleValue != that1.DoubleValue {
+		return false
+	}
+	return true
+}
+func (this *Value_BoolValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_BoolValue)
+	if !ok {
+		that2, ok := that.(Value_BoolValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.BoolValue != that1.BoolValue {
+		return false
+	}
+	return true
+}
+func (this *Value_IpAddressValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_IpAddressValue)
+	if !ok {
+		that2, ok := that.(Value_IpAddressValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.IpAddressValue, that1.IpAddressValue) {
+		return false
+	}
+	return true
+}
+func (this *Value_TimestampValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_TimestampValue)
+	if !ok {
+		that2, ok := that.(Value_TimestampValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.TimestampValue.Equal(that1.TimestampValue) {
+		return false
+	}
+	return true
+}
+func (this *Value_DurationValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_DurationValue)
+	if !ok {
+		that2, ok := that.(Value_DurationValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.DurationValue.Equal(that1.DurationValue) {
+		return false
+	}
+	return true
+}
+func (this *Value_EmailAddressValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_EmailAddressValue)
+	if !ok {
+		that2, ok := that.(Value_EmailAddressValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.EmailAddressValue != that1.EmailAddressValue {
+		return false
+	}
+	return true
+}
+func (this *Value_DnsNameValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_DnsNameValue)
+	if !ok {
+		that2, ok := that.(Value_DnsNameValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DnsNameValue != that1.DnsNameValue {
+		return false
+	}
+	return true
+}
+func (this *Value_UriValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_UriValue)
+	if !ok {
+		that2, ok := that.(Value_UriValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.UriValue != that1.UriValue {
+		return false
+	}
+	return true
+}
+func (this *Value_StringMapValue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Value_StringMapValue)
+	if !ok {
+		that2, ok := that.(Value_StringMapValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.StringMapValue.Equal(that1.StringMapValue) {
+		return false
+	}
+	return true
+}
+func (this *StringMap) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*StringMap)
+	if !ok {
+		that2, ok := that.(StringMap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if this.Entries[i] != that1.Entries[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CheckResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*CheckResult)
+	if !ok {
+		that2, ok := that.(CheckResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if this.ValidDuration != that1.ValidDuration {
+		return false
+	}
+	if this.ValidUseCount != that1.ValidUseCount {
+		return false
+	}
+	return true
+}
+func (this *QuotaArgs) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuotaArgs)
+	if !ok {
+		that2, ok := that.(QuotaArgs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DeduplicationId != that1.DeduplicationId {
+		return false
+	}
+	if this.QuotaAmount != that1.QuotaAmount {
+		return false
+	}
+	if this.BestEffort != that1.BestEffort {
+		return false
+	}
+	return true
+}
+func (this *QuotaResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuotaResult)
+	if !ok {
+		that2, ok := that.(QuotaResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if this.ValidDuration != that1.ValidDuration {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	return true
+}
+func (this *ReportResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ReportResult)
+	if !ok {
+		that2, ok := that.(ReportResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *Value) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&remote.Value{")
+	if this.Value != nil {
+		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Value_StringValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_StringValue{` +
+		`StringValue:` + fmt.Sprintf("%#v", this.StringValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_Int64Value) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_Int64Value{` +
+		`Int64Value:` + fmt.Sprintf("%#v", this.Int64Value) + `}`}, ", ")
+	return s
+}
+func (this *Value_DoubleValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_DoubleValue{` +
+		`DoubleValue:` + fmt.Sprintf("%#v", this.DoubleValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_BoolValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_BoolValue{` +
+		`BoolValue:` + fmt.Sprintf("%#v", this.BoolValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_IpAddressValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_IpAddressValue{` +
+		`IpAddressValue:` + fmt.Sprintf("%#v", this.IpAddressValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_TimestampValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_TimestampValue{` +
+		`TimestampValue:` + fmt.Sprintf("%#v", this.TimestampValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_DurationValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_DurationValue{` +
+		`DurationValue:` + fmt.Sprintf("%#v", this.DurationValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_EmailAddressValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_EmailAddressValue{` +
+		`EmailAddressValue:` + fmt.Sprintf("%#v", this.EmailAddressValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_DnsNameValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_DnsNameValue{` +
+		`DnsNameValue:` + fmt.Sprintf("%#v", this.DnsNameValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_UriValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_UriValue{` +
+		`UriValue:` + fmt.Sprintf("%#v", this.UriValue) + `}`}, ", ")
+	return s
+}
+func (this *Value_StringMapValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&remote.Value_StringMapValue{` +
+		`StringMapValue:` + fmt.Sprintf("%#v", this.StringMapValue) + `}`}, ", ")
+	return s
+}
+func (this *StringMap) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&remote.StringMap{")
+	keysForEntries := make([]string, 0, len(this.Entries))
+	for k, _ := range this.Entries {
+		keysForEntries = append(keysForEntries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEntries)
+	mapStringForEntries := "map[string]string{"
+	for _, k := range keysForEntries {
+		mapStringForEntries += fmt.Sprintf("%#v: %#v,", k, this.Entries[k])
+	}
+	mapStringForEntries += "}"
+	if this.Entries != nil {
+		s = append(s, "Entries: "+mapStringForEntries+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&remote.CheckResult{")
+	s = append(s, "Status: "+strings.Replace(this.Status.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ValidDuration: "+fmt.Sprintf("%#v", this.ValidDuration)+",\n")
+	s = append(s, "ValidUseCount: "+fmt.Sprintf("%#v", this.ValidUseCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuotaArgs) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&remote.QuotaArgs{")
+	s = append(s, "DeduplicationId: "+fmt.Sprintf("%#v", this.DeduplicationId)+",\n")
+	s = append(s, "QuotaAmount: "+fmt.Sprintf("%#v", this.QuotaAmount)+",\n")
+	s = append(s, "BestEffort: "+fmt.Sprintf("%#v", this.BestEffort)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuotaResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&remote.QuotaResult{")
+	s = append(s, "Status: "+strings.Replace(this.Status.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "ValidDuration: "+fmt.Sprintf("%#v", this.ValidDuration)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReportResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&remote.ReportResult{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRemote(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Value) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		nn1, err := m.Value.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
+}
+
+func (m *Value_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(len(m.StringValue)))
+	i += copy(dAtA[i:], m.StringValue)
+	return i, nil
+}
+func (m *Value_Int64Value) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(m.Int64Value))
+	return i, nil
+}
+func (m *Value_DoubleValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x19
+	i++
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DoubleValue))))
+	i += 8
+	return i, nil
+}
+func (m *Value_BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x20
+	i++
+	if m.BoolValue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *Value_IpAddressValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IpAddressValue != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(len(m.IpAddressValue)))
+		i += copy(dAtA[i:], m.IpAddressValue)
+	}
+	return i, nil
+}
+func (m *Value_TimestampValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TimestampValue != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.TimestampValue.Size()))
+		n2, err := m.TimestampValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *Value_DurationValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DurationValue != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.DurationValue.Size()))
+		n3, err := m.DurationValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *Value_EmailAddressValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(len(m.EmailAddressValue)))
+	i += copy(dAtA[i:], m.EmailAddressValue)
+	return i, nil
+}
+func (m *Value_DnsNameValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(len(m.DnsNameValue)))
+	i += copy(dAtA[i:], m.DnsNameValue)
+	return i, nil
+}
+func (m *Value_UriValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(len(m.UriValue)))
+	i += copy(dAtA[i:], m.UriValue)
+	return i, nil
+}
+func (m *Value_StringMapValue) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StringMapValue != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.StringMapValue.Size()))
+		n4, err := m.StringMapValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *StringMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringMap) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for k, _ := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			v := m.Entries[k]
+			mapSize := 1 + len(k) + sovRemote(uint64(len(k))) + 1 + len(v) + sovRemote(uint64(len(v)))
+			i = encodeVarintRemote(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *CheckResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(m.Status.Size()))
+	n5, err := m.Status.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration)))
+	n6, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ValidDuration, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if m.ValidUseCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.ValidUseCount))
+	}
+	return i, nil
+}
+
+func (m *QuotaArgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaArgs) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeduplicationId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(len(m.DeduplicationId)))
+		i += copy(dAtA[i:], m.DeduplicationId)
+	}
+	if m.QuotaAmount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.QuotaAmount))
+	}
+	if m.BestEffort {
+		dAtA[i] = 0x18
+		i++
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *QuotaResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(m.Status.Size()))
+	n7, err := m.Status.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRemote(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration)))
+	n8, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ValidDuration, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.Amount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *ReportResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintRemote(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Value) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *Value_StringValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovRemote(uint64(l))
+	return n
+}
+func (m *Value_Int64Value) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovRemote(uint64(m.Int64Value))
+	return n
+}
+func (m *Value_DoubleValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *Value_BoolValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *Value_IpAddressValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.IpAddressValue != nil {
+		l = len(m.IpAddressValue)
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
+}
+func (m *Value_TimestampValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.TimestampValue != nil {
+		l = m.TimestampValue.Size()
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
+}
+func (m *Value_DurationValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.DurationValue != nil {
+		l = m.DurationValue.Size()
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
+}
+func (m *Value_EmailAddressValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.EmailAddressValue)
+	n += 1 + l + sovRemote(uint64(l))
+	return n
+}
+func (m *Value_DnsNameValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DnsNameValue)
+	n += 1 + l + sovRemote(uint64(l))
+	return n
+}
+func (m *Value_UriValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UriValue)
+	n += 1 + l + sovRemote(uint64(l))
+	return n
+}
+func (m *Value_StringMapValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.StringMapValue != nil {
+		l = m.StringMapValue.Size()
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
+}
+func (m *StringMap) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for k, v := range m.Entries {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRemote(uint64(len(k))) + 1 + len(v) + sovRemote(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRemote(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CheckResult) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovRemote(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration)
+	n += 1 + l + sovRemote(uint64(l))
+	if m.ValidUseCount != 0 {
+		n += 1 + sovRemote(uint64(m.ValidUseCount))
+	}
+	return n
+}
+
+func (m *QuotaArgs) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DeduplicationId)
+	if l > 0 {
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	if m.QuotaAmount != 0 {
+		n += 1 + sovRemote(uint64(m.QuotaAmount))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuotaResult) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovRemote(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ValidDuration)
+	n += 1 + l + sovRemote(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovRemote(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *ReportResult) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovRemote(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRemote(x uint64) (n int) {
+	return sovRemote(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Value) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_StringValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_StringValue{`,
+		`StringValue:` + fmt.Sprintf("%v", this.StringValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_Int64Value) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_Int64Value{`,
+		`Int64Value:` + fmt.Sprintf("%v", this.Int64Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_DoubleValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_DoubleValue{`,
+		`DoubleValue:` + fmt.Sprintf("%v", this.DoubleValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_BoolValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_BoolValue{`,
+		`BoolValue:` + fmt.Sprintf("%v", this.BoolValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_IpAddressValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_IpAddressValue{`,
+		`IpAddressValue:` + fmt.Sprintf("%v", this.IpAddressValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_TimestampValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_TimestampValue{`,
+		`TimestampValue:` + strings.Replace(fmt.Sprintf("%v", this.TimestampValue), "Timestamp", "google_protobuf2.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_DurationValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_DurationValue{`,
+		`DurationValue:` + strings.Replace(fmt.Sprintf("%v", this.DurationValue), "Duration", "google_protobuf1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_EmailAddressValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_EmailAddressValue{`,
+		`EmailAddressValue:` + fmt.Sprintf("%v", this.EmailAddressValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_DnsNameValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_DnsNameValue{`,
+		`DnsNameValue:` + fmt.Sprintf("%v", this.DnsNameValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_UriValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_UriValue{`,
+		`UriValue:` + fmt.Sprintf("%v", this.UriValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value_StringMapValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value_StringMapValue{`,
+		`StringMapValue:` + strings.Replace(fmt.Sprintf("%v", this.StringMapValue), "StringMap", "StringMap", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StringMap) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForEntries := make([]string, 0, len(this.Entries))
+	for k, _ := range this.Entries {
+		keysForEntries = append(keysForEntries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEntries)
+	mapStringForEntries := "map[string]string{"
+	for _, k := range keysForEntries {
+		mapStringForEntries += fmt.Sprintf("%v: %v,", k, this.Entries[k])
+	}
+	mapStringForEntries += "}"
+	s := strings.Join([]string{`&StringMap{`,
+		`Entries:` + mapStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckResult{`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "google_rpc.Status", 1), `&`, ``, 1) + `,`,
+		`ValidDuration:` + strings.Replace(strings.Replace(this.ValidDuration.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`ValidUseCount:` + fmt.Sprintf("%v", this.ValidUseCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaArgs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaArgs{`,
+		`DeduplicationId:` + fmt.Sprintf("%v", this.DeduplicationId) + `,`,
+		`QuotaAmount:` + fmt.Sprintf("%v", this.QuotaAmount) + `,`,
+		`BestEffort:` + fmt.Sprintf("%v", this.BestEffort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaResult{`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "google_rpc.Status", 1), `&`, ``, 1) + `,`,
+		`ValidDuration:` + strings.Replace(strings.Replace(this.ValidDuration.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportResult{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRemote(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Value) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Value_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &Value_Int64Value{v}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = &Value_DoubleValue{float64(math.Float64frombits(v))}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Value = &Value_BoolValue{b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpAddressValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Value = &Value_IpAddressValue{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &google_protobuf2.Timestamp{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Value_TimestampValue{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &google_protobuf1.Duration{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Value_DurationValue{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmailAddressValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Value_EmailAddressValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsNameValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Value_DnsNameValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Value_UriValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMapValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StringMap{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Value_StringMapValue{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entries == nil {
+				m.Entries = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRemote
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRemote
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRemote
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRemote
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRemote(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRemote
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ValidDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUseCount", wireType)
+			}
+			m.ValidUseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUseCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaArgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaArgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeduplicationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaAmount", wireType)
+			}
+			m.QuotaAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaAmount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ValidDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemote(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthRemote
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipRemote(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthRemote = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemote   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("mixer/pkg/adapter/remote/remote.proto", fileDescriptorRemote) }
+
+var fileDescriptorRemote = []byte{
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x4d, 0x93, 0x26, 0xcf, 0x69, 0x1a, 0x4c, 0x05, 0x25, 0x52, 0x9d, 0x34, 0x88,
+	0xca, 0x30, 0x38, 0xa5, 0x14, 0x84, 0xba, 0x35, 0xa5, 0x52, 0x40, 0x02, 0x84, 0x5b, 0x18, 0x58,
+	0x22, 0x27, 0xbe, 0x86, 0x53, 0x6d, 0x9f, 0xb9, 0x3b, 0x57, 0x94, 0x89, 0x8f, 0xc0, 0xd8, 0x1d,
+	0x06, 0x76, 0xbe, 0x44, 0xc7, 0x8e, 0x4c, 0x40, 0xc3, 0xc2, 0xd8, 0x8f, 0x80, 0x7c, 0x77, 0x0e,
+	0xb4, 0x48, 0xc0, 0xc8, 0x64, 0xdf, 0xff, 0xfd, 0xde, 0xdf, 0xef, 0xbd, 0x7b, 0x86, 0x6b, 0x11,
+	0x79, 0x85, 0x59, 0x27, 0xd9, 0x1b, 0x75, 0xfc, 0xc0, 0x4f, 0x04, 0x66, 0x1d, 0x86, 0x23, 0x2a,
+	0xb0, 0x7e, 0xb8, 0x09, 0xa3, 0x82, 0x5a, 0x8b, 0x84, 0x0b, 0x42, 0x5d, 0x09, 0xbb, 0x1a, 0x74,
+	0x35, 0xb1, 0x7f, 0xb3, 0x31, 0x3f, 0xa2, 0x23, 0x2a, 0xc9, 0x4e, 0xf6, 0xa6, 0x92, 0x1a, 0xf6,
+	0x88, 0xd2, 0x51, 0x88, 0x3b, 0xf2, 0x34, 0x48, 0x77, 0x3b, 0x41, 0xca, 0x7c, 0x41, 0x68, 0xac,
+	0xe3, 0xcd, 0xf3, 0x71, 0x41, 0x22, 0xcc, 0x85, 0x1f, 0x25, 0x1a, 0xb8, 0xac, 0x01, 0x96, 0x0c,
+	0x3b, 0x5c, 0xf8, 0x22, 0xe5, 0x2a, 0xd0, 0x7e, 0x3f, 0x0d, 0xc5, 0x67, 0x7e, 0x98, 0x62, 0xeb,
+	0x2a, 0x54, 0xb9, 0x60, 0x24, 0x1e, 0xf5, 0xf7, 0xb3, 0xf3, 0x02, 0x6a, 0x21, 0xa7, 0xd2, 0x33,
+	0x3c, 0x53, 0xa9, 0x0a, 0x5a, 0x02, 0x93, 0xc4, 0xe2, 0xce, 0x9a, 0x66, 0xa6, 0x5a, 0xc8, 0x29,
+	0xf4, 0x0c, 0x0f, 0xa4, 0x38, 0xf1, 0x09, 0x68, 0x3a, 0x08, 0xb1, 0x66, 0x0a, 0x2d, 0xe4, 0xa0,
+	0xcc, 0x47, 0xa9, 0x0a, 0x6a, 0x02, 0x0c, 0x28, 0x0d, 0x35, 0x32, 0xdd, 0x42, 0x4e, 0xb9, 0x67,
+	0x78, 0x95, 0x4c, 0x53, 0xc0, 0x0d, 0xa8, 0x93, 0xa4, 0xef, 0x07, 0x01, 0xc3, 0x9c, 0x6b, 0xac,
+	0xd8, 0x42, 0x4e, 0xb5, 0x67, 0x78, 0x35, 0x92, 0x6c, 0xa8, 0x80, 0x62, 0xb7, 0x60, 0x6e, 0xd2,
+	0xaf, 0x46, 0x4b, 0x2d, 0xe4, 0x98, 0xab, 0x0d, 0x57, 0xb5, 0xed, 0xe6, 0x73, 0x71, 0x77, 0x72,
+	0x2e, 0xb3, 0x99, 0x24, 0x29, 0x9b, 0x2e, 0xd4, 0xf2, 0xb1, 0x6a, 0x97, 0x19, 0xe9, 0x72, 0xe5,
+	0x37, 0x97, 0x7b, 0x1a, 0xeb, 0x19, 0xde, 0x6c, 0x9e, 0xa2, 0x3c, 0x56, 0xe0, 0x22, 0x8e, 0x7c,
+	0x12, 0x9e, 0xab, 0xbc, 0xac, 0x67, 0x79, 0x41, 0x06, 0xcf, 0x14, 0xbf, 0x0c, 0xb5, 0x20, 0xe6,
+	0xfd, 0xd8, 0x8f, 0xf2, 0x81, 0x55, 0x34, 0x5c, 0x0d, 0x62, 0xfe, 0xc8, 0x8f, 0xf4, 0xc4, 0x16,
+	0xa1, 0x92, 0x32, 0xa2, 0x11, 0xd0, 0x48, 0x39, 0x65, 0x44, 0x85, 0x77, 0xa0, 0xae, 0x6f, 0x2f,
+	0xf2, 0xf3, 0x21, 0x98, 0xb2, 0x7c, 0xc7, 0xfd, 0xe3, 0xc6, 0xb9, 0xdb, 0x32, 0xed, 0xa1, 0x2f,
+	0x47, 0xc2, 0xf3, 0x83, 0x74, 0xed, 0xce, 0x40, 0x51, 0x5a, 0xb5, 0x0f, 0x11, 0x54, 0x26, 0xa0,
+	0xf5, 0x18, 0x66, 0x70, 0x2c, 0x18, 0xc1, 0x7c, 0x01, 0xb5, 0x0a, 0x8e, 0xb9, 0x7a, 0xfb, 0x5f,
+	0xbf, 0xe1, 0x6e, 0xa9, 0xbc, 0xec, 0x71, 0xe0, 0xe5, 0x2e, 0x8d, 0x75, 0xa8, 0xfe, 0x1a, 0xb0,
+	0xea, 0x50, 0xd8, 0xc3, 0x07, 0x6a, 0x05, 0xbd, 0xec, 0xd5, 0x9a, 0x87, 0xe2, 0xcf, 0x95, 0xab,
+	0x78, 0xea, 0xb0, 0x3e, 0x75, 0x17, 0xb5, 0x3f, 0x22, 0x30, 0x37, 0x5f, 0xe0, 0xe1, 0x9e, 0x87,
+	0x79, 0x1a, 0x0a, 0x6b, 0x05, 0x4a, 0x6a, 0xc3, 0x65, 0xba, 0xb9, 0x6a, 0xe5, 0xd7, 0xc7, 0x92,
+	0xa1, 0xbb, 0x2d, 0x23, 0xdd, 0xe9, 0xa3, 0xcf, 0x4d, 0xc3, 0xd3, 0x9c, 0xf5, 0x00, 0x6a, 0xfb,
+	0x7e, 0x48, 0x82, 0x7e, 0x7e, 0x97, 0x0b, 0x53, 0x7f, 0xb9, 0xf8, 0x6e, 0x39, 0x33, 0x38, 0xfc,
+	0xd2, 0x44, 0xde, 0xac, 0x4c, 0xcd, 0x03, 0xd6, 0x32, 0xcc, 0x29, 0xaf, 0x94, 0xe3, 0xfe, 0x90,
+	0xa6, 0xb1, 0x90, 0x3f, 0x40, 0x51, 0x73, 0x4f, 0x39, 0xde, 0xcc, 0xc4, 0xf6, 0x6b, 0xa8, 0x3c,
+	0x49, 0xa9, 0xf0, 0x37, 0xd8, 0x88, 0x5b, 0xd7, 0xa1, 0x1e, 0xe0, 0x20, 0x4d, 0x42, 0x32, 0x54,
+	0xeb, 0x47, 0x02, 0xdd, 0xfb, 0xdc, 0x19, 0xfd, 0x7e, 0x60, 0x2d, 0x41, 0xf5, 0x65, 0x96, 0xd7,
+	0xf7, 0x23, 0x69, 0x2e, 0xff, 0x40, 0xcf, 0x94, 0xda, 0x86, 0x94, 0xac, 0x26, 0x98, 0x03, 0xcc,
+	0x45, 0x1f, 0xef, 0xee, 0x52, 0xa6, 0x3e, 0x5f, 0xf6, 0x20, 0x93, 0xb6, 0xa4, 0xd2, 0x7e, 0x87,
+	0xc0, 0x94, 0x1f, 0xff, 0x2f, 0x26, 0x76, 0x09, 0x4a, 0xba, 0x97, 0x82, 0xec, 0x45, 0x9f, 0xda,
+	0x35, 0xa8, 0x7a, 0x38, 0xa1, 0x4c, 0xa8, 0x2a, 0xbb, 0x6b, 0xc7, 0x27, 0xb6, 0xf1, 0xe9, 0xc4,
+	0x36, 0x4e, 0x4f, 0x6c, 0xf4, 0x66, 0x6c, 0xa3, 0x0f, 0x63, 0x1b, 0x1d, 0x8d, 0x6d, 0x74, 0x3c,
+	0xb6, 0xd1, 0xd7, 0xb1, 0x8d, 0xbe, 0x8f, 0x6d, 0xe3, 0x74, 0x6c, 0xa3, 0xb7, 0xdf, 0x6c, 0xe3,
+	0x79, 0x49, 0x2d, 0xdf, 0xa0, 0x24, 0x2b, 0xb9, 0xf5, 0x63, 0x00, 0xd0, 0xd3, 0x09, 0x4a, 0x9e,
+	0x05, 0x00, 0x00,
+}
@@ -0,0 +1,143 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/status"
+)
+
+func TestValues(t *testing.T) {
+	ts := time.Date(2018, 1, 2, 3, 4, 5, 6, time.UTC)
+	cases := []struct {
+		name string
+		in   interface{}
+		want interface{}
+	}{
+		{"nil", nil, nil},
+		{"string", "a", "a"},
+		{"int64", int64(42), int64(42)},
+		{"int", 42, int64(42)},
+		{"double", 4.2, 4.2},
+		{"bool", true, true},
+		{"bytes", []byte{1, 2, 3, 4}, []byte{1, 2, 3, 4}},
+		{"ip", net.IPv4(1, 2, 3, 4), []byte(net.IPv4(1, 2, 3, 4))},
+		{"timestamp", ts, ts},
+		{"duration", 3 * time.Second, 3 * time.Second},
+		{"email", adapter.EmailAddress("a@b.c"), adapter.EmailAddress("a@b.c")},
+		{"dns", adapter.DNSName("a.b.c"), adapter.DNSName("a.b.c")},
+		{"uri", adapter.URI("http://a"), adapter.URI("http://a")},
+		{"string map", map[string]string{"a": "b"}, map[string]string{"a": "b"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := EncodeValue(c.in)
+			if err != nil {
+				t.Fatalf("EncodeValue(%v) failed: %v", c.in, err)
+			}
+			// Values go through the wire encoding, like the ones the adapters receive.
+			if v != nil {
+				data, err := v.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+				v = &Value{}
+				if err = v.Unmarshal(data); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := DecodeValue(v)
+			if err != nil {
+				t.Fatalf("DecodeValue(%v) failed: %v", v, err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("DecodeValue(EncodeValue(%v)) => %#v, want %#v", c.in, got, c.want)
+			}
+		})
+	}
+
+	if _, err := EncodeValue(struct{}{}); err == nil {
+		t.Error("EncodeValue() should fail for unsupported types")
+	}
+}
+
+func TestStandardTypes(t *testing.T) {
+	ts := time.Date(2018, 1, 2, 3, 4, 5, 6, time.UTC)
+	encode := func(v interface{}) *Value {
+		t.Helper()
+		encoded, err := EncodeValue(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encoded
+	}
+
+	if got, err := DecodeDuration(encode(time.Second)); err != nil || got != time.Second {
+		t.Errorf("DecodeDuration() => %v, %v", got, err)
+	}
+	if got, err := DecodeTimestamp(encode(ts)); err != nil || !got.Equal(ts) {
+		t.Errorf("DecodeTimestamp() => %v, %v", got, err)
+	}
+	if got, err := DecodeIPAddress(encode(net.IPv4(1, 2, 3, 4))); err != nil || !got.Equal(net.IPv4(1, 2, 3, 4)) {
+		t.Errorf("DecodeIPAddress() => %v, %v", got, err)
+	}
+	if got, err := DecodeDNSName(encode(adapter.DNSName("a.b"))); err != nil || got != "a.b" {
+		t.Errorf("DecodeDNSName() => %v, %v", got, err)
+	}
+	if got, err := DecodeEmailAddress(encode(adapter.EmailAddress("a@b"))); err != nil || got != "a@b" {
+		t.Errorf("DecodeEmailAddress() => %v, %v", got, err)
+	}
+	if got, err := DecodeURI(encode(adapter.URI("http://a"))); err != nil || got != "http://a" {
+		t.Errorf("DecodeURI() => %v, %v", got, err)
+	}
+
+	// Unset fields decode to the zero values, mismatched ones fail.
+	if got, err := DecodeDuration(nil); err != nil || got != 0 {
+		t.Errorf("DecodeDuration(nil) => %v, %v", got, err)
+	}
+	if _, err := DecodeDuration(encode("1s")); err == nil {
+		t.Error("DecodeDuration() should fail for a string value")
+	}
+	if _, err := DecodeIPAddress(encode(int64(1))); err == nil {
+		t.Error("DecodeIPAddress() should fail for an int64 value")
+	}
+}
+
+func TestResults(t *testing.T) {
+	check := adapter.CheckResult{
+		Status:        status.WithPermissionDenied("denied"),
+		ValidDuration: time.Minute,
+		ValidUseCount: 10,
+	}
+	if got := DecodeCheckResult(EncodeCheckResult(check)); !reflect.DeepEqual(got, check) {
+		t.Errorf("DecodeCheckResult(EncodeCheckResult(%v)) => %v", check, got)
+	}
+
+	args := adapter.QuotaArgs{DeduplicationID: "id", QuotaAmount: 5, BestEffort: true}
+	if got := DecodeQuotaArgs(EncodeQuotaArgs(args)); got != args {
+		t.Errorf("DecodeQuotaArgs(EncodeQuotaArgs(%v)) => %v", args, got)
+	}
+
+	quota := adapter.QuotaResult{Status: rpc.Status{Code: int32(rpc.OK)}, ValidDuration: time.Minute, Amount: 3}
+	if got := DecodeQuotaResult(EncodeQuotaResult(quota)); !reflect.DeepEqual(got, quota) {
+		t.Errorf("DecodeQuotaResult(EncodeQuotaResult(%v)) => %v", quota, got)
+	}
+}
@@ -29,7 +29,9 @@ import (
 	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
 	"istio.io/istio/mixer/pkg/adapter"
 	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/checkcache"
 	"istio.io/istio/mixer/pkg/pool"
+	"istio.io/istio/mixer/pkg/recording"
 	"istio.io/istio/mixer/pkg/runtime"
 	"istio.io/istio/mixer/pkg/status"
 	"istio.io/istio/pkg/log"
@@ -46,6 +48,12 @@ type (
 		dispatcher runtime.Dispatcher
 		gp         *pool.GoroutinePool
 
+		// checkCache caches the precondition results of Check requests. It is nil if disabled.
+		checkCache *checkcache.Cache
+
+		// recorder records a sample of the requests. It is nil if disabled.
+		recorder *recording.Recorder
+
 		// the global dictionary. This will eventually be writable via config
 		globalWordList []string
 		globalDict     map[string]int32
@@ -64,8 +72,10 @@ var checkOk = &adapter.CheckResult{
 	ValidUseCount: defaultValidUseCount,
 }
 
-// NewGRPCServer creates a gRPC serving stack.
-func NewGRPCServer(dispatcher runtime.Dispatcher, gp *pool.GoroutinePool) mixerpb.MixerServer {
+// NewGRPCServer creates a gRPC serving stack. The check cache and the recorder are optional.
+func NewGRPCServer(dispatcher runtime.Dispatcher, gp *pool.GoroutinePool,
+	checkCache *checkcache.Cache, recorder *recording.Recorder) mixerpb.MixerServer {
+
 	list := attribute.GlobalList()
 	globalDict := make(map[string]int32, len(list))
 	for i := 0; i < len(list); i++ {
@@ -75,6 +85,8 @@ func NewGRPCServer(dispatcher runtime.Dispatcher, gp *pool.GoroutinePool) mixerp
 	return &grpcServer{
 		dispatcher:     dispatcher,
 		gp:             gp,
+		checkCache:     checkCache,
+		recorder:       recorder,
 		globalWordList: list,
 		globalDict:     globalDict,
 	}
@@ -122,10 +134,38 @@ func (s *grpcServer) Check(legacyCtx legacyContext.Context, req *mixerpb.CheckRe
 	//       request was denied? This will need to be addressed in the new adapter model. In the meantime,
 	//       RPC failure is treated as a semantic denial.
 
+	if s.recorder != nil {
+		s.recorder.Record(req)
+	}
+
 	requestBag := attribute.NewProtoBag(&req.Attributes, s.globalDict, s.globalWordList)
 
 	globalWordCount := int(req.GlobalWordCount)
 
+	var resp *mixerpb.CheckResponse
+	var cacheGeneration uint64
+	if s.checkCache != nil {
+		cacheGeneration = s.checkCache.Generation()
+		if value, found := s.checkCache.Get(requestBag); found {
+			log.Debug("Check result found in the check cache")
+			resp = &mixerpb.CheckResponse{
+				Precondition: mixerpb.CheckResponse_PreconditionResult{
+					ValidDuration:        value.ValidDuration,
+					ValidUseCount:        value.ValidUseCount,
+					Status:               value.Status,
+					ReferencedAttributes: requestBag.GetReferencedAttributes(s.globalDict, globalWordCount),
+				},
+			}
+			requestBag.ClearReferencedAttributes()
+
+			// Quotas are never cached, the request still goes through preprocessing if it asks for any.
+			if len(req.Quotas) == 0 {
+				requestBag.Done()
+				return resp, nil
+			}
+		}
+	}
+
 	// compatReqBag ensures that preprocessor input handles deprecated attributes gracefully.
 	compatReqBag := &compatBag{requestBag}
 	preprocResponseBag := attribute.GetMutableBag(nil)
@@ -157,33 +197,46 @@ func (s *grpcServer) Check(legacyCtx legacyContext.Context, req *mixerpb.CheckRe
 		log.Debug("Dispatching Check")
 	}
 
-	cr, err := s.dispatcher.Check(legacyCtx, compatRespBag)
-	if err != nil {
-		out = status.WithError(err)
-	}
+	if resp == nil {
+		cr, err := s.dispatcher.Check(legacyCtx, compatRespBag)
+		if err != nil {
+			out = status.WithError(err)
+		}
 
-	if cr == nil {
-		// This request was NOT subject to any checks, let it through.
-		cr = checkOk
-	} else {
-		out = cr.Status
-	}
+		if cr == nil {
+			// This request was NOT subject to any checks, let it through.
+			cr = checkOk
+		} else {
+			out = cr.Status
+		}
 
-	resp := &mixerpb.CheckResponse{
-		Precondition: mixerpb.CheckResponse_PreconditionResult{
-			ValidDuration:        cr.ValidDuration,
-			ValidUseCount:        cr.ValidUseCount,
-			Status:               out,
-			ReferencedAttributes: requestBag.GetReferencedAttributes(s.globalDict, globalWordCount),
-		},
-	}
+		resp = &mixerpb.CheckResponse{
+			Precondition: mixerpb.CheckResponse_PreconditionResult{
+				ValidDuration:        cr.ValidDuration,
+				ValidUseCount:        cr.ValidUseCount,
+				Status:               out,
+				ReferencedAttributes: requestBag.GetReferencedAttributes(s.globalDict, globalWordCount),
+			},
+		}
 
-	if status.IsOK(out) {
-		log.Debug("Check returned with ok")
+		if status.IsOK(out) {
+			log.Debug("Check returned with ok")
+		} else {
+			log.Errora("Check returned with error: ", status.String(out))
+		}
+		if s.checkCache != nil && err == nil {
+			s.checkCache.Set(requestBag, cacheGeneration, checkcache.Value{
+				Status:        out,
+				ValidDuration: cr.ValidDuration,
+				ValidUseCount: cr.ValidUseCount,
+			})
+		}
+		requestBag.ClearReferencedAttributes()
 	} else {
-		log.Errora("Check returned with error: ", status.String(out))
+		// The preconditions came from the check cache, the attributes referenced by preprocessing
+		// are accounted for in their referenced attributes.
+		requestBag.ClearReferencedAttributes()
 	}
-	requestBag.ClearReferencedAttributes()
 
 	if status.IsOK(resp.Precondition.Status) && len(req.Quotas) > 0 {
 		resp.Quotas = make(map[string]mixerpb.CheckResponse_QuotaResult, len(req.Quotas))
@@ -260,6 +313,10 @@ var reportResp = &mixerpb.ReportResponse{}
 
 // Report is the entry point for the external Report method
 func (s *grpcServer) Report(legacyCtx legacyContext.Context, req *mixerpb.ReportRequest) (*mixerpb.ReportResponse, error) {
+	if s.recorder != nil {
+		s.recorder.Record(req)
+	}
+
 	if len(req.Attributes) == 0 {
 		// early out
 		return reportResp, nil
@@ -15,12 +15,15 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -28,7 +31,9 @@ import (
 	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
 	"istio.io/istio/mixer/pkg/adapter"
 	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/checkcache"
 	"istio.io/istio/mixer/pkg/pool"
+	"istio.io/istio/mixer/pkg/recording"
 	"istio.io/istio/mixer/pkg/runtime"
 	"istio.io/istio/mixer/pkg/status"
 	"istio.io/istio/pkg/log"
@@ -70,7 +75,7 @@ func (ts *testState) createGRPCServer() (string, error) {
 	ts.gp = pool.NewGoroutinePool(128, false)
 	ts.gp.AddWorkers(32)
 
-	ms := NewGRPCServer(ts, ts.gp)
+	ms := NewGRPCServer(ts, ts.gp, nil, nil)
 	ts.s = ms.(*grpcServer)
 	mixerpb.RegisterMixerServer(ts.gs, ts.s)
 
@@ -440,6 +445,135 @@ func TestFailingPreproc(t *testing.T) {
 	}
 }
 
+func TestCheckCache(t *testing.T) {
+	ts, err := prepTestState()
+	if err != nil {
+		t.Fatalf("Unable to prep test state: %v", err)
+	}
+	defer ts.cleanupTestState()
+	ts.s.checkCache = checkcache.New(10)
+
+	calls := 0
+	ts.check = func(ctx context.Context, requestBag attribute.Bag) (*adapter.CheckResult, error) {
+		calls++
+		if v, _ := requestBag.Get("A1"); v == int64(25) {
+			return &adapter.CheckResult{ValidDuration: time.Minute, ValidUseCount: 10}, nil
+		}
+		return &adapter.CheckResult{Status: status.WithPermissionDenied("denied"), ValidDuration: time.Minute,
+			ValidUseCount: 10}, nil
+	}
+
+	check := func(a1, a2 int64, wantCalls int, wantOK bool) {
+		t.Helper()
+		request := mixerpb.CheckRequest{Attributes: mixerpb.CompressedAttributes{
+			Words:  []string{"A1", "A2"},
+			Int64S: map[int32]int64{-1: a1, -2: a2},
+		}}
+		response, err := ts.client.Check(context.Background(), &request)
+		if err != nil {
+			t.Fatalf("Got %v, expected success", err)
+		}
+		if calls != wantCalls {
+			t.Errorf("Got %d dispatched checks, expected %d", calls, wantCalls)
+		}
+		if status.IsOK(response.Precondition.Status) != wantOK {
+			t.Errorf("Got precondition status %v, expected ok=%t", response.Precondition.Status, wantOK)
+		}
+		if len(response.Precondition.ReferencedAttributes.AttributeMatches) != 1 {
+			t.Errorf("Got referenced attributes %v, expected A1", response.Precondition.ReferencedAttributes)
+		}
+	}
+
+	check(25, 1, 1, true)
+	// A2 isn't referenced by the check, so its value doesn't matter.
+	check(25, 2, 1, true)
+	check(26, 1, 2, false)
+	check(26, 1, 2, false)
+
+	ts.s.checkCache.Invalidate()
+	check(25, 1, 3, true)
+}
+
+type recordBuffer struct {
+	bytes.Buffer
+}
+
+func (*recordBuffer) Close() error { return nil }
+
+func TestRecording(t *testing.T) {
+	ts, err := prepTestState()
+	if err != nil {
+		t.Fatalf("Unable to prep test state: %v", err)
+	}
+	defer ts.cleanupTestState()
+
+	ts.check = func(ctx context.Context, requestBag attribute.Bag) (*adapter.CheckResult, error) {
+		return nil, nil
+	}
+	ts.report = func(ctx context.Context, requestBag attribute.Bag) error {
+		return nil
+	}
+
+	buf := &recordBuffer{}
+	if ts.s.recorder, err = recording.New(buf, recording.Options{SampleRate: 1}, ts.s.globalWordList); err != nil {
+		t.Fatalf("Unable to create the recorder: %v", err)
+	}
+
+	attrs := mixerpb.CompressedAttributes{
+		Words:   []string{"A1"},
+		Int64S:  map[int32]int64{-1: 25},
+		Strings: map[int32]int32{int32(ts.s.globalDict["source.name"]): -1},
+	}
+	if _, err = ts.client.Check(context.Background(), &mixerpb.CheckRequest{Attributes: attrs}); err != nil {
+		t.Fatalf("Got %v, expected success", err)
+	}
+	if _, err = ts.client.Report(context.Background(), &mixerpb.ReportRequest{
+		Attributes:   []mixerpb.CompressedAttributes{{Int64S: attrs.Int64S}},
+		DefaultWords: attrs.Words,
+	}); err != nil {
+		t.Fatalf("Got %v, expected success", err)
+	}
+	if err = ts.s.recorder.Close(); err != nil {
+		t.Fatalf("Unable to close the recorder: %v", err)
+	}
+
+	r, err := recording.NewReader(buf)
+	if err != nil {
+		t.Fatalf("Unable to read the recording: %v", err)
+	}
+	for _, kind := range []recording.Kind{recording.Check, recording.Report} {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatalf("Got %v, expected the %v request", err, kind)
+		}
+		if e.Kind != kind {
+			t.Fatalf("Got a %v request, expected %v", e.Kind, kind)
+		}
+		if err = r.Localize(e); err != nil {
+			t.Fatalf("Unable to localize the request: %v", err)
+		}
+		var a *mixerpb.CompressedAttributes
+		if kind == recording.Check {
+			a = &e.Check.Attributes
+		} else {
+			a = &e.Report.Attributes[0]
+		}
+		b, err := attribute.GetBagFromProto(a, nil)
+		if err != nil {
+			t.Fatalf("Unable to decode the attributes: %v", err)
+		}
+		if v, _ := b.Get("A1"); v != int64(25) {
+			t.Errorf("Got A1=%v in the %v request, expected 25", v, kind)
+		}
+		if v, _ := b.Get("source.name"); kind == recording.Check && v != "A1" {
+			t.Errorf("Got source.name=%v in the %v request, expected A1", v, kind)
+		}
+	}
+	if _, err = r.Next(); err != io.EOF {
+		t.Fatalf("Got %v, expected the end of the recording", err)
+	}
+}
+
 func init() {
 	// bump up the log level so log-only logic runs during the tests, for correctness and coverage.
 	o := log.NewOptions()
@@ -54,7 +54,7 @@ func (bs *benchState) createGRPCServer() (string, error) {
 	bs.gp = pool.NewGoroutinePool(32, false)
 	bs.gp.AddWorkers(32)
 
-	ms := NewGRPCServer(bs, bs.gp)
+	ms := NewGRPCServer(bs, bs.gp, nil, nil)
 	bs.s = ms.(*grpcServer)
 	mixerpb.RegisterMixerServer(bs.gs, bs.s)
 
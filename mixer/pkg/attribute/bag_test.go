@@ -686,6 +686,15 @@ func TestReferenceTracking(t *testing.T) {
 		})
 	}
 
+	wantList := []ReferencedAttribute{
+		{Name: "G0", Condition: mixerpb.EXACT},
+		{Name: "N1", Condition: mixerpb.EXACT},
+		{Name: "XX", Condition: mixerpb.ABSENCE},
+	}
+	if list := b.ReferencedAttributeList(); !reflect.DeepEqual(list, wantList) {
+		t.Errorf("Got referenced attributes %v, expected %v", list, wantList)
+	}
+
 	b.ClearReferencedAttributes()
 
 	ra = b.GetReferencedAttributes(globalDict, len(globalDict))
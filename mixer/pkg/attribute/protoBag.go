@@ -87,6 +87,16 @@ func (s StringMap) Get(key string) (string, bool) {
 	return str, found
 }
 
+// Keys returns the keys of the stringmap and records access to the whole stringmap
+func (s StringMap) Keys() []string {
+	keys := make([]string, 0, len(s.entries))
+	for k := range s.entries {
+		keys = append(keys, k)
+	}
+	s.pb.trackReference(s.name, mixerpb.EXACT)
+	return keys
+}
+
 // Get returns an attribute value.
 func (pb *ProtoBag) Get(name string) (interface{}, bool) {
 	// find the dictionary index for the given string
@@ -139,6 +149,37 @@ func (pb *ProtoBag) GetReferencedAttributes(globalDict map[string]int32, globalW
 	return output
 }
 
+// ReferencedAttribute describes an attribute, or a key of a string map attribute, referenced through a bag.
+type ReferencedAttribute struct {
+	// Name of the attribute.
+	Name string
+
+	// MapKey is the referenced key of a string map attribute. It is empty for other attributes.
+	MapKey string
+
+	// Condition describes how the attribute was matched.
+	Condition mixerpb.ReferencedAttributes_Condition
+}
+
+// ReferencedAttributeList returns the attributes that have been referenced through this bag, ordered
+// by name and map key. Unlike GetReferencedAttributes, names are not encoded with the dictionaries.
+func (pb *ProtoBag) ReferencedAttributeList() []ReferencedAttribute {
+	pb.referencedAttrsMutex.Lock()
+	refs := make([]ReferencedAttribute, 0, len(pb.referencedAttrs))
+	for k, v := range pb.referencedAttrs {
+		refs = append(refs, ReferencedAttribute{Name: k.Name, MapKey: k.MapKey, Condition: v})
+	}
+	pb.referencedAttrsMutex.Unlock()
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Name != refs[j].Name {
+			return refs[i].Name < refs[j].Name
+		}
+		return refs[i].MapKey < refs[j].MapKey
+	})
+	return refs
+}
+
 // ClearReferencedAttributes clears the list of referenced attributes being tracked by this bag
 func (pb *ProtoBag) ClearReferencedAttributes() {
 	for k := range pb.referencedAttrs {
@@ -0,0 +1,276 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checkcache caches the precondition results of Check requests in Mixer.
+//
+// The result of a check only depends on the attributes the preprocessing and check adapters
+// referenced while producing it. The cache remembers the distinct sets of referenced attributes,
+// or signatures, it has seen, and keys every result on the values the request had for the
+// attributes of its signature. A request hits the cache when its values for the attributes of
+// one of the signatures match those of a cached result.
+package checkcache
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	mixerpb "istio.io/api/mixer/v1"
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/runtime"
+	"istio.io/istio/pkg/cache"
+)
+
+const (
+	// maxSignatures bounds the number of distinct sets of referenced attributes the cache
+	// remembers, as every lookup goes through all of them.
+	maxSignatures = 64
+)
+
+var (
+	hitCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "mixer",
+		Subsystem: "checkcache",
+		Name:      "hit_count",
+		Help:      "Total number of Check requests answered from the check cache.",
+	})
+
+	missCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "mixer",
+		Subsystem: "checkcache",
+		Name:      "miss_count",
+		Help:      "Total number of Check requests not found in the check cache.",
+	})
+
+	invalidationCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "mixer",
+		Subsystem: "checkcache",
+		Name:      "invalidation_count",
+		Help:      "Total number of times the check cache was cleared following a configuration change.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(hitCounter)
+	prometheus.MustRegister(missCounter)
+	prometheus.MustRegister(invalidationCounter)
+}
+
+// Value is the cached result of the preconditions of a Check request.
+type Value struct {
+	// Status of the preconditions.
+	Status rpc.Status
+
+	// ValidDuration is the amount of time for which the result is still valid.
+	ValidDuration time.Duration
+
+	// ValidUseCount is the number of uses for which the result is still valid.
+	ValidUseCount int32
+}
+
+// Cache caches the precondition results of Check requests. It is safe for concurrent use.
+type Cache struct {
+	results cache.ExpiringCache
+
+	mu sync.RWMutex
+
+	// generation is incremented every time the cache is invalidated.
+	generation uint64
+
+	// the distinct sets of referenced attributes of the cached results.
+	signatures   []signature
+	signatureIDs map[string]bool
+}
+
+// signature is a set of referenced attributes, ordered by name and map key.
+type signature struct {
+	id   string
+	refs []attribute.ReferencedAttribute
+}
+
+type entry struct {
+	status     rpc.Status
+	expiration time.Time
+
+	// remaining number of uses, decremented atomically.
+	uses int32
+}
+
+var _ runtime.ResolverChangeListener = &Cache{}
+
+// New returns a cache holding at most maxEntries results. The cache is bounded by its number
+// of entries rather than by the memory they use: an entry takes a few hundred bytes, plus the
+// size of the message and details of its status.
+func New(maxEntries int) *Cache {
+	return &Cache{
+		// Results are not evicted in the background: expired ones are removed when looked up,
+		// or displaced by newer ones once the cache is full.
+		results:      cache.NewLRU(0, 0, int32(maxEntries)),
+		signatureIDs: make(map[string]bool),
+	}
+}
+
+// Generation returns the current generation of the cache. It must be read before a request is
+// dispatched, and passed to Set along with its result.
+func (c *Cache) Generation() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// Get looks up the result of the preconditions of the request whose attributes are in the
+// bag. On a hit, the attributes referenced through the bag are exactly the ones on which
+// the cached result depends.
+func (c *Cache) Get(bag *attribute.ProtoBag) (Value, bool) {
+	c.mu.RLock()
+	signatures := c.signatures
+	c.mu.RUnlock()
+
+	now := time.Now()
+	for _, sig := range signatures {
+		bag.ClearReferencedAttributes()
+		key, ok := sig.key(bag)
+		if !ok {
+			continue
+		}
+		v, found := c.results.Get(key)
+		if !found {
+			continue
+		}
+		e := v.(*entry)
+		uses := atomic.AddInt32(&e.uses, -1)
+		if uses < 0 || !now.Before(e.expiration) {
+			c.results.Remove(key)
+			continue
+		}
+
+		hitCounter.Inc()
+		return Value{
+			Status:        e.status,
+			ValidDuration: e.expiration.Sub(now),
+			ValidUseCount: uses + 1,
+		}, true
+	}
+	bag.ClearReferencedAttributes()
+	missCounter.Inc()
+	return Value{}, false
+}
+
+// Set caches the result of the preconditions of the request whose attributes are in the bag.
+// It must be called before the attributes referenced while producing the result are cleared
+// from the bag. The result is discarded if the cache was invalidated since generation was read.
+func (c *Cache) Set(bag *attribute.ProtoBag, generation uint64, value Value) {
+	if value.ValidDuration <= 0 || value.ValidUseCount <= 0 {
+		return
+	}
+	sig, ok := newSignature(bag.ReferencedAttributeList())
+	if !ok {
+		return
+	}
+
+	key, ok := sig.key(bag)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if !c.signatureIDs[sig.id] {
+		if len(c.signatures) >= maxSignatures {
+			return
+		}
+		c.signatureIDs[sig.id] = true
+		// copy on write, as lookups iterate over the signatures without holding the lock.
+		signatures := make([]signature, len(c.signatures), len(c.signatures)+1)
+		copy(signatures, c.signatures)
+		c.signatures = append(signatures, sig)
+	}
+	c.results.SetWithExpiration(key, &entry{
+		status:     value.Status,
+		expiration: time.Now().Add(value.ValidDuration),
+		uses:       value.ValidUseCount,
+	}, value.ValidDuration)
+}
+
+// ChangeResolver invalidates the cache when the controller publishes a new resolver, as the
+// results may have been produced by rules and handlers which have changed.
+func (c *Cache) ChangeResolver(runtime.Resolver) {
+	c.Invalidate()
+}
+
+// Invalidate removes all the results from the cache.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	c.generation++
+	c.signatures = nil
+	c.signatureIDs = make(map[string]bool)
+	c.results.RemoveAll()
+	c.mu.Unlock()
+	invalidationCounter.Inc()
+}
+
+func newSignature(refs []attribute.ReferencedAttribute) (signature, bool) {
+	h := sha256.New()
+	for _, r := range refs {
+		// only exact and absence matches can be reproduced from the values of the attributes.
+		if r.Condition != mixerpb.EXACT && r.Condition != mixerpb.ABSENCE {
+			return signature{}, false
+		}
+		writeString(h, r.Name)
+		writeString(h, r.MapKey)
+		writeString(h, r.Condition.String())
+	}
+	return signature{id: string(h.Sum(nil)), refs: refs}, true
+}
+
+// key returns the key of the results with this signature for the request whose attributes
+// are in the bag. It fails if the request doesn't match the conditions of the signature.
+func (s signature) key(bag *attribute.ProtoBag) ([sha256.Size]byte, bool) {
+	var key [sha256.Size]byte
+	h := sha256.New()
+	writeString(h, s.id)
+	for _, r := range s.refs {
+		v, found := bag.Get(r.Name)
+		if r.MapKey != "" {
+			sm, ok := v.(attribute.StringMap)
+			if !ok {
+				return key, false
+			}
+			v, found = sm.Get(r.MapKey)
+		}
+		if found != (r.Condition == mixerpb.EXACT) {
+			return key, false
+		}
+		if found {
+			writeString(h, fmt.Sprintf("%T:%v", v, v))
+		}
+	}
+	copy(key[:], h.Sum(nil))
+	return key, true
+}
+
+// writeString writes the length of s followed by s, so that consecutive strings can't be confused.
+func writeString(h hash.Hash, s string) {
+	_, _ = h.Write([]byte(strconv.Itoa(len(s)) + ":" + s))
+}
@@ -0,0 +1,143 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkcache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/status"
+)
+
+var words = []string{"source.name", "destination.name", "request.headers", "user-agent", "a", "b"}
+
+func newBag(strs map[int32]int32, headers map[int32]int32) *attribute.ProtoBag {
+	attrs := &mixerpb.CompressedAttributes{
+		Words:   words,
+		Strings: strs,
+	}
+	if headers != nil {
+		attrs.StringMaps = map[int32]mixerpb.StringMap{-3: {Entries: headers}}
+	}
+	return attribute.NewProtoBag(attrs, map[string]int32{}, []string{})
+}
+
+// store runs a check referencing source.name, destination.name and the user-agent header, and caches its result.
+func store(c *Cache, bag *attribute.ProtoBag, value Value) {
+	gen := c.Generation()
+	_, _ = bag.Get("source.name")
+	_, _ = bag.Get("destination.name")
+	if v, ok := bag.Get("request.headers"); ok {
+		_, _ = v.(attribute.StringMap).Get("user-agent")
+	}
+	c.Set(bag, gen, value)
+	bag.ClearReferencedAttributes()
+}
+
+func TestHitAndMiss(t *testing.T) {
+	c := New(10)
+	value := Value{Status: status.WithPermissionDenied("denied"), ValidDuration: time.Minute, ValidUseCount: 10}
+	store(c, newBag(map[int32]int32{-1: -5}, map[int32]int32{-4: -6}), value)
+
+	cases := []struct {
+		name    string
+		bag     *attribute.ProtoBag
+		wantHit bool
+	}{
+		{"same values", newBag(map[int32]int32{-1: -5}, map[int32]int32{-4: -6}), true},
+		{"different value", newBag(map[int32]int32{-1: -6}, map[int32]int32{-4: -6}), false},
+		{"different header", newBag(map[int32]int32{-1: -5}, map[int32]int32{-4: -5}), false},
+		{"absent attribute became present", newBag(map[int32]int32{-1: -5, -2: -5}, map[int32]int32{-4: -6}), false},
+		{"missing header", newBag(map[int32]int32{-1: -5}, map[int32]int32{}), false},
+		{"missing headers", newBag(map[int32]int32{-1: -5}, nil), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, hit := c.Get(tc.bag)
+			if hit != tc.wantHit {
+				t.Fatalf("Get() => hit %t, want %t", hit, tc.wantHit)
+			}
+			if !hit {
+				if refs := tc.bag.ReferencedAttributeList(); len(refs) != 0 {
+					t.Errorf("Get() missed but left referenced attributes %v", refs)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got.Status, value.Status) || got.ValidDuration > value.ValidDuration ||
+				got.ValidUseCount != value.ValidUseCount {
+				t.Errorf("Get() => %v, want %v", got, value)
+			}
+			want := []attribute.ReferencedAttribute{
+				{Name: "destination.name", Condition: mixerpb.ABSENCE},
+				{Name: "request.headers", MapKey: "user-agent", Condition: mixerpb.EXACT},
+				{Name: "source.name", Condition: mixerpb.EXACT},
+			}
+			if refs := tc.bag.ReferencedAttributeList(); !reflect.DeepEqual(refs, want) {
+				t.Errorf("Get() referenced %v, want %v", refs, want)
+			}
+		})
+	}
+}
+
+func TestValidity(t *testing.T) {
+	c := New(10)
+	bag := func() *attribute.ProtoBag { return newBag(map[int32]int32{-1: -5}, nil) }
+
+	store(c, bag(), Value{ValidDuration: time.Minute, ValidUseCount: 2})
+	for i := int32(2); i > 0; i-- {
+		if v, hit := c.Get(bag()); !hit || v.ValidUseCount != i {
+			t.Errorf("Get() => %v, %t, want %d remaining uses", v, hit, i)
+		}
+	}
+	if _, hit := c.Get(bag()); hit {
+		t.Error("Get() => hit after the use count was exhausted")
+	}
+
+	store(c, bag(), Value{ValidDuration: time.Millisecond, ValidUseCount: 10})
+	time.Sleep(5 * time.Millisecond)
+	if _, hit := c.Get(bag()); hit {
+		t.Error("Get() => hit after the result expired")
+	}
+
+	store(c, bag(), Value{ValidUseCount: 10})
+	if _, hit := c.Get(bag()); hit {
+		t.Error("Get() => hit for a result without valid duration")
+	}
+}
+
+func TestInvalidation(t *testing.T) {
+	c := New(10)
+	bag := func() *attribute.ProtoBag { return newBag(map[int32]int32{-1: -5}, nil) }
+	value := Value{ValidDuration: time.Minute, ValidUseCount: 10}
+
+	store(c, bag(), value)
+	c.ChangeResolver(nil)
+	if _, hit := c.Get(bag()); hit {
+		t.Error("Get() => hit after the resolver changed")
+	}
+
+	// results produced with the previous resolver are discarded.
+	b := bag()
+	gen := c.Generation()
+	_, _ = b.Get("source.name")
+	c.Invalidate()
+	c.Set(b, gen, value)
+	if _, hit := c.Get(bag()); hit {
+		t.Error("Get() => hit for a result produced before the cache was invalidated")
+	}
+}
@@ -16,6 +16,7 @@ package config
 
 import (
 	"istio.io/istio/mixer/pkg/config/crd"
+	"istio.io/istio/mixer/pkg/config/kvstore"
 	"istio.io/istio/mixer/pkg/config/store"
 )
 
@@ -23,5 +24,6 @@ import (
 func StoreInventory() []store.RegisterFunc {
 	return []store.RegisterFunc{
 		crd.Register,
+		kvstore.Register,
 	}
 }
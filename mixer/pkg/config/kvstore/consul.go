@@ -0,0 +1,100 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+// consulWaitTime is the maximum duration of the blocking queries watching the prefix.
+const consulWaitTime = 5 * time.Minute
+
+// consulClient accesses the pairs under a prefix in the KV store of Consul.
+type consulClient struct {
+	kv     *api.KV
+	prefix string
+}
+
+var _ client = &consulClient{}
+
+// NewConsulStore creates a new Store instance backed by the KV store of Consul, from
+// a URL like consul://host:8500/path/to/prefix?dc=datacenter. The ACL token is read
+// from the CONSUL_HTTP_TOKEN environment variable.
+func NewConsulStore(u *url.URL) (store.Backend, error) {
+	conf := api.DefaultConfig()
+	conf.Address = u.Host
+	conf.Datacenter = u.Query().Get("dc")
+	c, err := api.NewClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	// keys in Consul don't start with a slash.
+	prefix := strings.TrimPrefix(strings.TrimSuffix(u.Path, "/")+"/", "/")
+	return newStore(&consulClient{kv: c.KV(), prefix: prefix}), nil
+}
+
+// get runs a query on the pairs under the prefix, which blocks until their index
+// gets past waitIndex when it's not 0.
+func (c *consulClient) get(ctx context.Context, waitIndex uint64) (update, error) {
+	q := &api.QueryOptions{WaitIndex: waitIndex, WaitTime: consulWaitTime}
+	kvs, meta, err := c.kv.List(c.prefix, q.WithContext(ctx))
+	if err != nil {
+		return update{}, err
+	}
+	// Consul has no history of the changes: every update is a snapshot.
+	u := update{snapshot: true, revision: meta.LastIndex}
+	for _, kv := range kvs {
+		u.pairs = append(u.pairs, pair{
+			key:      strings.TrimPrefix(kv.Key, c.prefix),
+			value:    kv.Value,
+			revision: kv.ModifyIndex,
+		})
+	}
+	return u, nil
+}
+
+func (c *consulClient) list(ctx context.Context) (update, error) {
+	return c.get(ctx, 0)
+}
+
+func (c *consulClient) watch(ctx context.Context, revision uint64, notify func(update)) error {
+	for {
+		u, err := c.get(ctx, revision)
+		if err != nil {
+			return err
+		}
+		switch {
+		case u.revision == revision:
+			// the query timed out without any change.
+			continue
+		case u.revision < revision:
+			// the index went backwards, e.g. after the data of the servers was restored.
+			return errResync
+		}
+		revision = u.revision
+		notify(u)
+	}
+}
+
+func (c *consulClient) close() error {
+	return nil
+}
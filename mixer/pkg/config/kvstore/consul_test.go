@@ -0,0 +1,169 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+// consulServer is a stand-in for the KV HTTP API of Consul, supporting recursive blocking queries.
+type consulServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	index    uint64
+	kvs      map[string]*api.KVPair
+	changed  chan struct{}
+	failures int
+}
+
+func newConsulServer() *consulServer {
+	s := &consulServer{index: 1, kvs: map[string]*api.KVPair{}, changed: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveList))
+	return s
+}
+
+func (s *consulServer) serveList(w http.ResponseWriter, r *http.Request) {
+	prefix := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+	timeout := time.After(wait)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for expired := false; waitIndex != 0 && s.index == waitIndex && s.failures == 0 && !expired; {
+		changed := s.changed
+		s.mu.Unlock()
+		select {
+		case <-changed:
+		case <-timeout:
+			expired = true
+		case <-r.Context().Done():
+			expired = true
+		}
+		s.mu.Lock()
+	}
+	if s.failures > 0 {
+		s.failures--
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+		return
+	}
+	kvs := api.KVPairs{}
+	for k, kv := range s.kvs {
+		if strings.HasPrefix(k, prefix) {
+			kvs = append(kvs, kv)
+		}
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(s.index, 10))
+	w.Header().Set("X-Consul-LastContact", "0")
+	w.Header().Set("X-Consul-KnownLeader", "true")
+	if len(kvs) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(kvs)
+}
+
+// update applies f to the pairs, and wakes up the blocking queries.
+func (s *consulServer) update(f func()) {
+	s.mu.Lock()
+	f()
+	close(s.changed)
+	s.changed = make(chan struct{})
+	s.mu.Unlock()
+}
+
+func (s *consulServer) put(k string, v []byte) {
+	s.update(func() {
+		s.index++
+		s.kvs[k] = &api.KVPair{Key: k, Value: v, ModifyIndex: s.index}
+	})
+}
+
+func (s *consulServer) delete(k string) {
+	s.update(func() {
+		s.index++
+		delete(s.kvs, k)
+	})
+}
+
+func TestConsulStore(t *testing.T) {
+	cs := newConsulServer()
+	defer cs.Close()
+	cs.put("istio/config/handler/ns/a", handler("a", "noop"))
+	cs.put("other/handler/ns/x", handler("x", "noop"))
+
+	u, _ := url.Parse(cs.URL)
+	b, err := NewConsulStore(&url.URL{Scheme: "consul", Host: u.Host, Path: "/istio/config"})
+	if err != nil {
+		t.Fatalf("NewConsulStore() => %v", err)
+	}
+	s := b.(*Store)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err = store.WithBackend(s).Init(ctx, kinds); err != nil {
+		t.Fatalf("Init() => %v", err)
+	}
+	ch, _ := s.Watch(ctx)
+	if got := s.List(); len(got) != 1 || got[key("a")] == nil {
+		t.Errorf("List() => %+v, want a", got)
+	}
+
+	cs.put("istio/config/handler/ns/b", handler("b", "noop"))
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Update || evs[0].Key != key("b") {
+		t.Errorf("Got %+v, want an update of b", evs[0])
+	}
+	cs.delete("istio/config/handler/ns/a")
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Delete || evs[0].Key != key("a") {
+		t.Errorf("Got %+v, want a deletion of a", evs[0])
+	}
+
+	// the watch is resumed after failures.
+	cs.mu.Lock()
+	cs.failures = 2
+	cs.mu.Unlock()
+	cs.put("istio/config/handler/ns/c", handler("c", "noop"))
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Update || evs[0].Key != key("c") {
+		t.Errorf("Got %+v, want an update of c", evs[0])
+	}
+
+	// the resources are listed again when the index goes backwards.
+	cs.update(func() {
+		cs.index = 1
+		delete(cs.kvs, "istio/config/handler/ns/b")
+	})
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Delete || evs[0].Key != key("b") {
+		t.Errorf("Got %+v, want a deletion of b", evs[0])
+	}
+	cs.put("istio/config/handler/ns/d", handler("d", "noop"))
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Update || evs[0].Key != key("d") {
+		t.Errorf("Got %+v, want an update of d", evs[0])
+	}
+}
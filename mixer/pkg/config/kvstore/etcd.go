@@ -0,0 +1,98 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+const etcdDialTimeout = 5 * time.Second
+
+// etcdClient accesses the pairs under a prefix in etcd.
+type etcdClient struct {
+	client *clientv3.Client
+	prefix string
+}
+
+var _ client = &etcdClient{}
+
+// NewEtcdStore creates a new Store instance backed by etcd, from a URL like
+// etcd://host1:2379,host2:2379/path/to/prefix.
+func NewEtcdStore(u *url.URL) (store.Backend, error) {
+	c, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(u.Host, ","),
+		DialTimeout: etcdDialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newStore(&etcdClient{client: c, prefix: strings.TrimSuffix(u.Path, "/") + "/"}), nil
+}
+
+func (c *etcdClient) list(ctx context.Context) (update, error) {
+	resp, err := c.client.Get(ctx, c.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return update{}, err
+	}
+	u := update{snapshot: true, revision: uint64(resp.Header.Revision)}
+	for _, kv := range resp.Kvs {
+		u.pairs = append(u.pairs, pair{
+			key:      strings.TrimPrefix(string(kv.Key), c.prefix),
+			value:    kv.Value,
+			revision: uint64(kv.ModRevision),
+		})
+	}
+	return u, nil
+}
+
+func (c *etcdClient) watch(ctx context.Context, revision uint64, notify func(update)) error {
+	// require a leader, so that the watch fails instead of hanging when the member is partitioned.
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+	wch := c.client.Watch(ctx, c.prefix, clientv3.WithPrefix(), clientv3.WithRev(int64(revision)+1))
+	for resp := range wch {
+		if resp.CompactRevision != 0 {
+			return errResync
+		}
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		u := update{revision: uint64(resp.Header.Revision)}
+		for _, ev := range resp.Events {
+			u.pairs = append(u.pairs, pair{
+				key:      strings.TrimPrefix(string(ev.Kv.Key), c.prefix),
+				value:    ev.Kv.Value,
+				deleted:  ev.Type == clientv3.EventTypeDelete,
+				revision: uint64(ev.Kv.ModRevision),
+			})
+		}
+		notify(u)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return errWatchClosed
+}
+
+func (c *etcdClient) close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,291 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+// etcdServer is a stand-in for the KV and Watch gRPC services of etcd, supporting range
+// requests, watches from a revision and compaction.
+type etcdServer struct {
+	addr   string
+	server *grpc.Server
+
+	mu        sync.Mutex
+	revision  int64
+	compacted int64
+	kvs       map[string]*mvccpb.KeyValue
+	events    []*mvccpb.Event
+	changed   chan struct{}
+}
+
+var _ pb.KVServer = &etcdServer{}
+var _ pb.WatchServer = &etcdServer{}
+
+// startEtcd starts a stand-in etcd server, and returns its address and a function to stop it.
+func startEtcd(t *testing.T) (string, func()) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &etcdServer{
+		addr:     l.Addr().String(),
+		server:   grpc.NewServer(),
+		revision: 1,
+		kvs:      map[string]*mvccpb.KeyValue{},
+		changed:  make(chan struct{}),
+	}
+	pb.RegisterKVServer(s.server, s)
+	pb.RegisterWatchServer(s.server, s)
+	go func() { _ = s.server.Serve(l) }()
+	return s.addr, s.server.Stop
+}
+
+// inRange tells whether a key is in the range of a request, with the semantics of etcd.
+func inRange(k, key, rangeEnd []byte) bool {
+	switch {
+	case len(rangeEnd) == 0:
+		return bytes.Equal(k, key)
+	case len(rangeEnd) == 1 && rangeEnd[0] == 0:
+		return bytes.Compare(k, key) >= 0
+	default:
+		return bytes.Compare(k, key) >= 0 && bytes.Compare(k, rangeEnd) < 0
+	}
+}
+
+// header must be called with the lock held.
+func (s *etcdServer) header() *pb.ResponseHeader {
+	return &pb.ResponseHeader{Revision: s.revision}
+}
+
+// update records the events of a new revision, and wakes up the watches.
+func (s *etcdServer) update(evs ...*mvccpb.Event) *pb.ResponseHeader {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revision++
+	for _, ev := range evs {
+		ev.Kv.ModRevision = s.revision
+		if ev.Type == mvccpb.DELETE {
+			delete(s.kvs, string(ev.Kv.Key))
+		} else {
+			s.kvs[string(ev.Kv.Key)] = ev.Kv
+		}
+	}
+	s.events = append(s.events, evs...)
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return s.header()
+}
+
+func (s *etcdServer) Range(_ context.Context, req *pb.RangeRequest) (*pb.RangeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &pb.RangeResponse{Header: s.header()}
+	for _, kv := range s.kvs {
+		if inRange(kv.Key, req.Key, req.RangeEnd) {
+			resp.Kvs = append(resp.Kvs, kv)
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool { return bytes.Compare(resp.Kvs[i].Key, resp.Kvs[j].Key) < 0 })
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func (s *etcdServer) Put(_ context.Context, req *pb.PutRequest) (*pb.PutResponse, error) {
+	kv := &mvccpb.KeyValue{Key: req.Key, Value: req.Value}
+	return &pb.PutResponse{Header: s.update(&mvccpb.Event{Type: mvccpb.PUT, Kv: kv})}, nil
+}
+
+func (s *etcdServer) DeleteRange(_ context.Context, req *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	s.mu.Lock()
+	var evs []*mvccpb.Event
+	for _, kv := range s.kvs {
+		if inRange(kv.Key, req.Key, req.RangeEnd) {
+			evs = append(evs, &mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: kv.Key}})
+		}
+	}
+	s.mu.Unlock()
+	return &pb.DeleteRangeResponse{Header: s.update(evs...), Deleted: int64(len(evs))}, nil
+}
+
+func (s *etcdServer) Txn(context.Context, *pb.TxnRequest) (*pb.TxnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "transactions are not supported")
+}
+
+func (s *etcdServer) Compact(_ context.Context, req *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.compacted = req.Revision
+	return &pb.CompactionResponse{Header: s.header()}, nil
+}
+
+// Watch serves a single watch per stream.
+func (s *etcdServer) Watch(stream pb.Watch_WatchServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	create := req.GetCreateRequest()
+	if create == nil {
+		return status.Error(codes.InvalidArgument, "the first request must create a watch")
+	}
+	// drain the cancel requests, the watch ends with the stream.
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+		}
+	}()
+
+	s.mu.Lock()
+	resp := &pb.WatchResponse{Header: s.header(), Created: true}
+	next := create.StartRevision
+	if next == 0 {
+		next = s.revision + 1
+	}
+	s.mu.Unlock()
+	if err = stream.Send(resp); err != nil {
+		return err
+	}
+	for {
+		s.mu.Lock()
+		if next <= s.compacted {
+			resp = &pb.WatchResponse{Header: s.header(), CompactRevision: s.compacted, Canceled: true}
+			s.mu.Unlock()
+			return stream.Send(resp)
+		}
+		resp = &pb.WatchResponse{Header: s.header()}
+		for _, ev := range s.events {
+			if ev.Kv.ModRevision >= next && inRange(ev.Kv.Key, create.Key, create.RangeEnd) {
+				resp.Events = append(resp.Events, ev)
+			}
+		}
+		next = s.revision + 1
+		changed := s.changed
+		s.mu.Unlock()
+
+		if len(resp.Events) > 0 {
+			if err = stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func TestEtcdStore(t *testing.T) {
+	addr, stop := startEtcd(t)
+	defer stop()
+
+	c, err := clientv3.New(clientv3.Config{Endpoints: []string{addr}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = c.Close() }()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	put := func(k string, v []byte) {
+		if _, err := c.Put(ctx, k, string(v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("/istio/config/handler/ns/a", handler("a", "noop"))
+	put("/other/handler/ns/x", handler("x", "noop"))
+
+	b, err := NewEtcdStore(&url.URL{Scheme: "etcd", Host: addr, Path: "/istio/config"})
+	if err != nil {
+		t.Fatalf("NewEtcdStore() => %v", err)
+	}
+	s := b.(*Store)
+	if err = store.WithBackend(s).Init(ctx, kinds); err != nil {
+		t.Fatalf("Init() => %v", err)
+	}
+	ch, _ := s.Watch(ctx)
+	if got := s.List(); len(got) != 1 || got[key("a")] == nil {
+		t.Errorf("List() => %+v, want a", got)
+	}
+
+	put("/istio/config/handler/ns/b", handler("b", "noop"))
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Update || evs[0].Key != key("b") {
+		t.Errorf("Got %+v, want an update of b", evs[0])
+	}
+	if _, err = c.Delete(ctx, "/istio/config/handler/ns/a"); err != nil {
+		t.Fatal(err)
+	}
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Delete || evs[0].Key != key("a") {
+		t.Errorf("Got %+v, want a deletion of a", evs[0])
+	}
+
+	// invalid resources are rejected.
+	put("/istio/config/handler/ns/invalid", []byte("spec:\n  foo: 1"))
+	put("/istio/config/handler/ns/c", handler("c", "noop"))
+	if evs := nextEvents(t, ch, 1); evs[0].Type != store.Update || evs[0].Key != key("c") {
+		t.Errorf("Got %+v, want an update of c", evs[0])
+	}
+}
+
+func TestEtcdStoreCompacted(t *testing.T) {
+	addr, stop := startEtcd(t)
+	defer stop()
+
+	b, err := NewEtcdStore(&url.URL{Scheme: "etcd", Host: addr, Path: "/istio/config/"})
+	if err != nil {
+		t.Fatalf("NewEtcdStore() => %v", err)
+	}
+	ec := b.(*Store).client.(*etcdClient)
+	defer func() { _ = ec.close() }()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	u, err := ec.list(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp *clientv3.PutResponse
+	for i := 0; i < 3; i++ {
+		if resp, err = ec.client.Put(ctx, "/istio/config/handler/ns/a", "spec: {}"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = ec.client.Compact(ctx, resp.Header.Revision); err != nil {
+		t.Fatal(err)
+	}
+	err = ec.watch(ctx, u.revision, func(update) { t.Error("Got an update from a compacted revision") })
+	if err != errResync {
+		t.Errorf("watch() => %v, want %v", err, errResync)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+// Register registers this module as a StoreBackend.
+// Do not use 'init()' for automatic registration; linker will drop
+// the whole module because it looks unused.
+func Register(builders map[string]store.Builder) {
+	builders["etcd"] = NewEtcdStore
+	builders["consul"] = NewConsulStore
+}
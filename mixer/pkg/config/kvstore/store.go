@@ -0,0 +1,310 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kvstore provides the store interface to config resources stored in
+// etcd or in the KV store of Consul.
+//
+// Every resource is stored under the prefix given by the path of the config URL,
+// at <prefix>/<kind>/<namespace>/<name>. Its value is a YAML or JSON document in the
+// same format as the files of the filesystem store. The kind, namespace and name
+// can be omitted from the document, as they are derived from the key.
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+
+	"istio.io/istio/mixer/pkg/config/store"
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/probe"
+)
+
+const (
+	// initial and maximum intervals to wait before watching again after a failure.
+	minRetryInterval = 100 * time.Millisecond
+	maxRetryInterval = 30 * time.Second
+)
+
+// errResync is returned by client.watch when the changes since the requested revision
+// are not available anymore, and the store needs to list all the resources again.
+var errResync = errors.New("revision is no longer available")
+
+// errWatchClosed is returned by client.watch when the backend closes the watch.
+var errWatchClosed = errors.New("watch closed by the backend")
+
+// pair is a key-value pair read from the backend.
+type pair struct {
+	// key relative to the prefix of the store.
+	key string
+
+	// value of the pair.
+	value []byte
+
+	// deleted is true if the pair was deleted.
+	deleted bool
+
+	// revision at which the pair was last modified.
+	revision uint64
+}
+
+// update is a set of changes to the pairs under the prefix of the store.
+type update struct {
+	// pairs which were created, modified or deleted. If snapshot is true, pairs holds
+	// all the pairs under the prefix instead, and the ones missing were deleted.
+	pairs    []pair
+	snapshot bool
+
+	// revision of the backend once the update is applied.
+	revision uint64
+}
+
+// client is the access to the pairs under the prefix of the store in a backend.
+type client interface {
+	// list returns all the pairs under the prefix.
+	list(ctx context.Context) (update, error)
+
+	// watch passes the changes made after revision to notify until ctx is done or the
+	// watch fails, and returns the error.
+	watch(ctx context.Context, revision uint64, notify func(update)) error
+
+	// close releases the resources of the client.
+	close() error
+}
+
+// Store offers store.Backend interface through a key-value store.
+type Store struct {
+	client    client
+	validator store.BackendValidator
+	kinds     map[string]bool
+
+	mu       sync.RWMutex
+	data     map[store.Key]*store.BackEndResource
+	revision uint64
+
+	watchMutex sync.RWMutex
+	watchCtx   context.Context
+	watchCh    chan store.BackendEvent
+
+	*probe.Probe
+}
+
+var _ store.ValidatingBackend = &Store{}
+var _ probe.SupportsProbe = &Store{}
+
+func newStore(c client) *Store {
+	return &Store{
+		client: c,
+		kinds:  map[string]bool{},
+		data:   map[store.Key]*store.BackEndResource{},
+		Probe:  probe.NewProbe(),
+	}
+}
+
+// SetValidator implements store.ValidatingBackend interface.
+func (s *Store) SetValidator(v store.BackendValidator) {
+	s.validator = v
+}
+
+// Init implements store.Backend interface.
+func (s *Store) Init(ctx context.Context, kinds []string) error {
+	for _, k := range kinds {
+		s.kinds[k] = true
+	}
+	u, err := s.client.list(ctx)
+	if err != nil {
+		_ = s.client.close()
+		return fmt.Errorf("unable to list the resources: %v", err)
+	}
+	s.apply(u)
+	s.SetAvailable(nil)
+	go s.run(ctx)
+	return nil
+}
+
+// run watches the changes until ctx is done, watching again from the last known
+// revision whenever the watch fails.
+func (s *Store) run(ctx context.Context) {
+	defer func() { _ = s.client.close() }()
+	retryInterval := minRetryInterval
+	notify := func(u update) {
+		retryInterval = minRetryInterval
+		s.SetAvailable(nil)
+		s.apply(u)
+	}
+	for {
+		s.mu.RLock()
+		revision := s.revision
+		s.mu.RUnlock()
+
+		err := s.client.watch(ctx, revision, notify)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == errResync {
+			log.Warnf("Unable to watch the config resources from revision %d, listing them again", revision)
+			var u update
+			if u, err = s.client.list(ctx); err == nil {
+				s.apply(u)
+				continue
+			}
+		}
+		log.Warnf("Failed to watch the config resources, retrying in %v: %v", retryInterval, err)
+		s.SetAvailable(err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+		if retryInterval *= 2; retryInterval > maxRetryInterval {
+			retryInterval = maxRetryInterval
+		}
+	}
+}
+
+// parseKey returns the key of the resource stored at the path, which is relative to the prefix.
+func (s *Store) parseKey(path string) (store.Key, bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return store.Key{}, false
+	}
+	key := store.Key{Kind: parts[0], Namespace: parts[1], Name: parts[2]}
+	return key, s.kinds[key.Kind]
+}
+
+// parse parses and validates the value of the resource with the key.
+func (s *Store) parse(key store.Key, p pair) (*store.BackEndResource, error) {
+	r := &store.BackEndResource{}
+	if err := yaml.Unmarshal(p.value, r); err != nil {
+		return nil, err
+	}
+	if r.Kind == "" {
+		r.Kind = key.Kind
+	}
+	if r.Metadata.Namespace == "" {
+		r.Metadata.Namespace = key.Namespace
+	}
+	if r.Metadata.Name == "" {
+		r.Metadata.Name = key.Name
+	}
+	if r.Key() != key {
+		return nil, fmt.Errorf("resource %s is stored under the key of %s", r.Key(), key)
+	}
+	r.Metadata.Revision = strconv.FormatUint(p.revision, 10)
+	if s.validator != nil {
+		if err := s.validator.Validate(&store.BackendEvent{Key: key, Type: store.Update, Value: r}); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// apply applies the update to the resources, and dispatches the resulting events. Invalid
+// resources are rejected, leaving the previous version of the resource in place.
+func (s *Store) apply(u update) {
+	evs := []store.BackendEvent{}
+	s.mu.Lock()
+	seen := map[store.Key]bool{}
+	for _, p := range u.pairs {
+		key, ok := s.parseKey(p.key)
+		if !ok {
+			continue
+		}
+		old, exists := s.data[key]
+		if p.deleted {
+			if exists {
+				delete(s.data, key)
+				evs = append(evs, store.BackendEvent{Type: store.Delete, Key: key})
+			}
+			continue
+		}
+		seen[key] = true
+		if exists && old.Metadata.Revision == strconv.FormatUint(p.revision, 10) {
+			continue
+		}
+		r, err := s.parse(key, p)
+		if err != nil {
+			log.Errorf("Rejected the config resource at %s: %v", p.key, err)
+			continue
+		}
+		s.data[key] = r
+		evs = append(evs, store.BackendEvent{Type: store.Update, Key: key, Value: r})
+	}
+	if u.snapshot {
+		for key := range s.data {
+			if !seen[key] {
+				delete(s.data, key)
+				evs = append(evs, store.BackendEvent{Type: store.Delete, Key: key})
+			}
+		}
+	}
+	s.revision = u.revision
+	s.mu.Unlock()
+	s.dispatch(evs)
+}
+
+func (s *Store) dispatch(evs []store.BackendEvent) {
+	if len(evs) == 0 {
+		return
+	}
+	s.watchMutex.RLock()
+	defer s.watchMutex.RUnlock()
+	if s.watchCh == nil {
+		return
+	}
+	for _, ev := range evs {
+		select {
+		case <-s.watchCtx.Done():
+		case s.watchCh <- ev:
+		}
+	}
+}
+
+// Watch implements store.Backend interface.
+func (s *Store) Watch(ctx context.Context) (<-chan store.BackendEvent, error) {
+	ch := make(chan store.BackendEvent)
+	s.watchMutex.Lock()
+	s.watchCtx = ctx
+	s.watchCh = ch
+	s.watchMutex.Unlock()
+	return ch, nil
+}
+
+// Get implements store.Backend interface.
+func (s *Store) Get(key store.Key) (*store.BackEndResource, error) {
+	s.mu.RLock()
+	r, ok := s.data[key]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return r, nil
+}
+
+// List implements store.Backend interface.
+func (s *Store) List() map[store.Key]*store.BackEndResource {
+	s.mu.RLock()
+	copied := make(map[store.Key]*store.BackEndResource, len(s.data))
+	for k, r := range s.data {
+		copied[k] = r
+	}
+	s.mu.RUnlock()
+	return copied
+}
@@ -0,0 +1,258 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	cfg "istio.io/istio/mixer/pkg/config/proto"
+	"istio.io/istio/mixer/pkg/config/store"
+)
+
+var kinds = map[string]proto.Message{"handler": &cfg.Handler{}}
+
+// fakeClient serves the result of list, and the updates and errors sent to events to the watches.
+type fakeClient struct {
+	mu        sync.Mutex
+	listed    update
+	listErr   error
+	listCount int
+
+	events    chan interface{}
+	revisions chan uint64
+}
+
+func newFakeClient(u update) *fakeClient {
+	return &fakeClient{listed: u, events: make(chan interface{}), revisions: make(chan uint64, 10)}
+}
+
+func (c *fakeClient) list(ctx context.Context) (update, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listCount++
+	return c.listed, c.listErr
+}
+
+func (c *fakeClient) watch(ctx context.Context, revision uint64, notify func(update)) error {
+	c.revisions <- revision
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-c.events:
+			switch v := ev.(type) {
+			case error:
+				return v
+			case update:
+				notify(v)
+			}
+		}
+	}
+}
+
+func (c *fakeClient) close() error {
+	return nil
+}
+
+func handler(name, adapter string) []byte {
+	return []byte("kind: handler\nmetadata:\n  name: " + name + "\n  namespace: ns\nspec:\n  adapter: " + adapter)
+}
+
+func key(name string) store.Key {
+	return store.Key{Kind: "handler", Namespace: "ns", Name: name}
+}
+
+func initStore(t *testing.T, c client) (*Store, <-chan store.BackendEvent, context.CancelFunc) {
+	t.Helper()
+	s := newStore(c)
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := store.WithBackend(s).Init(ctx, kinds); err != nil {
+		cancel()
+		t.Fatalf("Init() => %v", err)
+	}
+	ch, err := s.Watch(ctx)
+	if err != nil {
+		cancel()
+		t.Fatalf("Watch() => %v", err)
+	}
+	return s, ch, cancel
+}
+
+func nextEvents(t *testing.T, ch <-chan store.BackendEvent, n int) []store.BackendEvent {
+	t.Helper()
+	evs := make([]store.BackendEvent, 0, n)
+	for len(evs) < n {
+		select {
+		case ev := <-ch:
+			evs = append(evs, ev)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Got %d events, want %d", len(evs), n)
+		}
+	}
+	sort.Slice(evs, func(i, j int) bool { return evs[i].Key.String() < evs[j].Key.String() })
+	return evs
+}
+
+func TestStoreInit(t *testing.T) {
+	c := newFakeClient(update{snapshot: true, revision: 10, pairs: []pair{
+		{key: "handler/ns/a", value: handler("a", "noop"), revision: 1},
+		{key: "handler/ns/b", value: []byte("spec:\n  adapter: noop"), revision: 2},
+		{key: "handler/ns/invalid", value: []byte("spec:\n  foo: 1"), revision: 3},
+		{key: "handler/ns/mismatch", value: handler("a", "noop"), revision: 4},
+		{key: "handler/ns/unparsable", value: []byte("{"), revision: 5},
+		{key: "rule/ns/unknown", value: []byte("spec:\n  match: \"true\""), revision: 6},
+		{key: "handler/a", value: handler("a", "noop"), revision: 7},
+	}})
+	s, _, cancel := initStore(t, c)
+	defer cancel()
+
+	want := map[store.Key]*store.BackEndResource{
+		key("a"): {
+			Kind:     "handler",
+			Metadata: store.ResourceMeta{Name: "a", Namespace: "ns", Revision: "1"},
+			Spec:     map[string]interface{}{"adapter": "noop"},
+		},
+		key("b"): {
+			Kind:     "handler",
+			Metadata: store.ResourceMeta{Name: "b", Namespace: "ns", Revision: "2"},
+			Spec:     map[string]interface{}{"adapter": "noop"},
+		},
+	}
+	if got := s.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List() => %+v, want %+v", got, want)
+	}
+	if r, err := s.Get(key("a")); err != nil || !reflect.DeepEqual(r, want[key("a")]) {
+		t.Errorf("Get() => %+v, %v, want %+v", r, err, want[key("a")])
+	}
+	if _, err := s.Get(key("invalid")); err != store.ErrNotFound {
+		t.Errorf("Get() => %v, want %v", err, store.ErrNotFound)
+	}
+	if rev := <-c.revisions; rev != 10 {
+		t.Errorf("watching from revision %d, want 10", rev)
+	}
+}
+
+func TestStoreInitFailure(t *testing.T) {
+	c := newFakeClient(update{})
+	c.listErr = errors.New("unavailable")
+	if err := newStore(c).Init(context.Background(), []string{"handler"}); err == nil {
+		t.Error("Init() => nil, want error")
+	}
+}
+
+func TestStoreWatch(t *testing.T) {
+	c := newFakeClient(update{snapshot: true, revision: 2, pairs: []pair{
+		{key: "handler/ns/a", value: handler("a", "noop"), revision: 1},
+		{key: "handler/ns/b", value: handler("b", "noop"), revision: 2},
+	}})
+	s, ch, cancel := initStore(t, c)
+	defer cancel()
+	<-c.revisions
+
+	c.events <- update{revision: 4, pairs: []pair{
+		{key: "handler/ns/a", value: handler("a", "stdio"), revision: 3},
+		{key: "handler/ns/b", deleted: true, revision: 4},
+	}}
+	evs := nextEvents(t, ch, 2)
+	if evs[0].Type != store.Update || evs[0].Key != key("a") || evs[0].Value.Spec["adapter"] != "stdio" {
+		t.Errorf("Got %+v, want an update of a", evs[0])
+	}
+	if evs[1].Type != store.Delete || evs[1].Key != key("b") {
+		t.Errorf("Got %+v, want a deletion of b", evs[1])
+	}
+
+	// invalid updates are rejected, leaving the previous version in place.
+	c.events <- update{revision: 5, pairs: []pair{{key: "handler/ns/a", value: []byte("spec:\n  foo: 1"), revision: 5}}}
+	c.events <- update{revision: 6, pairs: []pair{{key: "handler/ns/c", value: handler("c", "noop"), revision: 6}}}
+	if evs = nextEvents(t, ch, 1); evs[0].Key != key("c") {
+		t.Errorf("Got %+v, want an update of c", evs[0])
+	}
+	if r, err := s.Get(key("a")); err != nil || r.Spec["adapter"] != "stdio" {
+		t.Errorf("Get() => %+v, %v, want the previous version", r, err)
+	}
+
+	// snapshots only produce events for the changed resources.
+	c.events <- update{snapshot: true, revision: 7, pairs: []pair{
+		{key: "handler/ns/a", value: handler("a", "stdio"), revision: 3},
+		{key: "handler/ns/d", value: handler("d", "noop"), revision: 7},
+	}}
+	evs = nextEvents(t, ch, 2)
+	if evs[0].Type != store.Delete || evs[0].Key != key("c") {
+		t.Errorf("Got %+v, want a deletion of c", evs[0])
+	}
+	if evs[1].Type != store.Update || evs[1].Key != key("d") {
+		t.Errorf("Got %+v, want an update of d", evs[1])
+	}
+}
+
+func TestStoreResume(t *testing.T) {
+	c := newFakeClient(update{snapshot: true, revision: 1, pairs: []pair{
+		{key: "handler/ns/a", value: handler("a", "noop"), revision: 1},
+	}})
+	_, ch, cancel := initStore(t, c)
+	defer cancel()
+	<-c.revisions
+
+	c.events <- update{revision: 2, pairs: []pair{{key: "handler/ns/b", value: handler("b", "noop"), revision: 2}}}
+	nextEvents(t, ch, 1)
+
+	// the watch resumes from the last revision after a failure.
+	c.events <- errors.New("disconnected")
+	if rev := <-c.revisions; rev != 2 {
+		t.Errorf("watching from revision %d, want 2", rev)
+	}
+
+	// the resources are listed again when the revision is no longer available.
+	c.mu.Lock()
+	c.listed = update{snapshot: true, revision: 5, pairs: []pair{
+		{key: "handler/ns/b", value: handler("b", "noop"), revision: 2},
+		{key: "handler/ns/c", value: handler("c", "noop"), revision: 5},
+	}}
+	c.mu.Unlock()
+	c.events <- errResync
+	evs := nextEvents(t, ch, 2)
+	if evs[0].Type != store.Delete || evs[0].Key != key("a") {
+		t.Errorf("Got %+v, want a deletion of a", evs[0])
+	}
+	if evs[1].Type != store.Update || evs[1].Key != key("c") {
+		t.Errorf("Got %+v, want an update of c", evs[1])
+	}
+	if rev := <-c.revisions; rev != 5 {
+		t.Errorf("watching from revision %d, want 5", rev)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.listCount != 2 {
+		t.Errorf("listed %d times, want 2", c.listCount)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	builders := map[string]store.Builder{}
+	Register(builders)
+	for _, scheme := range []string{"etcd", "consul"} {
+		if _, ok := builders[scheme]; !ok {
+			t.Errorf("%s is not registered", scheme)
+		}
+	}
+}
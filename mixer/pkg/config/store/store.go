@@ -125,6 +125,15 @@ type Backend interface {
 	List() map[Key]*BackEndResource
 }
 
+// ValidatingBackend is a Backend which has no admission control of its own, like the
+// validation webhook of the kubernetes store, and rejects invalid resources itself.
+type ValidatingBackend interface {
+	Backend
+
+	// SetValidator sets the validator of the resources. It is called before Init.
+	SetValidator(v BackendValidator)
+}
+
 // Store defines the access to the storage for mixer.
 type Store interface {
 	Init(ctx context.Context, kinds map[string]proto.Message) error
@@ -165,6 +174,9 @@ func (s *store) Init(ctx context.Context, kinds map[string]proto.Message) error
 	for k := range kinds {
 		kindNames = append(kindNames, k)
 	}
+	if vb, ok := s.backend.(ValidatingBackend); ok {
+		vb.SetValidator(NewValidator(nil, kinds))
+	}
 	if err := s.backend.Init(ctx, kindNames); err != nil {
 		return err
 	}
@@ -218,6 +218,36 @@ func TestStoreFail(t *testing.T) {
 	}
 }
 
+type testValidatingStore struct {
+	*testStore
+	validator BackendValidator
+}
+
+func (t *testValidatingStore) SetValidator(v BackendValidator) {
+	t.validator = v
+}
+
+func TestStoreValidatingBackend(t *testing.T) {
+	b := &testValidatingStore{testStore: newTestBackend()}
+	s := WithBackend(b)
+	kinds := map[string]proto.Message{"Handler": &cfg.Handler{}}
+	if err := s.Init(context.Background(), kinds); err != nil {
+		t.Fatal(err)
+	}
+	if b.validator == nil {
+		t.Fatal("Got no validator, Want one")
+	}
+	k := Key{Kind: "Handler", Name: "name", Namespace: "ns"}
+	ev := &BackendEvent{Type: Update, Key: k, Value: &BackEndResource{Spec: map[string]interface{}{"foo": 1}}}
+	if err := b.validator.Validate(ev); err == nil {
+		t.Error("Got nil, Want error for an invalid spec")
+	}
+	ev.Value.Spec = map[string]interface{}{"name": "default", "adapter": "noop"}
+	if err := b.validator.Validate(ev); err != nil {
+		t.Errorf("Got %v, Want nil", err)
+	}
+}
+
 func TestRegistry(t *testing.T) {
 	r := NewRegistry(registerTestStore)
 	for _, c := range []struct {
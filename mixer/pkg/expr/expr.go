@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"strconv"
 	"strings"
@@ -251,6 +252,10 @@ func (f *Function) EvalType(attrs AttributeDescriptorFinder, fMap map[string]Fun
 			}
 			expectedType = tmplType
 		}
+		// string literals which parse as durations, like "0", are still valid strings.
+		if argType == dpb.DURATION && expectedType == dpb.STRING && f.Args[idx].Const != nil {
+			continue
+		}
 		if argType != expectedType {
 			return valueType, fmt.Errorf("%s arg %d (%s) typeError got %s, expected %s", f, idx+1, f.Args[idx], argType, expectedType)
 		}
@@ -292,6 +297,18 @@ func process(ex ast.Expr, tgt *Expression) (err error) {
 			return
 		}
 	case *ast.BinaryExpr:
+		if v.Op == token.AND_NOT {
+			// x in y, see rewriteIn. It is the same as x.in(y).
+			target := &Expression{}
+			if err = process(v.X, target); err != nil {
+				return
+			}
+			tgt.Fn = &Function{Name: "in", Target: target}
+			if err = processFunc(tgt.Fn, []ast.Expr{v.Y}); err != nil {
+				return
+			}
+			return nil
+		}
 		tgt.Fn = &Function{Name: tMap[v.Op]}
 		if err = processFunc(tgt.Fn, []ast.Expr{v.X, v.Y}); err != nil {
 			return
@@ -420,9 +437,48 @@ func processFunc(fn *Function, args []ast.Expr) (err error) {
 	return nil
 }
 
+// rewriteIn replaces the infix operator in, as in ip("10.0.0.1") in cidr("10/8"), with &^ so that
+// the Go parser accepts it. &^ is not otherwise part of the language, and has the precedence of
+// the multiplicative operators. Both are two characters long, so the positions in src are kept.
+func rewriteIn(src string) (string, error) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	// errors are reported by the parser.
+	s.Init(file, []byte(src), nil, 0)
+
+	out := []byte(src)
+	prev := token.ILLEGAL
+	for {
+		pos, tok, lit := s.Scan()
+		switch tok {
+		case token.EOF:
+			return string(out), nil
+		case token.AND_NOT:
+			return "", fmt.Errorf("unexpected operator %s", tok)
+		case token.IDENT:
+			// in is an operator only when it follows an operand, otherwise it is an attribute name or
+			// the in function of the form x.in(y).
+			switch prev {
+			case token.IDENT, token.INT, token.FLOAT, token.CHAR, token.STRING, token.RPAREN, token.RBRACK:
+				if lit == "in" {
+					off := file.Offset(pos)
+					out[off], out[off+1] = '&', '^'
+					tok = token.AND_NOT
+				}
+			}
+		}
+		prev = tok
+	}
+}
+
 // Parse parses a given expression to ast.Expression.
 func Parse(src string) (ex *Expression, err error) {
-	a, err := parser.ParseExpr(src)
+	rewritten, err := rewriteIn(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse expression '%s': %v", src, err)
+	}
+	a, err := parser.ParseExpr(rewritten)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse expression '%s': %v", src, err)
 	}
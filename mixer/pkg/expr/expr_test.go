@@ -60,6 +60,10 @@ func TestGoodParse(t *testing.T) {
 		{`"abc".matches("foo")`, `"abc":matches("foo")`},
 		{`"abc".prefix(23).matches("foo")`, `"abc":prefix(23):matches("foo")`},
 		{`"abc".matches("foo")`, `"abc":matches("foo")`},
+		{`ip("10.0.0.1") in cidr("10/8")`, `ip("10.0.0.1"):in(cidr("10/8"))`},
+		{`a.b in "10/8" && c`, `LAND($a.b:in("10/8"), $c)`},
+		{`a.in("10/8")`, `$a:in("10/8")`},
+		{`in == 2`, `EQ($in, 2)`},
 	}
 	for idx, tt := range tests {
 		t.Run(fmt.Sprintf("[%d] %s", idx, tt.src), func(t *testing.T) {
@@ -207,6 +211,8 @@ func TestBadParse(t *testing.T) {
 		{`foo{}.bar()`, `unexpected expression`},
 		{`(foo{}).bar()`, `unexpected expression`},
 		{`a().b`, `unexpected expression`},
+		{`a &^ b`, `unexpected operator`},
+		{`a in`, `unable to parse`},
 	}
 	for idx, tt := range tests {
 		t.Run(fmt.Sprintf("[%d] %s", idx, tt.src), func(t *testing.T) {
@@ -323,6 +329,7 @@ func TestInternalTypeCheck(t *testing.T) {
 				}},
 			},
 			`$no:matches("a") target typeError got INT64`},
+		{`r.h["0"]`, dpb.STRING, []*ad{{"r.h", dpb.STRING_MAP}}, nil, success},
 		{`"abc".genericEquals("cba")`, dpb.BOOL, []*ad{},
 			[]FunctionMetadata{
 				{Name: "genericEquals", Instance: true, TargetType: dpb.VALUE_TYPE_UNSPECIFIED, ReturnType: dpb.BOOL, ArgumentTypes: []dpb.ValueType{
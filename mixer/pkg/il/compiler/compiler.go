@@ -23,6 +23,7 @@ import (
 	descriptor "istio.io/api/mixer/v1/config/descriptor"
 	"istio.io/istio/mixer/pkg/expr"
 	"istio.io/istio/mixer/pkg/il"
+	"istio.io/istio/mixer/pkg/il/optimizer"
 	"istio.io/istio/pkg/log"
 )
 
@@ -32,16 +33,23 @@ type Compiler struct {
 	program   *il.Program
 	finder    expr.AttributeDescriptorFinder
 	functions map[string]expr.FunctionMetadata
+	optimize  bool
 
 	nextFnID int
 }
 
-// New returns a new compiler instance.
+// New returns a new compiler instance. The generated functions are optimized.
 func New(finder expr.AttributeDescriptorFinder, functions map[string]expr.FunctionMetadata) *Compiler {
+	return newCompiler(finder, functions, true)
+}
+
+func newCompiler(finder expr.AttributeDescriptorFinder, functions map[string]expr.FunctionMetadata,
+	optimize bool) *Compiler {
 	return &Compiler{
 		finder:    finder,
 		program:   il.NewProgram(),
 		functions: functions,
+		optimize:  optimize,
 		nextFnID:  0,
 	}
 }
@@ -75,6 +83,9 @@ func (c *Compiler) CompileExpression(text string) (uint32, descriptor.ValueType,
 	g.builder.Ret()
 
 	body := g.builder.Build()
+	if c.optimize {
+		body = optimizer.Optimize(body)
+	}
 
 	name := fmt.Sprintf("$expression%d", c.nextFnID)
 	c.nextFnID++
@@ -121,8 +132,13 @@ type Result struct {
 	Expression *expr.Expression
 }
 
-// Compile converts the given expression text, into an IL based program.
+// Compile converts the given expression text, into an optimized IL based program.
 func Compile(text string, finder expr.AttributeDescriptorFinder, functions map[string]expr.FunctionMetadata) (*il.Program, error) {
+	return compile(text, finder, functions, true)
+}
+
+func compile(text string, finder expr.AttributeDescriptorFinder, functions map[string]expr.FunctionMetadata,
+	optimize bool) (*il.Program, error) {
 	// TODO: This function should either be eliminated entirely, or use the Compiler struct, once we switch over to
 	// to using compiled expressions. Keeping this here in its current form to avoid generation of excessive garbage
 	// in the request path.
@@ -155,6 +171,9 @@ func Compile(text string, finder expr.AttributeDescriptorFinder, functions map[s
 
 	g.builder.Ret()
 	body := g.builder.Build()
+	if optimize {
+		body = optimizer.Optimize(body)
+	}
 	if err = g.program.AddFunction("eval", []il.Type{}, returnType, body); err != nil {
 		g.internalError(err.Error())
 		return nil, err
@@ -279,6 +298,8 @@ func (g *generator) generateFunction(f *expr.Function, depth int, mode nilMode,
 		g.generateIndex(f, depth, mode, valueJmpLabel)
 	case "OR":
 		g.generateOr(f, depth, mode, valueJmpLabel)
+	case "conditional":
+		g.generateConditional(f, depth, mode, valueJmpLabel)
 	default:
 		if f.Target != nil {
 			g.generate(f.Target, depth, nmNone, "")
@@ -287,6 +308,11 @@ func (g *generator) generateFunction(f *expr.Function, depth int, mode nilMode,
 			g.generate(arg, depth, nmNone, "")
 		}
 		g.builder.Call(f.Name)
+
+		// Function calls either produce a value or raise an error.
+		if mode == nmJmpOnValue {
+			g.builder.Jmp(valueJmpLabel)
+		}
 	}
 }
 
@@ -337,6 +363,12 @@ func (g *generator) generateEq(f *expr.Function, depth int) {
 			g.builder.Call("ip_equal")
 		case descriptor.TIMESTAMP:
 			g.builder.Call("timestamp_equal")
+		case descriptor.EMAIL_ADDRESS:
+			g.builder.Call("email_equal")
+		case descriptor.DNS_NAME:
+			g.builder.Call("dnsName_equal")
+		case descriptor.URI:
+			g.builder.Call("uri_equal")
 		default:
 			g.internalError("equality for type not yet implemented: %v", exprType)
 		}
@@ -482,6 +514,21 @@ func (g *generator) generateOr(f *expr.Function, depth int, mode nilMode, valueJ
 	}
 }
 
+func (g *generator) generateConditional(f *expr.Function, depth int, mode nilMode, valueJmpLabel string) {
+	// Only one of Args[1] and Args[2] is evaluated, depending on the value of Args[0]. If the
+	// caller expects a jump on value, the jump is performed from within the evaluated argument,
+	// otherwise both branches leave their value on the stack at the end label.
+	lFalse := g.builder.AllocateLabel()
+	lEnd := g.builder.AllocateLabel()
+	g.generate(f.Args[0], depth+1, nmNone, "")
+	g.builder.Jz(lFalse)
+	g.generate(f.Args[1], depth+1, mode, valueJmpLabel)
+	g.builder.Jmp(lEnd)
+	g.builder.SetLabelPos(lFalse)
+	g.generate(f.Args[2], depth+1, mode, valueJmpLabel)
+	g.builder.SetLabelPos(lEnd)
+}
+
 func (g *generator) generateConstant(c *expr.Constant, mode nilMode, valueJmpLabel string) {
 	switch c.Type {
 	case descriptor.STRING:
@@ -43,7 +43,7 @@ func TestCompiler_SingleExpressionSession(t *testing.T) {
 			if test.Fns != nil {
 				fns = append(fns, test.Fns...)
 			}
-			compiler := New(finder, expr.FuncMap(fns))
+			compiler := newCompiler(finder, expr.FuncMap(fns), false)
 			fnID, _, err := compiler.CompileExpression(test.E)
 
 			if err != nil {
@@ -80,6 +80,15 @@ func TestCompiler_SingleExpressionSession(t *testing.T) {
 				t.Errorf(e.Error())
 				return
 			}
+
+			// The optimized version of the expression should evaluate the same way.
+			compiler = New(finder, expr.FuncMap(fns))
+			if fnID, _, err = compiler.CompileExpression(test.E); err != nil {
+				tt.Fatalf("Unexpected compile error: '%s'", err.Error())
+			}
+			if e := doEval(test, compiler.program, fnID); e != nil {
+				t.Errorf("optimized: %v", e)
+			}
 		})
 	}
 }
@@ -154,7 +163,12 @@ func doEval(test ilt.TestInfo, p *il.Program, fnID uint32) error {
 }
 
 func TestCompile(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		testCompile(t, optimize)
+	}
+}
 
+func testCompile(t *testing.T, optimize bool) {
 	for i, test := range ilt.TestData {
 		// If there is no expression in the test, skip it. It is most likely an interpreter test that directly runs
 		// off IL.
@@ -162,7 +176,7 @@ func TestCompile(t *testing.T) {
 			continue
 		}
 
-		name := fmt.Sprintf("%d '%s'", i, test.TestName())
+		name := fmt.Sprintf("%d '%s' optimize=%v", i, test.TestName(), optimize)
 		t.Run(name, func(tt *testing.T) {
 
 			finder := expr.NewFinder(test.Conf())
@@ -171,7 +185,7 @@ func TestCompile(t *testing.T) {
 			if test.Fns != nil {
 				fns = append(fns, test.Fns...)
 			}
-			program, err := Compile(test.E, finder, expr.FuncMap(fns))
+			program, err := compile(test.E, finder, expr.FuncMap(fns), optimize)
 			if err != nil {
 				if err.Error() != test.CompileErr {
 					tt.Fatalf("Unexpected error: '%s' != '%s'", err.Error(), test.CompileErr)
@@ -184,7 +198,8 @@ func TestCompile(t *testing.T) {
 				return
 			}
 
-			if test.IL != "" {
+			// The expected IL is not optimized.
+			if test.IL != "" && !optimize {
 				actual := text.WriteText(program)
 				if strings.TrimSpace(actual) != strings.TrimSpace(test.IL) {
 					tt.Log("===== EXPECTED ====\n")
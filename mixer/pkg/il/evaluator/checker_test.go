@@ -59,8 +59,22 @@ func TestTypeCheck(t *testing.T) {
 		{"int == 2", dpb.BOOL, ""},
 		{"double == 2.0", dpb.BOOL, ""},
 		{`string | "foobar"`, dpb.STRING, ""},
+		{`ip.in(cidr("10/8"))`, dpb.BOOL, ""},
+		{`ip in cidr("10/8") && bool`, dpb.BOOL, ""},
+		{`string.toLower().substring(0, 2)`, dpb.STRING, ""},
+		{`string.split(",")["0"]`, dpb.STRING, ""},
+		{`stringmap.keys()`, dpb.STRING_MAP, ""},
+		{`stringmap.contains("foo")`, dpb.BOOL, ""},
+		{`email("joe@example.com") == email`, dpb.BOOL, ""},
+		{`dnsName("example.com")`, dpb.DNS_NAME, ""},
+		{`uri("http://example.com")`, dpb.URI, ""},
+		{`conditional(bool, int, 2)`, dpb.INT64, ""},
 		// invalid expressions
 		{"int | bool", dpb.VALUE_TYPE_UNSPECIFIED, "typeError"},
+		{`string.in(cidr("10/8"))`, dpb.VALUE_TYPE_UNSPECIFIED, "typeError"},
+		{`string in cidr("10/8")`, dpb.VALUE_TYPE_UNSPECIFIED, "typeError"},
+		{`conditional(bool, int, "foo")`, dpb.VALUE_TYPE_UNSPECIFIED, "typeError"},
+		{`conditional(string, int, 2)`, dpb.VALUE_TYPE_UNSPECIFIED, "typeError"},
 		{"stringmap | ", dpb.VALUE_TYPE_UNSPECIFIED, "failed to parse"},
 	}
 
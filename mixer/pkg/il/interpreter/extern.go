@@ -35,7 +35,9 @@ type Extern struct {
 // function signature is incompatible to be an extern.
 //
 // A function can be extern under the following conditions:
-// - Input parameter types are one of the supported types: string, bool, int64, float64, map[string]string.
+// - Input parameter types are one of the supported types: string, bool, int64, float64, map[string]string,
+//   or interface{} for values of any of the interface types (e.g. maps that can be either map[string]string
+//   or il.StringMap).
 // - The return types can be:
 //   - none                                 (i.e. func (...) {...})
 //   - a supported type                     (i.e. func (...) string {...})
@@ -122,6 +124,9 @@ func ilType(t reflect.Type) il.Type {
 			return il.Interface
 		}
 	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return il.Interface
+		}
 		switch t.Name() {
 		case "StringMap":
 			return il.Interface
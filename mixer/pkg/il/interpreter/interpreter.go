@@ -39,7 +39,7 @@ import (
 const (
 	callStackSize = 64
 	opStackSize   = 64
-	registerCount = 4
+	registerCount = il.RegisterCount
 	heapSize      = 64
 )
 
@@ -17,11 +17,20 @@ package interpreter
 import (
 	"testing"
 
+	"istio.io/istio/mixer/pkg/il/optimizer"
 	"istio.io/istio/mixer/pkg/il/testing"
 	"istio.io/istio/mixer/pkg/il/text"
 )
 
 func BenchmarkInterpreter(b *testing.B) {
+	benchmarkInterpreter(b, false)
+}
+
+func BenchmarkInterpreterOptimized(b *testing.B) {
+	benchmarkInterpreter(b, true)
+}
+
+func benchmarkInterpreter(b *testing.B, optimize bool) {
 	for _, test := range ilt.TestData {
 		if !test.Bench {
 			continue
@@ -31,6 +40,11 @@ func BenchmarkInterpreter(b *testing.B) {
 		if err != nil {
 			b.Fatalf("Unable to parse program text: %v", err)
 		}
+		if optimize {
+			if p, err = optimizer.OptimizeProgram(p); err != nil {
+				b.Fatalf("Unable to optimize program: %v", err)
+			}
+		}
 		id := p.Functions.IDOf("eval")
 		if id == 0 {
 			b.Fatal("function not found: 'eval'")
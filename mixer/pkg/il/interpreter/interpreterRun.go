@@ -875,8 +875,6 @@ func (in *Interpreter) run(fn *il.Function, bag attribute.Bag, step bool) (Resul
 		case il.Call:
 			t1 = body[ip]
 			ip++
-			frames[fp].save(&registers, sp-typesStackAllocSize(fn.Parameters), ip, fn)
-			fp++
 			fn2 := in.program.Functions.GetByID(t1)
 
 			if fn2 == nil {
@@ -884,8 +882,6 @@ func (in *Interpreter) run(fn *il.Function, bag attribute.Bag, step bool) (Resul
 				goto RETURN_ERR
 			}
 			if fn2.Address == 0 {
-				fp--
-
 				ext := in.externs[strings.GetString(t1)]
 				t2 = typesStackAllocSize(fn2.Parameters)
 				if sp < t2 {
@@ -902,7 +898,10 @@ func (in *Interpreter) run(fn *il.Function, bag attribute.Bag, step bool) (Resul
 				break
 			}
 
-			ip = fn2.Address
+			frames[fp].save(&registers, sp-typesStackAllocSize(fn2.Parameters), ip, fn)
+			fp++
+			fn = fn2
+			ip = fn.Address
 
 		case il.Ret:
 			if fp == 0 {
@@ -958,6 +957,7 @@ func (in *Interpreter) run(fn *il.Function, bag attribute.Bag, step bool) (Resul
 					copy(in.stepper.registers[:], registers[:])
 					in.stepper.sp = sp
 					in.stepper.ip = ip
+					in.stepper.fn = fn
 					in.stepper.fp = fp
 					copy(in.stepper.opstack, opstack)
 					copy(in.stepper.frames, frames)
@@ -1132,6 +1132,7 @@ func (in *Interpreter) run(fn *il.Function, bag attribute.Bag, step bool) (Resul
 			copy(in.stepper.registers[:], registers[:])
 			in.stepper.sp = sp
 			in.stepper.ip = ip
+			in.stepper.fn = fn
 			in.stepper.fp = fp
 			copy(in.stepper.opstack, opstack)
 			copy(in.stepper.frames, frames)
@@ -1582,6 +1582,46 @@ func TestInterpreter_Eval(t *testing.T) {
 		`,
 			expected: "zoo",
 		},
+		"call/return/registers": {
+			code: `
+		fn main() integer
+			aload_i r2 42
+			call foo
+			rpush_i r2
+			ret
+		end
+
+		fn foo() void
+			aload_i r2 7
+			ret
+		end
+		`,
+			expected: int64(42),
+		},
+		"call/return/registers/nested": {
+			code: `
+		fn main() integer
+			aload_i r2 40
+			call foo
+			rpush_i r2
+			add_i
+			ret
+		end
+
+		fn foo() integer
+			aload_i r2 7
+			call bar
+			rpush_i r2
+			ret
+		end
+
+		fn bar() void
+			aload_i r2 9
+			ret
+		end
+		`,
+			expected: int64(47),
+		},
 		"extern/ret/string": {
 			code: `
 		fn main() string
@@ -26,7 +26,7 @@ type stackFrame struct {
 
 // save copies the supplied interpreter state variables into the stack frame.
 func (s *stackFrame) save(registers *[registerCount]uint32, sp uint32, ip uint32, fn *il.Function) {
-	copy(registers[:], s.registers[:])
+	copy(s.registers[:], registers[:])
 	s.sp = sp
 	s.ip = ip
 	s.fn = fn
@@ -34,7 +34,7 @@ func (s *stackFrame) save(registers *[registerCount]uint32, sp uint32, ip uint32
 
 // restore updates the supplied target state variables from the state captured in the stackFrame.
 func (s *stackFrame) restore(registers *[registerCount]uint32, sp *uint32, ip *uint32, fn **il.Function) {
-	copy(s.registers[:], registers[:])
+	copy(registers[:], s.registers[:])
 	*sp = s.sp
 	*ip = s.ip
 	*fn = s.fn
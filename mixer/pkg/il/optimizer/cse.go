@@ -0,0 +1,197 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"istio.io/istio/mixer/pkg/il"
+)
+
+// resolve identifies the resolution of an attribute by its opcode and the id of its name.
+type resolve struct {
+	op   il.Opcode
+	name uint32
+}
+
+// registerOps are the opcodes which copy the values resolved by an opcode to and from registers.
+type registerOps struct {
+	dup   il.Opcode
+	load  il.Opcode
+	push  il.Opcode
+	width uint32
+}
+
+var resolveRegisterOps = map[il.Opcode]registerOps{
+	il.ResolveS: {il.DupS, il.RLoadS, il.RPushS, 1},
+	il.ResolveB: {il.DupB, il.RLoadB, il.RPushB, 1},
+	il.ResolveI: {il.DupI, il.RLoadI, il.RPushI, 2},
+	il.ResolveD: {il.DupD, il.RLoadD, il.RPushD, 2},
+	// Interface values are references to the heap, which are copied the same way as strings.
+	il.ResolveF: {il.DupS, il.RLoadS, il.RPushS, 1},
+}
+
+// eliminateCommonResolves replaces the resolutions of attributes that are already resolved along
+// all the paths that lead to them, with a push of the value that is stored in a register by the
+// first resolution. The attributes are resolved in the order of their first use, as long as there
+// are free registers.
+func (o *optimizer) eliminateCommonResolves() {
+	available := o.availableResolves()
+
+	var order []resolve
+	redundant := make(map[resolve]bool)
+	for i, in := range o.ins {
+		if _, ok := resolveRegisterOps[in.op]; ok {
+			r := resolve{in.op, in.args[0]}
+			if available[i][r] && !redundant[r] {
+				redundant[r] = true
+				order = append(order, r)
+			}
+		}
+	}
+	if len(order) == 0 {
+		return
+	}
+
+	free := o.freeRegisters()
+	registers := make(map[resolve]uint32)
+	for _, r := range order {
+		width := resolveRegisterOps[r.op].width
+		for reg := uint32(0); reg+width <= il.RegisterCount; reg++ {
+			if free[reg] && free[reg+width-1] {
+				registers[r] = reg
+				free[reg], free[reg+width-1] = false, false
+				break
+			}
+		}
+	}
+
+	ins := make([]*instruction, 0, len(o.ins)+2*len(registers))
+	for i, in := range o.ins {
+		ops, ok := resolveRegisterOps[in.op]
+		if !ok {
+			ins = append(ins, in)
+			continue
+		}
+		r := resolve{in.op, in.args[0]}
+		reg, ok := registers[r]
+		switch {
+		case !ok:
+			ins = append(ins, in)
+		case available[i][r]:
+			in.op, in.args = ops.push, []uint32{reg}
+			ins = append(ins, in)
+		default:
+			ins = append(ins, in,
+				&instruction{op: ops.dup},
+				&instruction{op: ops.load, args: []uint32{reg}})
+		}
+	}
+	o.ins = ins
+}
+
+// availableResolves computes the set of attributes that are resolved along all the paths that lead to
+// each instruction. As the resolutions raise an error if the attributes are missing, the values of
+// the attributes are known after their resolution.
+func (o *optimizer) availableResolves() []map[resolve]bool {
+	index := make(map[*instruction]int, len(o.ins))
+	for i, in := range o.ins {
+		index[in] = i
+	}
+	preds := make([][]int, len(o.ins))
+	for i, in := range o.ins {
+		if in.target != nil {
+			t := index[in.target]
+			preds[t] = append(preds[t], i)
+		}
+		if !isTerminal(in.op) && i+1 < len(o.ins) {
+			preds[i+1] = append(preds[i+1], i)
+		}
+	}
+
+	// nil stands for the set of all the resolutions, until the instruction is visited.
+	in := make([]map[resolve]bool, len(o.ins))
+	out := make([]map[resolve]bool, len(o.ins))
+	in[0] = map[resolve]bool{}
+	for changed := true; changed; {
+		changed = false
+		for i, ins := range o.ins {
+			var entry map[resolve]bool
+			if i == 0 {
+				entry = in[0]
+			} else {
+				entry = intersect(out, preds[i])
+			}
+			if entry == nil {
+				continue
+			}
+			exit := make(map[resolve]bool, len(entry)+1)
+			for r := range entry {
+				exit[r] = true
+			}
+			if _, ok := resolveRegisterOps[ins.op]; ok {
+				exit[resolve{ins.op, ins.args[0]}] = true
+			}
+			if out[i] == nil || len(out[i]) != len(exit) {
+				changed = true
+			}
+			in[i], out[i] = entry, exit
+		}
+	}
+	return in
+}
+
+// intersect returns the intersection of the sets of the predecessors which are visited, or nil if
+// none of them is visited.
+func intersect(sets []map[resolve]bool, preds []int) map[resolve]bool {
+	var r map[resolve]bool
+	for _, p := range preds {
+		if sets[p] == nil {
+			continue
+		}
+		if r == nil {
+			r = make(map[resolve]bool, len(sets[p]))
+			for k := range sets[p] {
+				r[k] = true
+			}
+			continue
+		}
+		for k := range r {
+			if !sets[p][k] {
+				delete(r, k)
+			}
+		}
+	}
+	return r
+}
+
+// freeRegisters returns the registers which are not used by the instructions.
+func (o *optimizer) freeRegisters() []bool {
+	free := make([]bool, il.RegisterCount)
+	for i := range free {
+		free[i] = true
+	}
+	for _, in := range o.ins {
+		for i, a := range in.op.Args() {
+			if a != il.OpcodeArgRegister {
+				continue
+			}
+			reg := in.args[i]
+			for j := uint32(0); j < 2 && reg+j < il.RegisterCount; j++ {
+				// conservatively assume that all the registers are used for 64-bit values.
+				free[reg+j] = false
+			}
+		}
+	}
+	return free
+}
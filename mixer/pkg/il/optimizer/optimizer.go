@@ -0,0 +1,463 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package optimizer implements an optimizing pass over the IL function bodies that are generated
+// by the compiler. The optimizer preserves the observable behavior of the functions: their results,
+// the errors they raise, and the attributes they reference. It performs the following rewrites:
+//
+//   - Constant folding of the comparisons and logical operations on constant operands.
+//   - Elimination of the conditional jumps on constant operands (e.g. the branches of "LOR" and
+//     "LAND" with constant operands), and of the code that becomes unreachable as a result.
+//   - Peephole rewrites of opcode sequences, such as "not, jz" into "jnz", and threading of
+//     jumps to jumps and returns.
+//   - Common sub-expression elimination of the attribute resolutions which are repeated along all
+//     the paths of the function. The resolved value is kept in a register and pushed from there
+//     on subsequent uses.
+package optimizer
+
+import (
+	"istio.io/istio/mixer/pkg/il"
+)
+
+// maxPasses is the maximum number of times the rewrite passes are run until the body stabilizes.
+const maxPasses = 16
+
+// instruction is a decoded instruction of a function body.
+type instruction struct {
+	op   il.Opcode
+	args []uint32
+
+	// target is the target of jump instructions.
+	target *instruction
+
+	// removed indicates that the instruction is removed by the current pass.
+	removed bool
+
+	// address is the address of the instruction, when encoding the body.
+	address uint32
+}
+
+type optimizer struct {
+	ins []*instruction
+
+	// targets is the set of instructions that are the targets of jumps.
+	targets map[*instruction]bool
+}
+
+// Optimize returns the optimized version of the given function body. The jump addresses in the body
+// are relative to the beginning of the body, as produced by il.Builder. If the body can't be decoded,
+// it is returned as is.
+func Optimize(body []uint32) []uint32 {
+	ins, ok := decode(body)
+	if !ok {
+		return body
+	}
+	o := &optimizer{ins: ins}
+
+	for i := 0; i < maxPasses; i++ {
+		o.findTargets()
+		changed := o.peephole()
+		changed = o.removeUnreachable() || changed
+		if !o.compact() {
+			return body
+		}
+		if !changed {
+			break
+		}
+	}
+	o.eliminateCommonResolves()
+
+	return encode(o.ins)
+}
+
+func decode(body []uint32) ([]*instruction, bool) {
+	var ins []*instruction
+	byAddress := make(map[uint32]*instruction)
+	for i := uint32(0); i < uint32(len(body)); {
+		op := il.Opcode(body[i])
+		if op.Keyword() == "" || i+op.Size() > uint32(len(body)) {
+			return nil, false
+		}
+		in := &instruction{op: op, args: append([]uint32{}, body[i+1:i+op.Size()]...)}
+		ins = append(ins, in)
+		byAddress[i] = in
+		i += op.Size()
+	}
+
+	for _, in := range ins {
+		if isJump(in.op) {
+			if in.target = byAddress[in.args[0]]; in.target == nil {
+				return nil, false
+			}
+		}
+	}
+	return ins, len(ins) > 0
+}
+
+func encode(ins []*instruction) []uint32 {
+	var address uint32
+	for _, in := range ins {
+		in.address = address
+		address += in.op.Size()
+	}
+
+	body := make([]uint32, 0, address)
+	for _, in := range ins {
+		body = append(body, uint32(in.op))
+		if isJump(in.op) {
+			body = append(body, in.target.address)
+		} else {
+			body = append(body, in.args...)
+		}
+	}
+	return body
+}
+
+func isJump(op il.Opcode) bool {
+	return op == il.Jmp || op == il.Jz || op == il.Jnz
+}
+
+// isTerminal returns whether the execution never continues with the next instruction.
+func isTerminal(op il.Opcode) bool {
+	return op == il.Jmp || op == il.Ret || op == il.Halt || op == il.Err
+}
+
+func (o *optimizer) findTargets() {
+	o.targets = make(map[*instruction]bool)
+	for _, in := range o.ins {
+		if in.target != nil {
+			o.targets[in.target] = true
+		}
+	}
+}
+
+// compact drops the removed instructions, and moves the jumps to removed instructions to the
+// instruction that follows them. It returns false if a jump is left without a target.
+func (o *optimizer) compact() bool {
+	following := make(map[*instruction]*instruction, len(o.ins))
+	var next *instruction
+	for i := len(o.ins) - 1; i >= 0; i-- {
+		in := o.ins[i]
+		if !in.removed {
+			next = in
+		}
+		following[in] = next
+	}
+
+	ins := make([]*instruction, 0, len(o.ins))
+	for _, in := range o.ins {
+		if in.removed {
+			continue
+		}
+		if in.target != nil {
+			if in.target = following[in.target]; in.target == nil {
+				return false
+			}
+		}
+		ins = append(ins, in)
+	}
+	o.ins = ins
+	return true
+}
+
+// peephole rewrites the sequences of instructions which can be simplified. An instruction can
+// only be rewritten together with the ones that precede it, if it is not the target of a jump.
+func (o *optimizer) peephole() bool {
+	changed := false
+	for i := 0; i < len(o.ins); i++ {
+		var window [3]*instruction
+		for j := 0; j < len(window) && i+j < len(o.ins); j++ {
+			if j > 0 && o.targets[o.ins[i+j]] {
+				break
+			}
+			window[j] = o.ins[i+j]
+		}
+		if n := o.rewrite(window[0], window[1], window[2]); n > 0 {
+			changed = true
+			i += n - 1
+		}
+	}
+	return changed
+}
+
+// rewrite rewrites the sequence of the instructions a, b and c, where b and c may be nil. It returns
+// the number of instructions which are rewritten.
+func (o *optimizer) rewrite(a, b, c *instruction) int {
+	if b != nil {
+		if n := foldConstants(a, b, c); n > 0 {
+			return n
+		}
+	}
+
+	switch a.op {
+	case il.Not:
+		if b != nil {
+			switch b.op {
+			case il.Not:
+				remove(a, b)
+				return 2
+			case il.Jz:
+				b.op = il.Jnz
+				remove(a)
+				return 2
+			case il.Jnz:
+				b.op = il.Jz
+				remove(a)
+				return 2
+			}
+		}
+
+	case il.AEqB:
+		switch a.args[0] {
+		case 1:
+			remove(a)
+			return 1
+		case 0:
+			set(a, il.Not)
+			return 1
+		}
+
+	case il.APushS, il.APushB, il.DupS, il.DupB, il.RPushS, il.RPushB:
+		if b != nil && (b.op == il.PopS || b.op == il.PopB) {
+			remove(a, b)
+			return 2
+		}
+
+	case il.APushI, il.APushD, il.DupI, il.DupD, il.RPushI, il.RPushD:
+		if b != nil && (b.op == il.PopI || b.op == il.PopD) {
+			remove(a, b)
+			return 2
+		}
+
+	case il.Jz, il.Jnz:
+		// a conditional jump over an unconditional one is inverted to jump to the target of the latter.
+		if b != nil && b.op == il.Jmp && o.next(b) == a.target {
+			if a.op == il.Jz {
+				a.op = il.Jnz
+			} else {
+				a.op = il.Jz
+			}
+			a.target = b.target
+			remove(b)
+			return 2
+		}
+		// a conditional jump that returns the opposite constants on both of its branches (e.g. "ab && true")
+		// returns the condition, or its negation.
+		if b != nil && c != nil && b.op == il.APushB && c.op == il.Ret && o.returnsBool(a.target, b.args[0] == 0) {
+			if (b.args[0] != 0) == (a.op == il.Jz) {
+				remove(a)
+			} else {
+				set(a, il.Not)
+			}
+			remove(b)
+			return 3
+		}
+		return o.rewriteJump(a)
+
+	case il.Jmp:
+		return o.rewriteJump(a)
+	}
+
+	return 0
+}
+
+// foldConstants evaluates the operations on constant operands.
+func foldConstants(a, b, c *instruction) int {
+	switch {
+	case a.op == il.APushB && b.op == il.Not:
+		setBool(a, a.args[0] == 0)
+		remove(b)
+		return 2
+
+	case a.op == il.APushB && (b.op == il.AEqB || b.op == il.AAnd || b.op == il.AOr || b.op == il.AXor):
+		setBool(a, evalBool(b.op, a.args[0], b.args[0]))
+		remove(b)
+		return 2
+
+	case a.op == il.APushB && (b.op == il.Jz || b.op == il.Jnz):
+		if (a.args[0] != 0) == (b.op == il.Jnz) {
+			set(a, il.Jmp)
+			a.target = b.target
+		} else {
+			remove(a)
+		}
+		remove(b)
+		return 2
+
+	case a.op == il.APushS && b.op == il.AEqS:
+		// strings are interned, so the equality of their ids is the equality of the strings.
+		setBool(a, a.args[0] == b.args[0])
+		remove(b)
+		return 2
+
+	case a.op == il.APushI && b.op == il.AEqI:
+		setBool(a, a.args[0] == b.args[0] && a.args[1] == b.args[1])
+		remove(b)
+		return 2
+
+	case a.op == il.APushD && b.op == il.AEqD:
+		setBool(a, il.ByteCodeToDouble(a.args[1], a.args[0]) == il.ByteCodeToDouble(b.args[1], b.args[0]))
+		remove(b)
+		return 2
+	}
+
+	if c == nil || a.op != b.op {
+		return 0
+	}
+
+	switch {
+	case a.op == il.APushB && (c.op == il.EqB || c.op == il.And || c.op == il.Or || c.op == il.Xor):
+		setBool(a, evalBool(c.op, a.args[0], b.args[0]))
+
+	case a.op == il.APushS && c.op == il.EqS:
+		setBool(a, a.args[0] == b.args[0])
+
+	case a.op == il.APushI && c.op == il.EqI:
+		setBool(a, a.args[0] == b.args[0] && a.args[1] == b.args[1])
+
+	case a.op == il.APushD && c.op == il.EqD:
+		setBool(a, il.ByteCodeToDouble(a.args[1], a.args[0]) == il.ByteCodeToDouble(b.args[1], b.args[0]))
+
+	default:
+		return 0
+	}
+	remove(b, c)
+	return 3
+}
+
+// evalBool evaluates the boolean operation on the operands, the same way as the interpreter.
+func evalBool(op il.Opcode, x, y uint32) bool {
+	switch op {
+	case il.EqB, il.AEqB:
+		return x == y
+	case il.And, il.AAnd:
+		return x != 0 && y != 0
+	case il.Or, il.AOr:
+		return x != 0 || y != 0
+	default: // il.Xor, il.AXor
+		return (x != 0) != (y != 0)
+	}
+}
+
+// rewriteJump threads the jump through the jumps it targets, and simplifies the jumps to returns
+// and to the next instruction.
+func (o *optimizer) rewriteJump(a *instruction) int {
+	changed := false
+	for i := 0; i < len(o.ins) && isThreadable(a, a.target); i++ {
+		a.target = a.target.target
+		changed = true
+	}
+
+	if a.op == il.Jmp && a.target.op == il.Ret && !a.target.removed {
+		set(a, il.Ret)
+		return 1
+	}
+
+	if o.next(a) == a.target {
+		if a.op == il.Jmp {
+			remove(a)
+		} else {
+			// the condition still needs to be popped.
+			set(a, il.PopB)
+		}
+		return 1
+	}
+
+	if changed {
+		return 1
+	}
+	return 0
+}
+
+// isThreadable returns whether the jump can skip the target, because the target is another jump.
+func isThreadable(jump, target *instruction) bool {
+	return target.op == il.Jmp && !target.removed && target != jump && target.target != target
+}
+
+// returnsBool returns whether the instruction pushes the boolean constant and returns it.
+func (o *optimizer) returnsBool(in *instruction, b bool) bool {
+	if in.op != il.APushB || in.removed || (in.args[0] != 0) != b {
+		return false
+	}
+	ret := o.next(in)
+	return ret != nil && ret.op == il.Ret
+}
+
+// next returns the instruction that follows the given one.
+func (o *optimizer) next(in *instruction) *instruction {
+	for i, x := range o.ins {
+		if x == in {
+			for _, y := range o.ins[i+1:] {
+				if !y.removed {
+					return y
+				}
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// removeUnreachable removes the instructions that can't be reached from the beginning of the body.
+func (o *optimizer) removeUnreachable() bool {
+	index := make(map[*instruction]int, len(o.ins))
+	for i, in := range o.ins {
+		index[in] = i
+	}
+
+	reached := make([]bool, len(o.ins))
+	var visit func(i int)
+	visit = func(i int) {
+		for ; i < len(o.ins) && !reached[i]; i++ {
+			in := o.ins[i]
+			reached[i] = true
+			if in.removed {
+				continue
+			}
+			if in.target != nil {
+				visit(index[in.target])
+			}
+			if isTerminal(in.op) {
+				return
+			}
+		}
+	}
+	visit(0)
+
+	changed := false
+	for i, in := range o.ins {
+		if !reached[i] && !in.removed {
+			in.removed = true
+			changed = true
+		}
+	}
+	return changed
+}
+
+func remove(ins ...*instruction) {
+	for _, in := range ins {
+		in.removed = true
+	}
+}
+
+func set(in *instruction, op il.Opcode) {
+	in.op = op
+	in.args = nil
+	in.target = nil
+}
+
+func setBool(in *instruction, b bool) {
+	in.op = il.APushB
+	in.args = []uint32{il.BoolToByteCode(b)}
+}
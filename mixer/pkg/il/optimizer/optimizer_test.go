@@ -0,0 +1,352 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"strings"
+	"testing"
+
+	"istio.io/istio/mixer/pkg/il"
+	"istio.io/istio/mixer/pkg/il/interpreter"
+	"istio.io/istio/mixer/pkg/il/runtime"
+	"istio.io/istio/mixer/pkg/il/testing"
+	"istio.io/istio/mixer/pkg/il/text"
+)
+
+var optimizeTests = []struct {
+	name     string
+	code     string
+	expected string
+}{
+	{
+		name: "fold not",
+		code: `
+fn eval() bool
+  apush_b true
+  not
+  ret
+end`,
+		expected: `
+fn eval() bool
+  apush_b false
+  ret
+end`,
+	},
+	{
+		name: "fold string equality",
+		code: `
+fn eval() bool
+  apush_s "a"
+  aeq_s "b"
+  ret
+end`,
+		expected: `
+fn eval() bool
+  apush_b false
+  ret
+end`,
+	},
+	{
+		name: "fold integer equality",
+		code: `
+fn eval() bool
+  apush_i 2
+  apush_i 2
+  eq_i
+  ret
+end`,
+		expected: `
+fn eval() bool
+  apush_b true
+  ret
+end`,
+	},
+	{
+		name: "fold logical operations",
+		code: `
+fn eval() bool
+  apush_b true
+  apush_b false
+  or
+  aand true
+  ret
+end`,
+		expected: `
+fn eval() bool
+  apush_b true
+  ret
+end`,
+	},
+	{
+		name: "land with constant left operand",
+		code: `
+fn eval() bool
+  apush_b true
+  jz L0
+  resolve_b "ab"
+  jmp L1
+L0:
+  apush_b false
+L1:
+  ret
+end`,
+		expected: `
+fn eval() bool
+  resolve_b "ab"
+  ret
+end`,
+	},
+	{
+		name: "lor with constant left operand",
+		code: `
+fn eval() bool
+  apush_b true
+  jz L0
+  apush_b true
+  ret
+L0:
+  resolve_b "ab"
+  ret
+end`,
+		expected: `
+fn eval() bool
+  apush_b true
+  ret
+end`,
+	},
+	{
+		name: "land with constant right operand",
+		code: `
+fn eval() bool
+  resolve_b "ab"
+  jz L0
+  apush_b true
+  jmp L1
+L0:
+  apush_b false
+L1:
+  ret
+end`,
+		expected: `
+fn eval() bool
+  resolve_b "ab"
+  ret
+end`,
+	},
+	{
+		name: "negated condition",
+		code: `
+fn eval() bool
+  resolve_b "ab"
+  not
+  jz L0
+  apush_b false
+  ret
+L0:
+  apush_b true
+  ret
+end`,
+		expected: `
+fn eval() bool
+  resolve_b "ab"
+  ret
+end`,
+	},
+	{
+		name: "compare with boolean constant",
+		code: `
+fn eval() bool
+  resolve_b "ab"
+  aeq_b false
+  ret
+end`,
+		expected: `
+fn eval() bool
+  resolve_b "ab"
+  not
+  ret
+end`,
+	},
+	{
+		name: "jump over jump",
+		code: `
+fn eval() string
+  tresolve_s "as"
+  jnz L0
+  jmp L1
+L0:
+  ret
+L1:
+  apush_s "a"
+  ret
+end`,
+		expected: `
+fn eval() string
+  tresolve_s "as"
+  jz L0
+  ret
+L0:
+  apush_s "a"
+  ret
+end`,
+	},
+	{
+		name: "push and pop",
+		code: `
+fn eval() integer
+  resolve_i "ai"
+  apush_i 2
+  pop_i
+  ret
+end`,
+		expected: `
+fn eval() integer
+  resolve_i "ai"
+  ret
+end`,
+	},
+	{
+		name: "common resolves",
+		code: `
+fn eval() bool
+  resolve_s "as"
+  aeq_s "a"
+  jz L0
+  apush_b true
+  ret
+L0:
+  resolve_s "as"
+  aeq_s "b"
+  ret
+end`,
+		expected: `
+fn eval() bool
+  resolve_s "as"
+  dup_s
+  rload_s r0
+  aeq_s "a"
+  jz L0
+  apush_b true
+  ret
+L0:
+  rpush_s r0
+  aeq_s "b"
+  ret
+end`,
+	},
+	{
+		name: "common resolves with used registers",
+		code: `
+fn eval() integer
+  apush_i 1
+  rload_i r0
+  resolve_i "ai"
+  resolve_i "ai"
+  add_i
+  ret
+end`,
+		expected: `
+fn eval() integer
+  apush_i 1
+  rload_i r0
+  resolve_i "ai"
+  dup_i
+  rload_i r2
+  rpush_i r2
+  add_i
+  ret
+end`,
+	},
+	{
+		name: "resolves on some of the paths",
+		code: `
+fn eval() bool
+  tresolve_b "ab"
+  jnz L0
+  resolve_b "bb"
+  ret
+L0:
+  resolve_b "bb"
+  ret
+end`,
+		expected: `
+fn eval() bool
+  tresolve_b "ab"
+  jnz L0
+  resolve_b "bb"
+  ret
+L0:
+  resolve_b "bb"
+  ret
+end`,
+	},
+}
+
+func TestOptimize(t *testing.T) {
+	for _, test := range optimizeTests {
+		t.Run(test.name, func(tt *testing.T) {
+			p, err := text.ReadText(test.code)
+			if err != nil {
+				tt.Fatalf("Unable to parse program text: %v", err)
+			}
+			if p, err = OptimizeProgram(p); err != nil {
+				tt.Fatalf("OptimizeProgram() => %v", err)
+			}
+			actual := text.WriteText(p)
+			if strings.TrimSpace(actual) != strings.TrimSpace(test.expected) {
+				tt.Fatalf("OptimizeProgram() =>\n%s\nwant:\n%s", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestOptimize_InvalidBody(t *testing.T) {
+	body := []uint32{uint32(il.Jmp), 42}
+	if actual := Optimize(body); len(actual) != 2 || actual[1] != 42 {
+		t.Fatalf("Optimize() => %v, want the body as is", actual)
+	}
+}
+
+// TestOptimize_TestData checks that the optimized versions of the programs in the test data are evaluated the
+// same way as the original ones.
+func TestOptimize_TestData(t *testing.T) {
+	for _, test := range ilt.TestData {
+		if test.IL == "" {
+			continue
+		}
+		t.Run(test.TestName(), func(tt *testing.T) {
+			p, err := text.ReadText(test.IL)
+			if err != nil {
+				tt.Fatalf("Unable to parse program text: %v", err)
+			}
+			if p, err = OptimizeProgram(p); err != nil {
+				tt.Fatalf("OptimizeProgram() => %v", err)
+			}
+
+			externs := make(map[string]interpreter.Extern)
+			for k, v := range runtime.Externs {
+				externs[k] = v
+			}
+			for k, v := range test.Externs {
+				externs[k] = interpreter.ExternFromFn(k, v)
+			}
+
+			r, err := interpreter.New(p, externs).Eval("eval", ilt.NewFakeBag(test.I))
+			if e := test.CheckEvaluationResult(r.AsInterface(), err); e != nil {
+				tt.Fatalf("%v\n%s", e, text.WriteText(p))
+			}
+		})
+	}
+}
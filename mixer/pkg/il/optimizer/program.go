@@ -0,0 +1,80 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizer
+
+import (
+	"fmt"
+	"sort"
+
+	"istio.io/istio/mixer/pkg/il"
+)
+
+// OptimizeProgram returns a copy of the program where the bodies of all the IL based functions are
+// optimized. The strings of the program keep their ids.
+func OptimizeProgram(p *il.Program) (*il.Program, error) {
+	r := il.NewProgram()
+	for id := 1; id < p.Strings().Size(); id++ {
+		r.Strings().Add(p.Strings().GetString(uint32(id)))
+	}
+
+	names := p.Functions.Names()
+	sort.Strings(names)
+	code := p.ByteCode()
+	for _, name := range names {
+		f := p.Functions.Get(name)
+		if f.Length == 0 {
+			r.AddExternDef(name, f.Parameters, f.ReturnType)
+			continue
+		}
+
+		body, err := localBody(code[f.Address : f.Address+f.Length])
+		if err != nil {
+			return nil, fmt.Errorf("unable to optimize function '%s': %v", name, err)
+		}
+		start := f.Address
+		for i := range body.addresses {
+			body.code[body.addresses[i]] -= start
+		}
+		if err = r.AddFunction(name, f.Parameters, f.ReturnType, Optimize(body.code)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// functionBody is a copy of the body of a function, along with the locations of its jump addresses.
+type functionBody struct {
+	code      []uint32
+	addresses []int
+}
+
+func localBody(code []uint32) (functionBody, error) {
+	b := functionBody{code: append([]uint32{}, code...)}
+	for i := 0; i < len(b.code); {
+		op := il.Opcode(b.code[i])
+		if op.Keyword() == "" || i+int(op.Size()) > len(b.code) {
+			return b, fmt.Errorf("invalid opcode at %d: %v", i, op)
+		}
+		j := i + 1
+		for _, a := range op.Args() {
+			if a == il.OpcodeArgAddress {
+				b.addresses = append(b.addresses, j)
+			}
+			j += int(a.Size())
+		}
+		i += int(op.Size())
+	}
+	return b, nil
+}
@@ -62,6 +62,9 @@ import (
 const (
 	// programCodeSize is the default size of a program.
 	defaultProgramCodeSize int = 256
+
+	// RegisterCount is the number of registers that are available to the functions of a program.
+	RegisterCount = 4
 )
 
 // Program is a self-contained IL based set of functions that can be executed by an interpreter.
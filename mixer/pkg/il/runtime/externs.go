@@ -17,12 +17,17 @@ package runtime
 import (
 	"fmt"
 	"net"
+	"net/mail"
+	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	config "istio.io/api/mixer/v1/config/descriptor"
 	"istio.io/istio/mixer/pkg/expr"
+	"istio.io/istio/mixer/pkg/il"
 	"istio.io/istio/mixer/pkg/il/interpreter"
 )
 
@@ -36,6 +41,20 @@ var Externs = map[string]interpreter.Extern{
 	"matches":         interpreter.ExternFromFn("matches", externMatches),
 	"startsWith":      interpreter.ExternFromFn("startsWith", externStartsWith),
 	"endsWith":        interpreter.ExternFromFn("endsWith", externEndsWith),
+	"cidr":            interpreter.ExternFromFn("cidr", externCIDR),
+	"in":              interpreter.ExternFromFn("in", externIn),
+	"toLower":         interpreter.ExternFromFn("toLower", externToLower),
+	"toUpper":         interpreter.ExternFromFn("toUpper", externToUpper),
+	"substring":       interpreter.ExternFromFn("substring", externSubstring),
+	"split":           interpreter.ExternFromFn("split", externSplit),
+	"email":           interpreter.ExternFromFn("email", externEmail),
+	"email_equal":     interpreter.ExternFromFn("email_equal", externEmailEqual),
+	"dnsName":         interpreter.ExternFromFn("dnsName", externDNSName),
+	"dnsName_equal":   interpreter.ExternFromFn("dnsName_equal", externDNSNameEqual),
+	"uri":             interpreter.ExternFromFn("uri", externURI),
+	"uri_equal":       interpreter.ExternFromFn("uri_equal", externURIEqual),
+	"keys":            interpreter.ExternFromFn("keys", externKeys),
+	"contains":        interpreter.ExternFromFn("contains", externContains),
 }
 
 // ExternFunctionMetadata is the type-metadata about externs. It gets used during compilations.
@@ -76,6 +95,75 @@ var ExternFunctionMetadata = []expr.FunctionMetadata{
 		ReturnType:    config.BOOL,
 		ArgumentTypes: []config.ValueType{config.STRING},
 	},
+	{
+		Name:          "cidr",
+		ReturnType:    config.STRING,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "in",
+		Instance:      true,
+		TargetType:    config.IP_ADDRESS,
+		ReturnType:    config.BOOL,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "toLower",
+		Instance:      true,
+		TargetType:    config.STRING,
+		ReturnType:    config.STRING,
+		ArgumentTypes: []config.ValueType{},
+	},
+	{
+		Name:          "toUpper",
+		Instance:      true,
+		TargetType:    config.STRING,
+		ReturnType:    config.STRING,
+		ArgumentTypes: []config.ValueType{},
+	},
+	{
+		Name:          "substring",
+		Instance:      true,
+		TargetType:    config.STRING,
+		ReturnType:    config.STRING,
+		ArgumentTypes: []config.ValueType{config.INT64, config.INT64},
+	},
+	{
+		Name:          "split",
+		Instance:      true,
+		TargetType:    config.STRING,
+		ReturnType:    config.STRING_MAP,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "email",
+		ReturnType:    config.EMAIL_ADDRESS,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "dnsName",
+		ReturnType:    config.DNS_NAME,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "uri",
+		ReturnType:    config.URI,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
+	{
+		Name:          "keys",
+		Instance:      true,
+		TargetType:    config.STRING_MAP,
+		ReturnType:    config.STRING_MAP,
+		ArgumentTypes: []config.ValueType{},
+	},
+	{
+		Name:          "contains",
+		Instance:      true,
+		TargetType:    config.STRING_MAP,
+		ReturnType:    config.BOOL,
+		ArgumentTypes: []config.ValueType{config.STRING},
+	},
 }
 
 func externIP(in string) ([]byte, error) {
@@ -126,3 +214,149 @@ func externStartsWith(str string, prefix string) bool {
 func externEndsWith(str string, suffix string) bool {
 	return strings.HasSuffix(str, suffix)
 }
+
+// externCIDR parses a CIDR block and returns it in its canonical form. IPv4 blocks can omit their trailing
+// zero octets, e.g. "10/8" is "10.0.0.0/8".
+func externCIDR(in string) (string, error) {
+	_, n, err := parseCIDR(in)
+	if err != nil {
+		return "", fmt.Errorf("could not convert %s to CIDR", in)
+	}
+	return n.String(), nil
+}
+
+func parseCIDR(in string) (net.IP, *net.IPNet, error) {
+	if i := strings.IndexByte(in, '/'); i > 0 && !strings.Contains(in, ":") {
+		if octets := strings.Count(in[:i], ".") + 1; octets < 4 {
+			in = in[:i] + strings.Repeat(".0", 4-octets) + in[i:]
+		}
+	}
+	return net.ParseCIDR(in)
+}
+
+// externIn returns whether the IP address is within the CIDR block, as in ip in cidr("10/8") or ip.in(cidr("10/8")).
+func externIn(ip []byte, cidr string) (bool, error) {
+	_, n, err := parseCIDR(cidr)
+	if err != nil {
+		return false, fmt.Errorf("could not convert %s to CIDR", cidr)
+	}
+	return n.Contains(net.IP(ip)), nil
+}
+
+func externToLower(str string) string {
+	return strings.ToLower(str)
+}
+
+func externToUpper(str string) string {
+	return strings.ToUpper(str)
+}
+
+// externSubstring returns the bytes of str from begin up to, but not including, end.
+func externSubstring(str string, begin int64, end int64) (string, error) {
+	if begin < 0 || end < begin || end > int64(len(str)) {
+		return "", fmt.Errorf("substring [%d:%d] is out of the bounds of '%s'", begin, end, str)
+	}
+	return str[begin:end], nil
+}
+
+// externSplit splits str around each instance of sep. There are no list types in the expression
+// language, so the parts are returned as a map from their position ("0", "1", ...) to the part.
+func externSplit(str string, sep string) map[string]string {
+	parts := strings.Split(str, sep)
+	m := make(map[string]string, len(parts))
+	for i, p := range parts {
+		m[strconv.Itoa(i)] = p
+	}
+	return m
+}
+
+// externEmail parses a bare email address, as in "joe@example.com".
+func externEmail(in string) (string, error) {
+	a, err := mail.ParseAddress(in)
+	if err != nil || a.Name != "" || a.Address != in {
+		return "", fmt.Errorf("could not convert %s to EMAIL_ADDRESS", in)
+	}
+	return a.Address, nil
+}
+
+// externEmailEqual compares email addresses. The domain of the addresses is case insensitive.
+func externEmailEqual(a string, b string) bool {
+	i, j := strings.LastIndexByte(a, '@'), strings.LastIndexByte(b, '@')
+	if i < 0 || j < 0 {
+		return a == b
+	}
+	return a[:i] == b[:j] && strings.EqualFold(a[i:], b[j:])
+}
+
+// externDNSName parses a DNS name, as in "www.example.com", following the rules of RFC 1123.
+func externDNSName(in string) (string, error) {
+	name := strings.TrimSuffix(in, ".")
+	if name == "" || len(name) > 253 {
+		return "", fmt.Errorf("could not convert %s to DNS_NAME", in)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !isDNSLabel(label) {
+			return "", fmt.Errorf("could not convert %s to DNS_NAME", in)
+		}
+	}
+	return in, nil
+}
+
+func isDNSLabel(label string) bool {
+	if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// externDNSNameEqual compares DNS names. DNS names are case insensitive, and the trailing dot of
+// fully qualified names is ignored.
+func externDNSNameEqual(a string, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// externURI parses an absolute URI, as in "http://example.com/path".
+func externURI(in string) (string, error) {
+	u, err := url.Parse(in)
+	if err != nil || u.Scheme == "" {
+		return "", fmt.Errorf("could not convert %s to URI", in)
+	}
+	return in, nil
+}
+
+// externURIEqual compares URIs. The scheme and the host of the URIs are case insensitive.
+func externURIEqual(a string, b string) (bool, error) {
+	u1, err := url.Parse(a)
+	if err != nil {
+		return false, err
+	}
+	u2, err := url.Parse(b)
+	if err != nil {
+		return false, err
+	}
+	u1.Scheme, u1.Host = strings.ToLower(u1.Scheme), strings.ToLower(u1.Host)
+	u2.Scheme, u2.Host = strings.ToLower(u2.Scheme), strings.ToLower(u2.Host)
+	return u1.String() == u2.String(), nil
+}
+
+// externKeys returns the sorted keys of the map, as a map from their position ("0", "1", ...) to the key.
+func externKeys(m interface{}) map[string]string {
+	keys := il.MapKeys(m)
+	sort.Strings(keys)
+	r := make(map[string]string, len(keys))
+	for i, k := range keys {
+		r[strconv.Itoa(i)] = k
+	}
+	return r
+}
+
+// externContains returns whether the map contains the key.
+func externContains(m interface{}, key string) bool {
+	_, found := il.MapGet(m, key)
+	return found
+}
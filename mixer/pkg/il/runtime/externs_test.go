@@ -17,6 +17,7 @@ package runtime
 import (
 	"bytes"
 	"net"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -169,3 +170,176 @@ func TestExternEndsWith(t *testing.T) {
 		}
 	}
 }
+
+func TestExternCIDR(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out string
+		err bool
+	}{
+		{"10.0.0.0/8", "10.0.0.0/8", false},
+		{"10/8", "10.0.0.0/8", false},
+		{"192.168.1/24", "192.168.1.0/24", false},
+		{"10.1.2.3/16", "10.1.0.0/16", false},
+		{"2001:db8::/32", "2001:db8::/32", false},
+		{"10.0.0.0", "", true},
+		{"10.0.0.0/33", "", true},
+		{"a/8", "", true},
+	}
+
+	for _, c := range cases {
+		out, err := externCIDR(c.in)
+		if (err != nil) != c.err || out != c.out {
+			t.Fatalf("cidr failure: %+v => %s, %v", c, out, err)
+		}
+	}
+}
+
+func TestExternIn(t *testing.T) {
+	var cases = []struct {
+		ip   string
+		cidr string
+		e    bool
+	}{
+		{"10.0.0.1", "10/8", true},
+		{"11.0.0.1", "10/8", false},
+		{"192.168.1.10", "192.168.1.0/24", true},
+		{"2001:db8::1", "2001:db8::/32", true},
+		{"2001:db9::1", "2001:db8::/32", false},
+	}
+
+	for _, c := range cases {
+		m, err := externIn(net.ParseIP(c.ip), c.cidr)
+		if err != nil || m != c.e {
+			t.Fatalf("in failure: %+v => %v, %v", c, m, err)
+		}
+	}
+
+	if _, err := externIn(net.ParseIP("10.0.0.1"), "10.0.0.1"); err == nil {
+		t.Fatalf("Expected error not found.")
+	}
+}
+
+func TestExternSubstring(t *testing.T) {
+	var cases = []struct {
+		s     string
+		begin int64
+		end   int64
+		e     string
+		err   bool
+	}{
+		{"abcd", 0, 4, "abcd", false},
+		{"abcd", 1, 3, "bc", false},
+		{"abcd", 2, 2, "", false},
+		{"abcd", -1, 2, "", true},
+		{"abcd", 3, 2, "", true},
+		{"abcd", 2, 5, "", true},
+	}
+
+	for _, c := range cases {
+		s, err := externSubstring(c.s, c.begin, c.end)
+		if (err != nil) != c.err || s != c.e {
+			t.Fatalf("substring failure: %+v => %s, %v", c, s, err)
+		}
+	}
+}
+
+func TestExternSplit(t *testing.T) {
+	m := externSplit("a,b,,c", ",")
+	e := map[string]string{"0": "a", "1": "b", "2": "", "3": "c"}
+	if !reflect.DeepEqual(m, e) {
+		t.Fatalf("Unexpected output: %v", m)
+	}
+}
+
+func TestExternEmail(t *testing.T) {
+	var cases = []struct {
+		in  string
+		err bool
+	}{
+		{"joe@example.com", false},
+		{"joe", true},
+		{"Joe <joe@example.com>", true},
+		{"joe@", true},
+	}
+
+	for _, c := range cases {
+		if _, err := externEmail(c.in); (err != nil) != c.err {
+			t.Fatalf("email failure: %+v => %v", c, err)
+		}
+	}
+
+	if !externEmailEqual("joe@example.com", "joe@EXAMPLE.COM") {
+		t.Fatalf("email domains are not case insensitive")
+	}
+	if externEmailEqual("joe@example.com", "Joe@example.com") {
+		t.Fatalf("email local parts are not case sensitive")
+	}
+}
+
+func TestExternDNSName(t *testing.T) {
+	var cases = []struct {
+		in  string
+		err bool
+	}{
+		{"example.com", false},
+		{"www.example.com.", false},
+		{"a-b.example.com", false},
+		{"localhost", false},
+		{"", true},
+		{".", true},
+		{"-a.example.com", true},
+		{"a..example.com", true},
+		{"a_b.example.com", true},
+	}
+
+	for _, c := range cases {
+		if _, err := externDNSName(c.in); (err != nil) != c.err {
+			t.Fatalf("dnsName failure: %+v => %v", c, err)
+		}
+	}
+
+	if !externDNSNameEqual("www.Example.com.", "www.example.com") {
+		t.Fatalf("DNS names are not case insensitive")
+	}
+}
+
+func TestExternURI(t *testing.T) {
+	var cases = []struct {
+		in  string
+		err bool
+	}{
+		{"http://example.com/path?q=1", false},
+		{"mailto:joe@example.com", false},
+		{"/path", true},
+		{"http://[::1", true},
+	}
+
+	for _, c := range cases {
+		if _, err := externURI(c.in); (err != nil) != c.err {
+			t.Fatalf("uri failure: %+v => %v", c, err)
+		}
+	}
+
+	if eq, err := externURIEqual("HTTP://Example.com/path", "http://example.com/path"); err != nil || !eq {
+		t.Fatalf("URI schemes and hosts are not case insensitive: %v, %v", eq, err)
+	}
+	if eq, err := externURIEqual("http://example.com/Path", "http://example.com/path"); err != nil || eq {
+		t.Fatalf("URI paths are not case sensitive: %v, %v", eq, err)
+	}
+}
+
+func TestExternKeys(t *testing.T) {
+	m := externKeys(map[string]string{"b": "1", "a": "2", "c": "3"})
+	e := map[string]string{"0": "a", "1": "b", "2": "c"}
+	if !reflect.DeepEqual(m, e) {
+		t.Fatalf("Unexpected output: %v", m)
+	}
+}
+
+func TestExternContains(t *testing.T) {
+	m := map[string]string{"a": ""}
+	if !externContains(m, "a") || externContains(m, "b") {
+		t.Fatalf("contains failure: %v", m)
+	}
+}
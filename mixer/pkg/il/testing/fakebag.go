@@ -112,3 +112,12 @@ func (s stringMap) Get(key string) (string, bool) {
 	}
 	return str, found
 }
+
+// Keys returns the keys of the stringmap
+func (s stringMap) Keys() []string {
+	keys := make([]string, 0, len(s.Entries))
+	for k := range s.Entries {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -2255,6 +2255,371 @@ end`,
 		},
 		R: false,
 	},
+
+	{
+		E:    `cidr("10/8")`,
+		Type: descriptor.STRING,
+		R:    "10.0.0.0/8",
+	},
+	{
+		E:    `cidr("10.1.2.3/16")`,
+		Type: descriptor.STRING,
+		R:    "10.1.0.0/16",
+	},
+	{
+		E:    `cidr("10.0.0.0/33")`,
+		Type: descriptor.STRING,
+		Err:  "could not convert 10.0.0.0/33 to CIDR",
+	},
+	{
+		E:    `ip("10.0.0.1").in(cidr("10/8"))`,
+		Type: descriptor.BOOL,
+		IL: `
+fn eval() bool
+  apush_s "10.0.0.1"
+  call ip
+  apush_s "10/8"
+  call cidr
+  call in
+  ret
+end`,
+		R: true,
+	},
+	{
+		E:    `aip.in("10.0.0.0/8")`,
+		Type: descriptor.BOOL,
+		IL: `
+fn eval() bool
+  resolve_f "aip"
+  apush_s "10.0.0.0/8"
+  call in
+  ret
+end`,
+		I: map[string]interface{}{
+			"aip": []byte(net.ParseIP("192.168.0.1")),
+		},
+		R: false,
+	},
+	{
+		E:    `ip("10.0.0.1") in cidr("10/8")`,
+		Type: descriptor.BOOL,
+		IL: `
+fn eval() bool
+  apush_s "10.0.0.1"
+  call ip
+  apush_s "10/8"
+  call cidr
+  call in
+  ret
+end`,
+		R: true,
+	},
+	{
+		E:    `aip in cidr("10/8") || ab`,
+		Type: descriptor.BOOL,
+		I: map[string]interface{}{
+			"aip": []byte(net.ParseIP("192.168.0.1")),
+			"ab":  true,
+		},
+		R: true,
+	},
+	{
+		E:    `ip("2001:db8::1").in(cidr("2001:db8::/32"))`,
+		Type: descriptor.BOOL,
+		R:    true,
+	},
+	{
+		E:    `aip.in(as)`,
+		Type: descriptor.BOOL,
+		I: map[string]interface{}{
+			"aip": []byte(net.ParseIP("10.0.0.1")),
+			"as":  "10.0.0.0",
+		},
+		Err: "could not convert 10.0.0.0 to CIDR",
+	},
+	{
+		E:          `"10.0.0.1".in("10/8")`,
+		CompileErr: `"10.0.0.1":in("10/8") target typeError got STRING, expected IP_ADDRESS`,
+	},
+
+	{
+		E:    `as.toLower()`,
+		Type: descriptor.STRING,
+		IL: `
+fn eval() string
+  resolve_s "as"
+  call toLower
+  ret
+end`,
+		I: map[string]interface{}{
+			"as": "AbC",
+		},
+		R: "abc",
+	},
+	{
+		E:    `as.toUpper()`,
+		Type: descriptor.STRING,
+		IL: `
+fn eval() string
+  resolve_s "as"
+  call toUpper
+  ret
+end`,
+		I: map[string]interface{}{
+			"as": "AbC",
+		},
+		R: "ABC",
+	},
+	{
+		E:          `toLower("AbC")`,
+		CompileErr: `invoking instance method without an instance: toLower`,
+	},
+
+	{
+		E:    `as.substring(1, 3)`,
+		Type: descriptor.STRING,
+		IL: `
+fn eval() string
+  resolve_s "as"
+  apush_i 1
+  apush_i 3
+  call substring
+  ret
+end`,
+		I: map[string]interface{}{
+			"as": "abcd",
+		},
+		R: "bc",
+	},
+	{
+		E:    `as.substring(2, 10)`,
+		Type: descriptor.STRING,
+		I: map[string]interface{}{
+			"as": "abcd",
+		},
+		Err: "substring [2:10] is out of the bounds of 'abcd'",
+	},
+	{
+		E:          `as.substring("1", 3)`,
+		CompileErr: `$as:substring("1", 3) arg 1 ("1") typeError got STRING, expected INT64`,
+	},
+
+	{
+		E:    `as.split(",")["1"]`,
+		Type: descriptor.STRING,
+		IL: `
+fn eval() string
+  resolve_s "as"
+  apush_s ","
+  call split
+  anlookup "1"
+  ret
+end`,
+		I: map[string]interface{}{
+			"as": "a,b,c",
+		},
+		R: "b",
+	},
+	{
+		E:    `as.split(",")["3"] | "none"`,
+		Type: descriptor.STRING,
+		I: map[string]interface{}{
+			"as": "a,b,c",
+		},
+		R: "none",
+	},
+	{
+		E:    `as.split(",")`,
+		Type: descriptor.STRING_MAP,
+		I: map[string]interface{}{
+			"as": "a,b",
+		},
+		R: map[string]string{"0": "a", "1": "b"},
+	},
+
+	{
+		E:    `email("joe@example.com")`,
+		Type: descriptor.EMAIL_ADDRESS,
+		R:    "joe@example.com",
+	},
+	{
+		E:    `email("Joe <joe@example.com>")`,
+		Type: descriptor.EMAIL_ADDRESS,
+		Err:  "could not convert Joe <joe@example.com> to EMAIL_ADDRESS",
+	},
+	{
+		E:    `email(as) == email("joe@example.com")`,
+		Type: descriptor.BOOL,
+		IL: `
+fn eval() bool
+  resolve_s "as"
+  call email
+  apush_s "joe@example.com"
+  call email
+  call email_equal
+  ret
+end`,
+		I: map[string]interface{}{
+			"as": "joe@EXAMPLE.com",
+		},
+		R: true,
+	},
+	{
+		E:    `email("Joe@example.com") == email("joe@example.com")`,
+		Type: descriptor.BOOL,
+		R:    false,
+	},
+	{
+		E:    `dnsName("www.example.com")`,
+		Type: descriptor.DNS_NAME,
+		R:    "www.example.com",
+	},
+	{
+		E:    `dnsName("-www.example.com")`,
+		Type: descriptor.DNS_NAME,
+		Err:  "could not convert -www.example.com to DNS_NAME",
+	},
+	{
+		E:    `dnsName("www.Example.com.") == dnsName("www.example.com")`,
+		Type: descriptor.BOOL,
+		R:    true,
+	},
+	{
+		E:    `dnsName("www.example.com") != dnsName("example.com")`,
+		Type: descriptor.BOOL,
+		R:    true,
+	},
+	{
+		E:    `uri("http://example.com/path")`,
+		Type: descriptor.URI,
+		R:    "http://example.com/path",
+	},
+	{
+		E:    `uri("/path")`,
+		Type: descriptor.URI,
+		Err:  "could not convert /path to URI",
+	},
+	{
+		E:    `uri("HTTP://Example.com/path") == uri("http://example.com/path")`,
+		Type: descriptor.BOOL,
+		R:    true,
+	},
+	{
+		E:    `uri("http://example.com/path") == uri("http://example.com/Path")`,
+		Type: descriptor.BOOL,
+		R:    false,
+	},
+	{
+		E:          `email("joe@example.com") == "joe@example.com"`,
+		CompileErr: `EQ(email("joe@example.com"), "joe@example.com") arg 2 ("joe@example.com") typeError got STRING, expected EMAIL_ADDRESS`,
+	},
+
+	{
+		E:    `ar.keys()`,
+		Type: descriptor.STRING_MAP,
+		IL: `
+fn eval() interface
+  resolve_f "ar"
+  call keys
+  ret
+end`,
+		I: map[string]interface{}{
+			"ar": map[string]string{"b": "2", "a": "1"},
+		},
+		R: map[string]string{"0": "a", "1": "b"},
+	},
+	{
+		E:    `ar.keys()["1"]`,
+		Type: descriptor.STRING,
+		I: map[string]interface{}{
+			"ar": map[string]string{"b": "2", "a": "1"},
+		},
+		R: "b",
+	},
+	{
+		E:    `ar.contains("foo")`,
+		Type: descriptor.BOOL,
+		IL: `
+fn eval() bool
+  resolve_f "ar"
+  apush_s "foo"
+  call contains
+  ret
+end`,
+		I: map[string]interface{}{
+			"ar": map[string]string{"foo": "bar"},
+		},
+		R:          true,
+		Referenced: []string{"ar", "ar[foo]"},
+	},
+	{
+		E:    `ar.contains("baz")`,
+		Type: descriptor.BOOL,
+		I: map[string]interface{}{
+			"ar": map[string]string{"foo": "bar"},
+		},
+		R: false,
+	},
+	{
+		E:          `as.contains("a")`,
+		CompileErr: `$as:contains("a") target typeError got STRING, expected STRING_MAP`,
+	},
+
+	{
+		E:    `conditional(ab, "yes", "no")`,
+		Type: descriptor.STRING,
+		IL: `
+fn eval() string
+  resolve_b "ab"
+  jz L0
+  apush_s "yes"
+  jmp L1
+L0:
+  apush_s "no"
+L1:
+  ret
+end`,
+		I: map[string]interface{}{
+			"ab": true,
+		},
+		R: "yes",
+	},
+	{
+		E:    `conditional(ab, ai, bi)`,
+		Type: descriptor.INT64,
+		I: map[string]interface{}{
+			"ab": false,
+			"ai": int64(1),
+			"bi": int64(2),
+		},
+		R:          int64(2),
+		Referenced: []string{"ab", "bi"},
+	},
+	{
+		E:    `conditional(ab, ar["foo"], "b") | "c"`,
+		Type: descriptor.STRING,
+		I: map[string]interface{}{
+			"ab": true,
+			"ar": map[string]string{},
+		},
+		R: "c",
+	},
+	{
+		E:    `conditional(ab, ar["foo"], "b") | "c"`,
+		Type: descriptor.STRING,
+		I: map[string]interface{}{
+			"ab": false,
+		},
+		R: "b",
+	},
+	{
+		E:          `conditional(ai, "a", "b")`,
+		CompileErr: `conditional($ai, "a", "b") arg 1 ($ai) typeError got INT64, expected BOOL`,
+	},
+	{
+		E:          `conditional(ab, "a", 2)`,
+		CompileErr: `conditional($ab, "a", 2) arg 3 (2) typeError got INT64, expected STRING`,
+	},
 }
 
 // TestInfo is a structure that contains detailed test information. Depending
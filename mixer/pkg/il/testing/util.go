@@ -16,9 +16,11 @@ package ilt
 
 import (
 	"bytes"
+	"reflect"
 )
 
-// AreEqual checks for equality of given values. It handles comparison of []byte as a special case.
+// AreEqual checks for equality of given values. It handles comparison of []byte and map[string]string
+// as special cases.
 func AreEqual(e interface{}, a interface{}) bool {
 	if eb, ok := e.([]byte); ok {
 		if ab, ok := a.([]byte); ok {
@@ -28,5 +30,9 @@ func AreEqual(e interface{}, a interface{}) bool {
 		return false
 	}
 
+	if em, ok := e.(map[string]string); ok {
+		return reflect.DeepEqual(em, a)
+	}
+
 	return a == e
 }
@@ -80,6 +80,9 @@ func GetType(name string) (Type, bool) {
 // This is used for reference counting.
 type StringMap interface {
 	Get(key string) (value string, found bool)
+
+	// Keys returns the keys of the map, in no particular order.
+	Keys() []string
 }
 
 // MapGet abstracts over map[string]string and refcounted stringMap
@@ -96,3 +99,19 @@ func MapGet(tVal interface{}, tStr string) (ret string, found bool) {
 		panic(fmt.Sprintf("Unknown map type %T", v))
 	}
 }
+
+// MapKeys abstracts over map[string]string and refcounted stringMap, returning the keys of the map.
+func MapKeys(tVal interface{}) []string {
+	switch v := tVal.(type) {
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		return keys
+	case StringMap:
+		return v.Keys()
+	default:
+		panic(fmt.Sprintf("Unknown map type %T", v))
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recording
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"istio.io/istio/pkg/log"
+)
+
+const (
+	// queueSize bounds the number of sampled requests waiting to be written. The requests sampled
+	// while the queue is full are dropped.
+	queueSize = 1024
+)
+
+var recordCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "mixer",
+	Subsystem: "recording",
+	Name:      "request_count",
+	Help:      "Total number of requests recorded.",
+}, []string{"kind"})
+
+var dropCounter = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: "mixer",
+	Subsystem: "recording",
+	Name:      "dropped_count",
+	Help:      "Total number of sampled requests dropped because the recording fell behind.",
+})
+
+func init() {
+	prometheus.MustRegister(recordCounter, dropCounter)
+}
+
+// Options configures a recording.
+type Options struct {
+	// SampleRate is the fraction of the requests which are recorded, in (0, 1].
+	SampleRate float64
+
+	// MaxSize is the number of bytes of requests, before compression, after which the recording
+	// stops. The size of the recording is not bounded if 0.
+	MaxSize int64
+
+	// MaxDuration is the duration after which the recording stops. The duration of the recording
+	// is not bounded if 0.
+	MaxDuration time.Duration
+}
+
+type entry struct {
+	t    time.Time
+	kind Kind
+	b    []byte
+}
+
+// Recorder records a sample of the requests received by Mixer. It is safe for concurrent use.
+//
+// The sampled requests are encoded on the calling goroutine, and queued to a goroutine which
+// compresses them and writes them out, so that the requests are not held up by the recording.
+type Recorder struct {
+	opts Options
+
+	mu   sync.Mutex
+	rand *rand.Rand
+	// stopped is set once the recording reached its bounds or was closed, and queue is closed.
+	stopped bool
+	queue   chan entry
+	timer   *time.Timer
+
+	// done is closed once the writing goroutine completed the recording, err is then its result.
+	done chan struct{}
+	err  error
+}
+
+// New returns a recorder which records a sample of the requests to w, and closes w when the
+// recording completes.
+func New(w io.WriteCloser, opts Options, globalWords []string) (*Recorder, error) {
+	if opts.SampleRate <= 0 || opts.SampleRate > 1 {
+		return nil, fmt.Errorf("sample rate must be in (0, 1], got %v", opts.SampleRate)
+	}
+	if opts.MaxSize < 0 || opts.MaxDuration < 0 {
+		return nil, fmt.Errorf("max size and duration must be >= 0, got %d and %v", opts.MaxSize, opts.MaxDuration)
+	}
+
+	wr, err := NewWriter(w, globalWords)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{
+		opts:  opts,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		queue: make(chan entry, queueSize),
+		done:  make(chan struct{}),
+	}
+	if opts.MaxDuration > 0 {
+		// the timer may fire before it is assigned.
+		r.mu.Lock()
+		r.timer = time.AfterFunc(opts.MaxDuration, func() {
+			log.Infof("The recording reached its maximum duration of %v", opts.MaxDuration)
+			r.stop()
+		})
+		r.mu.Unlock()
+	}
+	go r.write(wr, w)
+	return r, nil
+}
+
+// NewFile returns a recorder which records a sample of the requests to a new file.
+func NewFile(path string, opts Options, globalWords []string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the recording: %v", err)
+	}
+
+	r, err := New(f, opts, globalWords)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record records the CheckRequest or ReportRequest, if it is sampled. It must be called before
+// the request is modified.
+func (r *Recorder) Record(req proto.Message) {
+	now := time.Now()
+
+	r.mu.Lock()
+	sampled := !r.stopped && (r.opts.SampleRate >= 1 || r.rand.Float64() < r.opts.SampleRate)
+	r.mu.Unlock()
+	if !sampled {
+		return
+	}
+
+	kind := kindOf(req)
+	if kind == 0 {
+		log.Errorf("Unable to record requests of type %T", req)
+		return
+	}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		log.Errorf("Unable to record request: %v", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return
+	}
+	select {
+	case r.queue <- entry{t: now, kind: kind, b: b}:
+	default:
+		dropCounter.Inc()
+	}
+}
+
+// Close completes the recording, once the queued requests are written.
+func (r *Recorder) Close() error {
+	r.stop()
+	<-r.done
+	return r.err
+}
+
+// stop stops queueing requests to the recording.
+func (r *Recorder) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return
+	}
+	r.stopped = true
+	close(r.queue)
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+}
+
+// write writes the queued requests until the queue is closed, and completes the recording.
+func (r *Recorder) write(wr *Writer, closer io.Closer) {
+	var err error
+	for e := range r.queue {
+		// keep draining the queue once the recording failed or is full, until it is closed.
+		if err != nil || (r.opts.MaxSize > 0 && wr.size >= r.opts.MaxSize) {
+			continue
+		}
+		if err = wr.writeEntry(e.t, e.kind, e.b); err != nil {
+			log.Errorf("Unable to record request, the recording is stopped: %v", err)
+			r.stop()
+			continue
+		}
+		recordCounter.WithLabelValues(e.kind.String()).Inc()
+		if r.opts.MaxSize > 0 && wr.size >= r.opts.MaxSize {
+			log.Infof("The recording reached its maximum size of %d bytes", r.opts.MaxSize)
+			r.stop()
+		}
+	}
+
+	if cerr := wr.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := closer.Close(); err == nil {
+		err = cerr
+	}
+	r.err = err
+	close(r.done)
+}
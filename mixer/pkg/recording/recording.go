@@ -0,0 +1,310 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recording records the Check and Report requests received by Mixer into a file, and reads
+// them back so they can be replayed.
+//
+// A recording is a gzip compressed stream. It starts with a header that holds the start time of the
+// recording and the global word list of the Mixer that recorded it, which the compressed attributes
+// of the requests refer to. The header is followed by the requests, each with its offset from the
+// start of the recording, and its protobuf encoding.
+package recording
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/pkg/attribute"
+)
+
+const (
+	magic   = "mixr"
+	version = 1
+
+	// maxEntrySize bounds the size of the entries which are read back, to detect corrupt recordings.
+	maxEntrySize = 64 * 1024 * 1024
+)
+
+// Kind is the kind of a recorded request.
+type Kind byte
+
+const (
+	// Check is the kind of the recorded CheckRequests.
+	Check Kind = 1
+
+	// Report is the kind of the recorded ReportRequests.
+	Report Kind = 2
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Check:
+		return "check"
+	case Report:
+		return "report"
+	default:
+		return fmt.Sprintf("kind(%d)", byte(k))
+	}
+}
+
+// Entry is a recorded request.
+type Entry struct {
+	// Offset is the time at which the request was received, relative to the start of the recording.
+	Offset time.Duration
+
+	// Kind of the request. Either Check or Report is set accordingly.
+	Kind   Kind
+	Check  *mixerpb.CheckRequest
+	Report *mixerpb.ReportRequest
+}
+
+func kindOf(req proto.Message) Kind {
+	switch req.(type) {
+	case *mixerpb.CheckRequest:
+		return Check
+	case *mixerpb.ReportRequest:
+		return Report
+	default:
+		return 0
+	}
+}
+
+// Writer writes the entries of a recording. It is not safe for concurrent use.
+type Writer struct {
+	gz    *gzip.Writer
+	w     *bufio.Writer
+	start time.Time
+	buf   [binary.MaxVarintLen64]byte
+
+	// size is the number of bytes of the entries written so far, before compression.
+	size int64
+}
+
+// NewWriter writes the header of a recording that starts now to w, and returns a writer for its entries.
+func NewWriter(w io.Writer, globalWords []string) (*Writer, error) {
+	gz := gzip.NewWriter(w)
+	wr := &Writer{
+		gz:    gz,
+		w:     bufio.NewWriter(gz),
+		start: time.Now(),
+	}
+
+	if _, err := wr.w.WriteString(magic); err != nil {
+		return nil, err
+	}
+	wr.writeUvarint(version)
+	wr.writeUvarint(uint64(wr.start.UnixNano()))
+	wr.writeUvarint(uint64(len(globalWords)))
+	for _, word := range globalWords {
+		wr.writeUvarint(uint64(len(word)))
+		_, _ = wr.w.WriteString(word)
+	}
+
+	// bufio.Writer keeps the first error, it is reported on flush.
+	return wr, wr.w.Flush()
+}
+
+// Write writes a request received at the given time.
+func (w *Writer) Write(t time.Time, req proto.Message) error {
+	kind := kindOf(req)
+	if kind == 0 {
+		return fmt.Errorf("unable to record requests of type %T", req)
+	}
+
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return w.writeEntry(t, kind, b)
+}
+
+// writeEntry writes the encoded request of the given kind received at the given time.
+func (w *Writer) writeEntry(t time.Time, kind Kind, b []byte) error {
+	offset := t.Sub(w.start)
+	if offset < 0 {
+		offset = 0
+	}
+	_ = w.w.WriteByte(byte(kind))
+	n := 1 + w.writeUvarint(uint64(offset)) + w.writeUvarint(uint64(len(b)))
+	m, err := w.w.Write(b)
+	w.size += int64(n + m)
+	return err
+}
+
+// Close flushes the recording. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+func (w *Writer) writeUvarint(v uint64) int {
+	n := binary.PutUvarint(w.buf[:], v)
+	_, _ = w.w.Write(w.buf[:n])
+	return n
+}
+
+// Reader reads the entries of a recording.
+type Reader struct {
+	r *bufio.Reader
+
+	// Start is the time at which the recording started.
+	Start time.Time
+
+	// GlobalWords is the global word list the requests of the recording refer to.
+	GlobalWords []string
+}
+
+// NewReader reads the header of the recording from r, and returns a reader for its entries.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a recording: %v", err)
+	}
+	rd := &Reader{r: bufio.NewReader(gz)}
+
+	m := make([]byte, len(magic))
+	if _, err = io.ReadFull(rd.r, m); err != nil || string(m) != magic {
+		return nil, errors.New("not a recording: invalid header")
+	}
+
+	v, err := rd.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if v != version {
+		return nil, fmt.Errorf("unsupported recording version %d", v)
+	}
+
+	start, err := rd.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	rd.Start = time.Unix(0, int64(start))
+
+	count, err := rd.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < count; i++ {
+		word, err := rd.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		rd.GlobalWords = append(rd.GlobalWords, string(word))
+	}
+	return rd, nil
+}
+
+// Next returns the next entry of the recording, or io.EOF at its end.
+func (r *Reader) Next() (*Entry, error) {
+	k, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	offset, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	b, err := r.readBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Entry{Offset: time.Duration(offset), Kind: Kind(k)}
+	switch e.Kind {
+	case Check:
+		e.Check = &mixerpb.CheckRequest{}
+		err = proto.Unmarshal(b, e.Check)
+	case Report:
+		e.Report = &mixerpb.ReportRequest{}
+		err = proto.Unmarshal(b, e.Report)
+	default:
+		err = fmt.Errorf("unknown entry %v", e.Kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("corrupt recording: %v", err)
+	}
+	return e, nil
+}
+
+func (r *Reader) readUvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return 0, fmt.Errorf("corrupt recording: %v", err)
+	}
+	return v, nil
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxEntrySize {
+		return nil, fmt.Errorf("corrupt recording: entry of %d bytes", n)
+	}
+	b := make([]byte, n)
+	if _, err = io.ReadFull(r.r, b); err != nil {
+		return nil, fmt.Errorf("corrupt recording: %v", err)
+	}
+	return b, nil
+}
+
+// Localize rewrites the attributes of the request of the entry so that they only refer to the words
+// of their messages, rather than to the global word list of the recording. The request can then be
+// sent to a Mixer whose global word list is different.
+func (r *Reader) Localize(e *Entry) error {
+	switch e.Kind {
+	case Check:
+		e.Check.GlobalWordCount = 0
+		return localize(&e.Check.Attributes, r.GlobalWords)
+	case Report:
+		e.Report.GlobalWordCount = 0
+		for i := range e.Report.Attributes {
+			if len(e.Report.Attributes[i].Words) == 0 {
+				e.Report.Attributes[i].Words = e.Report.DefaultWords
+			}
+			if err := localize(&e.Report.Attributes[i], r.GlobalWords); err != nil {
+				return err
+			}
+		}
+		e.Report.DefaultWords = nil
+	}
+	return nil
+}
+
+func localize(attrs *mixerpb.CompressedAttributes, globalWords []string) error {
+	b, err := attribute.GetBagFromProto(attrs, globalWords)
+	if err != nil {
+		return err
+	}
+	var out mixerpb.CompressedAttributes
+	b.ToProto(&out, nil, 0)
+	b.Done()
+	*attrs = out
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recording
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/pkg/attribute"
+)
+
+var globalWords = []string{"source.name", "destination.service", "request.size"}
+
+// attrs encodes the attributes with the global word list.
+func attrs(values map[string]interface{}) mixerpb.CompressedAttributes {
+	dict := make(map[string]int32, len(globalWords))
+	for i, w := range globalWords {
+		dict[w] = int32(i)
+	}
+	b := attribute.GetFakeMutableBagForTesting(values)
+	var out mixerpb.CompressedAttributes
+	b.ToProto(&out, dict, len(globalWords))
+	return out
+}
+
+func values(t *testing.T, a *mixerpb.CompressedAttributes, words []string) map[string]interface{} {
+	b, err := attribute.GetBagFromProto(a, words)
+	if err != nil {
+		t.Fatalf("Unable to decode attributes: %v", err)
+	}
+	res := make(map[string]interface{})
+	for _, n := range b.Names() {
+		res[n], _ = b.Get(n)
+	}
+	return res
+}
+
+func TestRecording(t *testing.T) {
+	check := map[string]interface{}{"source.name": "a", "destination.service": "svc.ns", "custom": "x"}
+	report := map[string]interface{}{"destination.service": "svc.ns", "request.size": int64(42)}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, globalWords)
+	if err != nil {
+		t.Fatalf("NewWriter() => %v", err)
+	}
+	now := time.Now()
+	if err = w.Write(now, &mixerpb.CheckRequest{
+		Attributes:      attrs(check),
+		GlobalWordCount: uint32(len(globalWords)),
+		DeduplicationId: "42",
+	}); err != nil {
+		t.Fatalf("Write() => %v", err)
+	}
+	if err = w.Write(now.Add(time.Second), &mixerpb.ReportRequest{
+		Attributes:      []mixerpb.CompressedAttributes{attrs(report)},
+		GlobalWordCount: uint32(len(globalWords)),
+	}); err != nil {
+		t.Fatalf("Write() => %v", err)
+	}
+	if err = w.Write(now, &mixerpb.CompressedAttributes{}); err == nil {
+		t.Fatalf("Write() => success, want an error for an unknown request")
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() => %v", err)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader() => %v", err)
+	}
+	if !reflect.DeepEqual(r.GlobalWords, globalWords) {
+		t.Fatalf("got global words %v, want %v", r.GlobalWords, globalWords)
+	}
+	if d := now.Sub(r.Start); d < 0 || d > time.Minute {
+		t.Fatalf("got start %v, want before %v", r.Start, now)
+	}
+
+	e, err := r.Next()
+	if err != nil {
+		t.Fatalf("Next() => %v", err)
+	}
+	if e.Kind != Check || e.Check == nil || e.Check.DeduplicationId != "42" {
+		t.Fatalf("got %+v, want the check request", e)
+	}
+	if err = r.Localize(e); err != nil {
+		t.Fatalf("Localize() => %v", err)
+	}
+	if got := values(t, &e.Check.Attributes, nil); !reflect.DeepEqual(got, check) || e.Check.GlobalWordCount != 0 {
+		t.Fatalf("got attributes %v, want %v without global words", got, check)
+	}
+
+	e2, err := r.Next()
+	if err != nil {
+		t.Fatalf("Next() => %v", err)
+	}
+	if e2.Kind != Report || e2.Report == nil || e2.Offset-e.Offset != time.Second {
+		t.Fatalf("got %+v, want the report request a second after the check", e2)
+	}
+	if err = r.Localize(e2); err != nil {
+		t.Fatalf("Localize() => %v", err)
+	}
+	if got := values(t, &e2.Report.Attributes[0], nil); !reflect.DeepEqual(got, report) {
+		t.Fatalf("got attributes %v, want %v", got, report)
+	}
+
+	if _, err = r.Next(); err != io.EOF {
+		t.Fatalf("Next() => %v, want EOF", err)
+	}
+}
+
+func TestRecording_Invalid(t *testing.T) {
+	if _, err := NewReader(strings.NewReader("not a recording")); err == nil {
+		t.Fatalf("NewReader() => success, want an error")
+	}
+
+	// an entry which is shorter than its declared length.
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, globalWords)
+	_ = w.w.WriteByte(byte(Check))
+	w.writeUvarint(0)
+	w.writeUvarint(10)
+	_, _ = w.w.WriteString("short")
+	_ = w.Close()
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader() => %v", err)
+	}
+	if _, err = r.Next(); err == nil || !strings.Contains(err.Error(), "corrupt") {
+		t.Fatalf("Next() => %v, want a corrupt recording", err)
+	}
+}
+
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closeBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	if _, err := New(&closeBuffer{}, Options{}, globalWords); err == nil {
+		t.Fatalf("New() => success, want an error for an invalid sample rate")
+	}
+	if _, err := New(&closeBuffer{}, Options{SampleRate: 1, MaxSize: -1}, globalWords); err == nil {
+		t.Fatalf("New() => success, want an error for an invalid max size")
+	}
+
+	for _, s := range []struct {
+		rate    float64
+		entries int
+	}{
+		{rate: 1, entries: 100},
+		{rate: 0.5},
+	} {
+		buf := &closeBuffer{}
+		rec, err := New(buf, Options{SampleRate: s.rate}, globalWords)
+		if err != nil {
+			t.Fatalf("New() => %v", err)
+		}
+		for i := 0; i < 100; i++ {
+			rec.Record(&mixerpb.CheckRequest{})
+		}
+		if err = rec.Close(); err != nil || !buf.closed {
+			t.Fatalf("Close() => %v, want the writer to be closed", err)
+		}
+		// the requests are no longer recorded once the recorder is closed.
+		rec.Record(&mixerpb.CheckRequest{})
+
+		r, err := NewReader(buf)
+		if err != nil {
+			t.Fatalf("NewReader() => %v", err)
+		}
+		n := 0
+		for ; ; n++ {
+			if _, err = r.Next(); err != nil {
+				break
+			}
+		}
+		if err != io.EOF {
+			t.Fatalf("Next() => %v", err)
+		}
+		if s.entries != 0 && n != s.entries {
+			t.Fatalf("got %d entries at rate %v, want %d", n, s.rate, s.entries)
+		}
+		if s.entries == 0 && (n == 0 || n == 100) {
+			t.Fatalf("got %d entries at rate %v, want a sample", n, s.rate)
+		}
+	}
+}
+
+func readEntries(t *testing.T, buf io.Reader) int {
+	t.Helper()
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatalf("NewReader() => %v", err)
+	}
+	n := 0
+	for ; ; n++ {
+		if _, err = r.Next(); err != nil {
+			break
+		}
+	}
+	if err != io.EOF {
+		t.Fatalf("Next() => %v", err)
+	}
+	return n
+}
+
+func TestRecorder_MaxSize(t *testing.T) {
+	req := &mixerpb.CheckRequest{DeduplicationId: "0123456789"}
+	// an entry holds at least its kind, its offset, its length and the request.
+	size := 3 + req.Size()
+
+	buf := &closeBuffer{}
+	rec, err := New(buf, Options{SampleRate: 1, MaxSize: int64(10 * size)}, globalWords)
+	if err != nil {
+		t.Fatalf("New() => %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		rec.Record(req)
+	}
+	if err = rec.Close(); err != nil {
+		t.Fatalf("Close() => %v", err)
+	}
+	if n := readEntries(t, buf); n == 0 || n > 10 {
+		t.Fatalf("got %d entries, want at most 10", n)
+	}
+}
+
+func TestRecorder_MaxDuration(t *testing.T) {
+	buf := &closeBuffer{}
+	rec, err := New(buf, Options{SampleRate: 1, MaxDuration: time.Millisecond}, globalWords)
+	if err != nil {
+		t.Fatalf("New() => %v", err)
+	}
+	// the recording completes on its own once it reaches its duration.
+	<-rec.done
+	rec.Record(&mixerpb.CheckRequest{})
+	if err = rec.Close(); err != nil || !buf.closed {
+		t.Fatalf("Close() => %v, want the writer to be closed", err)
+	}
+	if n := readEntries(t, buf); n != 0 {
+		t.Fatalf("got %d entries, want none", n)
+	}
+}
@@ -96,6 +96,12 @@ type ResolverChangeListener interface {
 	ChangeResolver(rt Resolver)
 }
 
+// ResolverChangeNotifier is implemented by the dispatchers which let other components be notified of
+// resolver changes, for example to invalidate the results they derived from the previous resolver.
+type ResolverChangeNotifier interface {
+	AddResolverChangeListener(l ResolverChangeListener)
+}
+
 // VocabularyChangeListener is notified when attribute vocabulary changes.
 type VocabularyChangeListener interface {
 	ChangeVocabulary(finder expr.AttributeDescriptorFinder)
@@ -154,6 +160,7 @@ func (c *Controller) publishSnapShot() {
 	// Create new resolver and cleanup the old resolver.
 	c.nextResolverID++
 	resolver := newResolver(c.evaluator, c.identityAttribute, c.defaultConfigNamespace, resolvedRules, c.nextResolverID)
+	resolver.attributes = attributes
 	c.resolverChangeListener.ChangeResolver(resolver)
 
 	// copy old for deletion.
@@ -268,6 +275,19 @@ func (c *Controller) validHandlerConfigs() map[string]*cpb.Handler {
 	return handlerConfig
 }
 
+// handlerLabels returns the labels of the handler with the fully qualified name.
+func (c *Controller) handlerLabels(name string) map[string]string {
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	obj := c.configState[store.Key{Name: parts[0], Kind: parts[1], Namespace: parts[2]}]
+	if obj == nil {
+		return nil
+	}
+	return obj.Metadata.Labels
+}
+
 // processAttributeManifests loads attribute manifests to produce an AttributeDescriptorFinder.
 // attribute manifests are not expected to change often.
 func (c *Controller) processAttributeManifests() expr.AttributeDescriptorFinder {
@@ -341,6 +361,11 @@ func convertToRuntimeRules(ruleConfig rulesMapByNamespace) (rulesListByNamespace
 
 const (
 	istioProtocol = "istio-protocol"
+	istioDryRun   = "istio-dry-run"
+	istioTimeout  = "istio-timeout"
+
+	// dryRunMirrorReportValue is the value of the dry-run label that dispatches the report actions.
+	dryRunMirrorReportValue = "mirror-report"
 )
 
 // buildRule builds runtime representation of rule based on match condition.
@@ -375,6 +400,48 @@ func resourceType(labels map[string]string) ResourceType {
 	return rt
 }
 
+// dryRun maps labels to the dry-run mode of rules.
+func dryRun(labels map[string]string) dryRunMode {
+	switch v := labels[istioDryRun]; v {
+	case "", "false":
+		return dryRunOff
+	case dryRunMirrorReportValue:
+		return dryRunMirrorReport
+	case "true":
+		return dryRunSkipReport
+	default:
+		log.Warnf("Unknown value of label %s: %s, assuming true", istioDryRun, v)
+		return dryRunSkipReport
+	}
+}
+
+// timeoutLabels are the labels of handlers that set the timeout of a template variety. They override
+// the istio-timeout label, which applies to all varieties.
+var timeoutLabels = map[adptTmpl.TemplateVariety]string{
+	adptTmpl.TEMPLATE_VARIETY_CHECK:               istioTimeout + "-check",
+	adptTmpl.TEMPLATE_VARIETY_REPORT:              istioTimeout + "-report",
+	adptTmpl.TEMPLATE_VARIETY_QUOTA:               istioTimeout + "-quota",
+	adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR: istioTimeout + "-preprocess",
+}
+
+// timeout maps labels to the timeout of the calls to a handler for the template variety, or 0 if the
+// default timeout applies.
+func timeout(labels map[string]string, variety adptTmpl.TemplateVariety) time.Duration {
+	for _, l := range []string{timeoutLabels[variety], istioTimeout} {
+		v, found := labels[l]
+		if !found {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Warnf("Invalid value of label %s: %s, it must be a positive duration", l, v)
+			continue
+		}
+		return d
+	}
+	return 0
+}
+
 // processRules builds the current consistent view of the rules keyed by Namespace and then Name.
 // ht (handlerTable) keeps track of handler-instance association.
 func (c *Controller) processRules(handlerConfig map[string]*cpb.Handler,
@@ -409,6 +476,16 @@ func (c *Controller) processRules(handlerConfig map[string]*cpb.Handler,
 			continue
 		}
 		rule.actions = ruleActions
+		rule.dryRun = dryRun(obj.Metadata.Labels)
+		if rule.dryRun != dryRunOff {
+			log.Infof("Rule %s is in dry-run mode: %s", rule.name, rule.dryRun)
+		}
+		for _, acts := range ruleActions {
+			for _, act := range acts {
+				act.ruleName = rule.name
+				act.dryRun = rule.dryRun != dryRunOff
+			}
+		}
 		rn := ruleConfig[k.Namespace]
 		if rn == nil {
 			rn = make(map[string]*Rule)
@@ -489,6 +566,7 @@ func (c *Controller) processActions(acts []*cpb.Action, handlerConfig map[string
 					processor:   &ti,
 					handlerName: ic.Handler,
 					adapterName: hc.Adapter,
+					timeout:     timeout(c.handlerLabels(ic.Handler), ti.Variety),
 				}
 				vAction[templateHandlerKey] = act
 			}
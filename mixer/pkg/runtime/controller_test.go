@@ -530,6 +530,66 @@ func TestController_ResourceType(t *testing.T) {
 
 }
 
+func TestController_DryRun(t *testing.T) {
+	for _, tc := range []struct {
+		labels map[string]string
+		dryRun dryRunMode
+	}{
+		{labels: nil, dryRun: dryRunOff},
+		{labels: map[string]string{istioDryRun: "false"}, dryRun: dryRunOff},
+		{labels: map[string]string{istioDryRun: "true"}, dryRun: dryRunSkipReport},
+		{labels: map[string]string{istioDryRun: "mirror-report"}, dryRun: dryRunMirrorReport},
+		{labels: map[string]string{istioDryRun: "yes"}, dryRun: dryRunSkipReport},
+	} {
+		t.Run(fmt.Sprintf("%v", tc.labels), func(t *testing.T) {
+			if got := dryRun(tc.labels); got != tc.dryRun {
+				t.Fatalf("got %v, want %v", got, tc.dryRun)
+			}
+		})
+	}
+}
+
+func TestController_Timeout(t *testing.T) {
+	for _, tc := range []struct {
+		labels  map[string]string
+		variety adptTmpl.TemplateVariety
+		timeout time.Duration
+	}{
+		{labels: nil, variety: adptTmpl.TEMPLATE_VARIETY_CHECK},
+		{labels: map[string]string{istioTimeout: "1s"}, variety: adptTmpl.TEMPLATE_VARIETY_REPORT, timeout: time.Second},
+		{labels: map[string]string{istioTimeout: "1s", istioTimeout + "-check": "10ms"},
+			variety: adptTmpl.TEMPLATE_VARIETY_CHECK, timeout: 10 * time.Millisecond},
+		{labels: map[string]string{istioTimeout: "1s", istioTimeout + "-check": "10ms"},
+			variety: adptTmpl.TEMPLATE_VARIETY_QUOTA, timeout: time.Second},
+		{labels: map[string]string{istioTimeout + "-preprocess": "5ms"},
+			variety: adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR, timeout: 5 * time.Millisecond},
+		{labels: map[string]string{istioTimeout: "1s", istioTimeout + "-quota": "-1s"},
+			variety: adptTmpl.TEMPLATE_VARIETY_QUOTA, timeout: time.Second},
+		{labels: map[string]string{istioTimeout: "soon"}, variety: adptTmpl.TEMPLATE_VARIETY_CHECK},
+	} {
+		t.Run(fmt.Sprintf("%v/%v", tc.labels, tc.variety), func(t *testing.T) {
+			if got := timeout(tc.labels, tc.variety); got != tc.timeout {
+				t.Fatalf("got %v, want %v", got, tc.timeout)
+			}
+		})
+	}
+
+	labels := map[string]string{istioTimeout: "1s"}
+	c := &Controller{
+		configState: map[store.Key]*store.Resource{
+			{Name: "h1", Kind: "a1", Namespace: "ns"}: {Metadata: store.ResourceMeta{Labels: labels}},
+		},
+	}
+	if got := c.handlerLabels("h1.a1.ns"); !reflect.DeepEqual(got, labels) {
+		t.Fatalf("got %v, want %v", got, labels)
+	}
+	for _, name := range []string{"h2.a1.ns", "h1"} {
+		if got := c.handlerLabels(name); got != nil {
+			t.Fatalf("got %v for %s, want no labels", got, name)
+		}
+	}
+}
+
 //unc canonicalizeInstanceNames(instances []string, namespace string) []string
 func TestController_canInstances(t *testing.T) {
 	ns := "default-ns"
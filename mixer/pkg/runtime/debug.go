@@ -0,0 +1,237 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	adptTmpl "istio.io/api/mixer/v1/template"
+	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/il/compiled"
+)
+
+// Debugger is implemented by the dispatchers which can explain how the configuration applies to a
+// set of attributes.
+type Debugger interface {
+	// Debug returns the rules that apply to the attributes, and the instances they build. The attribute
+	// generation instances are dispatched, and the attributes they generate are added to the ones the
+	// rules apply to, as in Preprocess. If dispatch is true, the check instances are also dispatched to the
+	// handlers, whose results are returned but not applied. Quota and report instances are never dispatched.
+	Debug(ctx context.Context, bag attribute.Bag, dispatch bool) (*DebugInfo, error)
+}
+
+// DebugInfo describes how the configuration applies to a set of attributes.
+type DebugInfo struct {
+	// Resolver is the id of the configuration snapshot.
+	Resolver int `json:"resolver"`
+
+	// Rules are the rules of the namespaces that apply to the attributes, in the order they are evaluated.
+	Rules []*DebugRule `json:"rules"`
+
+	// Errors are the errors of the resolution of the actions.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// DebugRule describes the evaluation of a rule.
+type DebugRule struct {
+	Name    string `json:"name"`
+	Match   string `json:"match,omitempty"`
+	Matched bool   `json:"matched"`
+	DryRun  bool   `json:"dryRun,omitempty"`
+
+	// Error is the error of the evaluation of the match condition.
+	Error string `json:"error,omitempty"`
+
+	// Actions are the actions of the rule that apply to the attributes.
+	Actions []*DebugAction `json:"actions,omitempty"`
+}
+
+// DebugAction describes the dispatch of the instances of a template to a handler.
+type DebugAction struct {
+	Variety   string           `json:"variety"`
+	Template  string           `json:"template"`
+	Handler   string           `json:"handler"`
+	Adapter   string           `json:"adapter"`
+	Instances []*DebugInstance `json:"instances"`
+}
+
+// DebugInstance describes an instance that is built from the attributes.
+type DebugInstance struct {
+	Name string `json:"name"`
+
+	// Fields is the instance that is built from the attributes.
+	Fields interface{} `json:"fields,omitempty"`
+
+	// Result is the result returned by the handler, if the instance is dispatched.
+	Result interface{} `json:"result,omitempty"`
+
+	// Error is the error of building or dispatching the instance.
+	Error string `json:"error,omitempty"`
+}
+
+// debugVarieties are the template varieties which are resolved after the attribute generation, in the
+// order of their dispatch.
+var debugVarieties = []adptTmpl.TemplateVariety{
+	adptTmpl.TEMPLATE_VARIETY_CHECK,
+	adptTmpl.TEMPLATE_VARIETY_QUOTA,
+	adptTmpl.TEMPLATE_VARIETY_REPORT,
+}
+
+// debugAction is a DebugAction along with the rule it belongs to.
+type debugAction struct {
+	rule   string
+	action *DebugAction
+}
+
+// Debug implements Debugger.
+func (m *dispatcher) Debug(ctx context.Context, bag attribute.Bag, dispatch bool) (*DebugInfo, error) {
+	m.resolverLock.RLock()
+	r, ok := m.resolver.(*resolver)
+	if ok {
+		// the reference keeps the handlers of the configuration from being closed while they are used.
+		r.incRefCount()
+	}
+	m.resolverLock.RUnlock()
+	if !ok {
+		return nil, errors.New("the resolver does not support debugging")
+	}
+	defer r.decRefCount()
+
+	var expb *compiled.ExpressionBuilder
+	if r.attributes != nil {
+		expb = compiled.NewBuilder(r.attributes)
+	}
+
+	info := &DebugInfo{Resolver: r.id}
+	ctx = newContextWithRequestData(ctx, bag, m.identityAttribute)
+
+	// The other varieties are resolved against the request attributes and the generated ones.
+	responseBag := attribute.GetMutableBag(bag)
+	defer responseBag.Done()
+	actions := m.debugActions(ctx, r, adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR, bag, expb, true, responseBag, info)
+
+	rules, err := r.debugRules(responseBag)
+	if err != nil {
+		return nil, err
+	}
+	for _, variety := range debugVarieties {
+		actions = append(actions, m.debugActions(ctx, r, variety, responseBag, expb,
+			dispatch && variety == adptTmpl.TEMPLATE_VARIETY_CHECK, nil, info)...)
+	}
+
+	byName := make(map[string]*DebugRule, len(rules))
+	for _, rule := range rules {
+		byName[rule.Name] = rule
+	}
+	for _, a := range actions {
+		if rule := byName[a.rule]; rule != nil {
+			rule.Actions = append(rule.Actions, a.action)
+		}
+	}
+	info.Rules = rules
+	return info, nil
+}
+
+// debugActions resolves the actions of the variety, and builds their instances. If dispatch is true, the instances
+// are dispatched to the handlers. The attributes generated by the instances are merged into responseBag, if any.
+func (m *dispatcher) debugActions(ctx context.Context, r *resolver, variety adptTmpl.TemplateVariety, bag attribute.Bag,
+	expb *compiled.ExpressionBuilder, dispatch bool, responseBag *attribute.MutableBag, info *DebugInfo) []debugAction {
+	acts, err := r.Resolve(bag, variety)
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("%v: %v", variety, err))
+		return nil
+	}
+	defer acts.Done()
+
+	var res []debugAction
+	for _, act := range acts.Get() {
+		da := &DebugAction{
+			Variety:  variety.String(),
+			Template: act.processor.Name,
+			Handler:  act.handlerName,
+			Adapter:  act.adapterName,
+		}
+		for _, inst := range act.instanceConfig {
+			di := &DebugInstance{Name: inst.Name}
+			if expb != nil {
+				di.Fields, err = buildInstance(act, inst.Name, inst.Params.(proto.Message), expb, bag)
+				if err != nil {
+					di.Error = err.Error()
+				}
+			}
+			if dispatch && di.Error == "" {
+				generated := m.debugDispatch(ctx, act, variety, inst.Name, inst.Params.(proto.Message), bag, di)
+				if generated != nil && responseBag != nil && !act.dryRun {
+					if err = responseBag.Merge(generated); err != nil {
+						info.Errors = append(info.Errors, fmt.Sprintf("%v: %v", variety, err))
+					}
+				}
+			}
+			da.Instances = append(da.Instances, di)
+		}
+		res = append(res, debugAction{rule: act.ruleName, action: da})
+	}
+	return res
+}
+func buildInstance(act *Action, name string, params proto.Message, expb *compiled.ExpressionBuilder,
+	bag attribute.Bag) (interface{}, error) {
+	if act.processor.CreateInstanceBuilder == nil {
+		return nil, nil
+	}
+	build, err := act.processor.CreateInstanceBuilder(name, params, expb)
+	if err != nil {
+		return nil, err
+	}
+	return build(bag)
+}
+
+// debugDispatch dispatches the check or attribute generation instance to the handler of the action, and records
+// the result. It returns the attributes generated by the instance, if any.
+func (m *dispatcher) debugDispatch(ctx context.Context, act *Action, variety adptTmpl.TemplateVariety, name string,
+	params proto.Message, bag attribute.Bag, di *DebugInstance) *attribute.MutableBag {
+	var generated *attribute.MutableBag
+	op := act.processor.Name + ":" + act.handlerName + "(" + act.adapterName + ")"
+	out := safeDispatch(ctx, func(ctx context.Context) *result {
+		if variety == adptTmpl.TEMPLATE_VARIETY_CHECK {
+			res, err := act.processor.ProcessCheck(ctx, name, params, bag, m.mapper, act.handler)
+			return &result{err: err, res: &res}
+		}
+
+		var err error
+		if generated, err = act.processor.ProcessGenAttrs(ctx, name, params, bag, m.mapper, act.handler); err != nil ||
+			generated == nil {
+			return &result{err: err}
+		}
+		attrs := make(map[string]interface{})
+		for _, n := range generated.Names() {
+			attrs[n], _ = generated.Get(n)
+		}
+		di.Result = attrs
+		return &result{}
+	}, op)
+
+	if out.err != nil {
+		di.Error = fmt.Sprintf("dispatch: %v", out.err)
+		return nil
+	}
+	if out.res != nil {
+		di.Result = out.res
+	}
+	return generated
+}
@@ -0,0 +1,199 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	adptTmpl "istio.io/api/mixer/v1/template"
+	rpc "istio.io/gogo-genproto/googleapis/google/rpc"
+	"istio.io/istio/mixer/pkg/adapter"
+	"istio.io/istio/mixer/pkg/attribute"
+	cpb "istio.io/istio/mixer/pkg/config/proto"
+	"istio.io/istio/mixer/pkg/expr"
+	"istio.io/istio/mixer/pkg/pool"
+	"istio.io/istio/mixer/pkg/template"
+)
+
+func newDebugResolver(reject bool, fp *fakeProc) *resolver {
+	newAction := func(rule string, handler string) *Action {
+		return &Action{
+			processor:      newTemplate("t1", fp),
+			handlerName:    handler,
+			adapterName:    handler + "Impl",
+			instanceConfig: []*cpb.Instance{{Name: "i1", Template: "t1", Params: &rpc.Status{}}},
+			ruleName:       rule,
+		}
+	}
+
+	rules := map[string][]*Rule{
+		DefaultConfigNamespace: {
+			{
+				name: "r1",
+				actions: map[adptTmpl.TemplateVariety][]*Action{
+					adptTmpl.TEMPLATE_VARIETY_CHECK: {newAction("r1", "h1")},
+				},
+			},
+			{
+				name:  "r2",
+				match: "request.size > 2000",
+				actions: map[adptTmpl.TemplateVariety][]*Action{
+					adptTmpl.TEMPLATE_VARIETY_REPORT: {newAction("r2", "h2")},
+				},
+				dryRun: dryRunMirrorReport,
+			},
+		},
+	}
+	return newResolver(fakePred(reject, ""), DefaultIdentityAttribute, DefaultConfigNamespace, rules, 42)
+}
+
+func TestDebug(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	bag := attribute.GetFakeMutableBagForTesting(map[string]interface{}{
+		DefaultIdentityAttribute: "myservice.myns",
+	})
+
+	for _, s := range []struct {
+		name     string
+		reject   bool
+		dispatch bool
+		matched  []bool
+		actions  []int
+		ncalled  int
+	}{
+		{name: "matched", matched: []bool{true, true}, actions: []int{1, 1}},
+		{name: "rejected", reject: true, matched: []bool{true, false}, actions: []int{1, 0}},
+		// the report of r2 is not dispatched.
+		{name: "dispatched", dispatch: true, matched: []bool{true, true}, actions: []int{1, 1}, ncalled: 1},
+	} {
+		t.Run(s.name, func(t *testing.T) {
+			fp := &fakeProc{checkResult: adapter.CheckResult{ValidUseCount: 200}}
+			r := newDebugResolver(s.reject, fp)
+			m := newDispatcher(nil, r, gp, DefaultIdentityAttribute)
+
+			info, err := m.Debug(context.Background(), bag, s.dispatch)
+			if err != nil {
+				t.Fatalf("Debug() => %v", err)
+			}
+			if info.Resolver != 42 || len(info.Errors) != 0 {
+				t.Fatalf("Debug() => %+v, want resolver 42 without errors", info)
+			}
+			if len(info.Rules) != len(s.matched) {
+				t.Fatalf("got %d rules, want %d", len(info.Rules), len(s.matched))
+			}
+			for i, rule := range info.Rules {
+				if rule.Matched != s.matched[i] || len(rule.Actions) != s.actions[i] {
+					t.Fatalf("got rule %+v, want matched=%v with %d actions", rule, s.matched[i], s.actions[i])
+				}
+			}
+			if !info.Rules[1].DryRun || info.Rules[1].Match != "request.size > 2000" {
+				t.Fatalf("got rule %+v, want the dry-run rule r2", info.Rules[1])
+			}
+			if fp.called != s.ncalled {
+				t.Fatalf("got %d calls, want %d", fp.called, s.ncalled)
+			}
+
+			check := info.Rules[0].Actions[0]
+			if check.Variety != adptTmpl.TEMPLATE_VARIETY_CHECK.String() || check.Handler != "h1" ||
+				check.Adapter != "h1Impl" || len(check.Instances) != 1 || check.Instances[0].Name != "i1" {
+				t.Fatalf("got action %+v, want the check of h1", check)
+			}
+			if result := check.Instances[0].Result; (result != nil) != s.dispatch {
+				t.Fatalf("got result %v, want dispatched=%v", result, s.dispatch)
+			}
+			if result := info.Rules[1].Actions[0].Instances[0].Result; result != nil {
+				t.Fatalf("got report result %v, want none", result)
+			}
+			if refs := r.refCount; refs != 0 {
+				t.Fatalf("got refcount %d, want 0", refs)
+			}
+		})
+	}
+}
+
+func TestDebug_Preprocess(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	bag := attribute.GetFakeMutableBagForTesting(map[string]interface{}{
+		DefaultIdentityAttribute: "myservice.myns",
+	})
+	generated := attribute.GetMutableBag(nil)
+	generated.Set("source.labels", map[string]string{"app": "a1"})
+
+	var checked interface{}
+	check := &template.Info{
+		Name: "t2",
+		ProcessCheck: func(_ context.Context, _ string, _ proto.Message, attrs attribute.Bag, _ expr.Evaluator,
+			_ adapter.Handler) (adapter.CheckResult, error) {
+			checked, _ = attrs.Get("source.labels")
+			return adapter.CheckResult{}, nil
+		},
+	}
+
+	r := newDebugResolver(false, &fakeProc{mutableBagResult: generated})
+	r1 := r.rules[DefaultConfigNamespace][0]
+	r1.actions[adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR] = []*Action{{
+		processor:      newTemplate("t0", &fakeProc{mutableBagResult: generated}),
+		handlerName:    "h0",
+		instanceConfig: []*cpb.Instance{{Name: "i0", Template: "t0", Params: &rpc.Status{}}},
+		ruleName:       "r1",
+	}}
+	r1.actions[adptTmpl.TEMPLATE_VARIETY_CHECK][0].processor = check
+	m := newDispatcher(nil, r, gp, DefaultIdentityAttribute)
+
+	// the attributes are generated even if the instances are not dispatched.
+	info, err := m.Debug(context.Background(), bag, false)
+	if err != nil {
+		t.Fatalf("Debug() => %v", err)
+	}
+	if actions := info.Rules[0].Actions; len(actions) != 2 ||
+		actions[0].Variety != adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR.String() ||
+		actions[0].Instances[0].Result == nil {
+		t.Fatalf("got actions %+v, want the generated attributes of r1 first", actions)
+	}
+	if checked != nil {
+		t.Fatalf("got check dispatched with %v, want no dispatch", checked)
+	}
+
+	if _, err = m.Debug(context.Background(), bag, true); err != nil {
+		t.Fatalf("Debug() => %v", err)
+	}
+	if labels, ok := checked.(map[string]string); !ok || labels["app"] != "a1" {
+		t.Fatalf("got check dispatched with %v, want the generated attributes", checked)
+	}
+}
+
+func TestDebug_Errors(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	m := newDispatcher(nil, newFakeResolver("t1", nil, false, &fakeProc{}), gp, DefaultIdentityAttribute)
+	if _, err := m.Debug(context.Background(), attribute.GetMutableBag(nil), false); err == nil {
+		t.Fatalf("Debug() => success, want an error for a resolver that does not support debugging")
+	}
+
+	m = newDispatcher(nil, newDebugResolver(false, &fakeProc{}), gp, DefaultIdentityAttribute)
+	if _, err := m.Debug(context.Background(), attribute.GetMutableBag(nil), false); err == nil ||
+		!strings.Contains(err.Error(), "identity not found") {
+		t.Fatalf("Debug() => %v, want an identity error", err)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -67,6 +68,14 @@ type Resolver interface {
 	Resolve(bag attribute.Bag, variety adptTmpl.TemplateVariety) (Actions, error)
 }
 
+// TimeoutSetter is implemented by the dispatchers which enforce deadlines on the calls to the handlers.
+// The deadlines are visible to the handlers through the context they are called with.
+type TimeoutSetter interface {
+	// SetDefaultTimeouts sets the timeouts of the calls to the handlers, by template variety.
+	// A handler can override the timeout of its variety with labels.
+	SetDefaultTimeouts(timeouts map[adptTmpl.TemplateVariety]time.Duration)
+}
+
 // Actions combines []*Action with a lifecycle (Done) function.
 type Actions interface {
 	// Get gets the encapsulated actions.
@@ -93,6 +102,13 @@ type Action struct {
 	// instanceConfigs to dispatch to the handler.
 	// instanceConfigs must belong to the same template.
 	instanceConfig []*cpb.Instance
+	// Name of the rule of the action. Informational.
+	ruleName string
+	// dryRun indicates that the results of the action are logged and counted, but not applied.
+	dryRun bool
+	// timeout is the maximum duration of the calls to the handler. The default timeout of the
+	// template variety applies if 0.
+	timeout time.Duration
 }
 
 // genDispatchFn creates dispatchFn closures based on the given action.
@@ -123,6 +139,15 @@ type dispatcher struct {
 	resolverLock sync.RWMutex
 	resolver     Resolver
 
+	// listeners are notified after a new resolver is installed.
+	listeners []ResolverChangeListener
+
+	// timeouts are the default timeouts of the calls to the handlers, by template variety.
+	timeouts map[adptTmpl.TemplateVariety]time.Duration
+
+	// abandoned counts the timed out calls to the handlers which have not returned yet.
+	abandoned abandonedDispatches
+
 	identityAttribute string
 
 	*probe.Probe
@@ -139,9 +164,40 @@ func (m *dispatcher) ChangeResolver(rt Resolver) {
 		err = errors.New("resolver is unavailable")
 	}
 	m.Probe.SetAvailable(err)
+	listeners := m.listeners
+	m.resolverLock.Unlock()
+
+	for _, l := range listeners {
+		l.ChangeResolver(rt)
+	}
+}
+
+// AddResolverChangeListener registers a listener which is notified every time a new resolver
+// is installed.
+func (m *dispatcher) AddResolverChangeListener(l ResolverChangeListener) {
+	m.resolverLock.Lock()
+	m.listeners = append(m.listeners, l)
+	m.resolverLock.Unlock()
+}
+
+// SetDefaultTimeouts sets the timeouts of the calls to the handlers, by template variety. They
+// apply to the handlers without a timeout of their own.
+func (m *dispatcher) SetDefaultTimeouts(timeouts map[adptTmpl.TemplateVariety]time.Duration) {
+	m.resolverLock.Lock()
+	m.timeouts = timeouts
 	m.resolverLock.Unlock()
 }
 
+// timeout returns the timeout of the calls of the action, or 0 if they have none.
+func (m *dispatcher) timeout(callinfo *Action) time.Duration {
+	if callinfo.timeout > 0 {
+		return callinfo.timeout
+	}
+	m.resolverLock.RLock()
+	defer m.resolverLock.RUnlock()
+	return m.timeouts[callinfo.processor.Variety]
+}
+
 // Resolve resolves configuration to a list of actions.
 func (m *dispatcher) Resolve(bag attribute.Bag, variety adptTmpl.TemplateVariety) (Actions, error) {
 	m.resolverLock.RLock()
@@ -254,11 +310,12 @@ func (m *dispatcher) Quota(ctx context.Context, requestBag attribute.Bag,
 				// When such a mechanism is created, re-enable the inst.Name filter.
 				// Until then Proxy always calls with exactly 1 quota request named
 				// "RequestCount" which is intended for rate limit.
-				if dispatched { // ensures only one call is dispatched.
+				// dry-run actions do not count, as their results are not applied.
+				if dispatched && !call.dryRun { // ensures only one call is dispatched.
 					log.Warnf("Multiple dispatch: not dispatching %s to handler %s", inst.Name, call.handlerName)
 					return nil
 				}
-				dispatched = true
+				dispatched = dispatched || !call.dryRun
 				return []dispatchFn{ // nolint: megacheck
 					func(ctx context.Context) *result {
 						resp, err := call.processor.ProcessQuota(ctx, inst.Name,
@@ -297,7 +354,7 @@ func (m *dispatcher) Preprocess(ctx context.Context, requestBag attribute.Bag, r
 							inst.Params.(proto.Message),
 							requestBag, m.mapper,
 							call.handler)
-						if err == nil {
+						if err == nil && !call.dryRun {
 							lock.Lock()
 							defer lock.Unlock()
 							err = responseBag.Merge(mBag)
@@ -409,6 +466,111 @@ func safeDispatch(ctx context.Context, do dispatchFn, op string) (res *result) {
 	return
 }
 
+// errDispatchTimeout is returned by dispatchWithTimeout when the deadline expires before the dispatch completes.
+var errDispatchTimeout = errors.New("dispatch timed out")
+
+// maxAbandonedDispatches bounds the number of timed out calls to a handler which have not returned yet.
+// Once a handler reaches it, the calls to the handler fail without being made until some of them return.
+const maxAbandonedDispatches = 16
+
+// abandonedDispatches counts the timed out calls which have not returned yet, by handler.
+type abandonedDispatches struct {
+	mu sync.Mutex
+	n  map[string]int
+}
+
+// acquire counts a timed out call to the handler, unless the handler reached maxAbandonedDispatches.
+func (a *abandonedDispatches) acquire(handler string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.n[handler] >= maxAbandonedDispatches {
+		return false
+	}
+	if a.n == nil {
+		a.n = make(map[string]int)
+	}
+	a.n[handler]++
+	dispatchAbandonedGauge.With(prometheus.Labels{handlerName: handler}).Set(float64(a.n[handler]))
+	return true
+}
+
+// release uncounts a timed out call to the handler which returned.
+func (a *abandonedDispatches) release(handler string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.n[handler]--
+	dispatchAbandonedGauge.With(prometheus.Labels{handlerName: handler}).Set(float64(a.n[handler]))
+	if a.n[handler] == 0 {
+		delete(a.n, handler)
+	}
+}
+
+// full returns whether the handler reached maxAbandonedDispatches.
+func (a *abandonedDispatches) full(handler string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.n[handler] >= maxAbandonedDispatches
+}
+
+const (
+	dispatchRunning int32 = iota
+	dispatchReturned
+	dispatchAbandoned
+)
+
+// dispatchWithTimeout dispatches with a context which deadline is at most timeout from now. It returns when
+// the deadline expires even if the handler has not returned yet, so that a slow handler does not hold the
+// goroutine of the caller. The handler is expected to give up when the context is done. The calls which
+// do not are counted until they return, and the handler is no longer called once it has
+// maxAbandonedDispatches of them.
+func (m *dispatcher) dispatchWithTimeout(ctx context.Context, timeout time.Duration, do dispatchFn, op string,
+	handler string) *result {
+	if m.abandoned.full(handler) {
+		return &result{err: fmt.Errorf("dispatch %s not attempted, %d timed out calls to the handler have not returned",
+			op, maxAbandonedDispatches)}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state := dispatchRunning
+	done := make(chan *result, 1)
+	go func() {
+		done <- safeDispatch(ctx, do, op)
+		if !atomic.CompareAndSwapInt32(&state, dispatchRunning, dispatchReturned) {
+			m.abandoned.release(handler)
+		}
+	}()
+
+	select {
+	case out := <-done:
+		return out
+	case <-ctx.Done():
+		// the handler may have completed at the same time.
+		select {
+		case out := <-done:
+			return out
+		default:
+		}
+		// the call is abandoned, unless it returns in the meantime or the handler has too many of them,
+		// in which case the caller waits for it.
+		if !m.abandoned.acquire(handler) {
+			<-done
+			return &result{err: errDispatchTimeout}
+		}
+		if !atomic.CompareAndSwapInt32(&state, dispatchRunning, dispatchAbandoned) {
+			m.abandoned.release(handler)
+			return <-done
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return &result{err: errDispatchTimeout}
+		}
+		return &result{err: ctx.Err()}
+	}
+}
+
 // runAsync runs the dispatchFn using a scheduler. It also adds a new span and records prometheus metrics.
 func (m *dispatcher) runAsync(ctx context.Context, callinfo *Action, results chan *result, do dispatchFn) {
 	log.Debugf("runAsync %v", *callinfo)
@@ -421,7 +583,21 @@ func (m *dispatcher) runAsync(ctx context.Context, callinfo *Action, results cha
 
 		log.Debugf("runAsync %s -> %v", op, *callinfo)
 
-		out := safeDispatch(ctx2, do, op)
+		var out *result
+		if timeout := m.timeout(callinfo); timeout > 0 {
+			out = m.dispatchWithTimeout(ctx2, timeout, do, op, callinfo.handlerName)
+			if out.err == errDispatchTimeout {
+				log.Warnf("Dispatch %s timed out after %v", op, timeout)
+				dispatchTimeoutCounter.With(prometheus.Labels{
+					meshFunction: callinfo.processor.Name,
+					handlerName:  callinfo.handlerName,
+					adapterName:  callinfo.adapterName,
+				}).Inc()
+				out = &result{err: fmt.Errorf("dispatch %s timed out after %v", op, timeout), callinfo: callinfo}
+			}
+		} else {
+			out = safeDispatch(ctx2, do, op)
+		}
 		st := status.OK
 		if out.err != nil {
 			st = status.WithError(out.err)
@@ -449,7 +625,35 @@ func (m *dispatcher) runAsync(ctx context.Context, callinfo *Action, results cha
 		dispatchCounter.With(dispatchLbls).Inc()
 		dispatchDuration.With(dispatchLbls).Observe(duration.Seconds())
 
+		if callinfo.dryRun {
+			recordDryRun(op, callinfo, out)
+			out = &result{callinfo: callinfo}
+		}
+
 		results <- out
 		span.Finish()
 	}, nil)
 }
+
+// recordDryRun logs and counts the result of an action in dry-run mode.
+func recordDryRun(op string, callinfo *Action, out *result) {
+	st := status.OK
+	if out.err != nil {
+		st = status.WithError(out.err)
+	} else if out.res != nil {
+		st = out.res.GetStatus()
+	}
+	if status.IsOK(st) {
+		log.Debugf("Dry-run rule %s: %s succeeded", callinfo.ruleName, op)
+	} else {
+		log.Infof("Dry-run rule %s: %s failed: %s", callinfo.ruleName, op, status.String(st))
+	}
+
+	dryRunCounter.With(prometheus.Labels{
+		ruleStr:      callinfo.ruleName,
+		meshFunction: callinfo.processor.Name,
+		handlerName:  callinfo.handlerName,
+		responseCode: rpc.Code_name[st.Code],
+		errorStr:     strconv.FormatBool(out.err != nil),
+	}).Inc()
+}
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -160,6 +162,143 @@ func TestCheck(t *testing.T) {
 	_ = gp.Close()
 }
 
+func TestCheck_DryRun(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	for _, s := range []struct {
+		name    string
+		callErr error
+		cr      adapter.CheckResult
+	}{
+		{name: "denied", cr: adapter.CheckResult{Status: status.WithPermissionDenied("bad user")}},
+		{name: "error", callErr: errors.New("internal error")},
+	} {
+		t.Run(s.name, func(t *testing.T) {
+			fp := &fakeProc{
+				err:         s.callErr,
+				checkResult: s.cr,
+			}
+			rt := newFakeResolver("metric1", nil, false, fp)
+			for _, a := range rt.ra[1:] {
+				a.dryRun = true
+			}
+			m := newDispatcher(nil, rt, gp, DefaultIdentityAttribute)
+
+			cr, err := m.Check(context.Background(), attribute.GetMutableBag(nil))
+			if fp.called != 6 {
+				t.Fatalf("got %v calls, want 6", fp.called)
+			}
+			// only the results of the first action are applied.
+			checkError(t, s.callErr, err)
+			if s.callErr != nil {
+				return
+			}
+			if cr == nil || !strings.HasPrefix(cr.Status.Message, "myhandler:") || strings.Contains(cr.Status.Message, "_") {
+				t.Fatalf("got %v, want the status of myhandler", cr)
+			}
+
+			for _, a := range rt.ra {
+				a.dryRun = true
+			}
+			fp.called = 0
+			cr, err = m.Check(context.Background(), attribute.GetMutableBag(nil))
+			if err != nil || cr != nil {
+				t.Fatalf("got %v, %v, want no result", cr, err)
+			}
+			if fp.called != 6 {
+				t.Fatalf("got %v calls, want 6", fp.called)
+			}
+		})
+	}
+}
+
+func TestCheck_Timeout(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	rt := newFakeResolver("metric1", nil, false, &fakeProc{})
+	var deadlines int32
+	cancelled := make(chan struct{})
+	for i, a := range rt.ra {
+		slow := i == len(rt.ra)-1
+		a.processor.Variety = adptTmpl.TEMPLATE_VARIETY_CHECK
+		a.processor.ProcessCheck = func(ctx context.Context, _ string, _ proto.Message, _ attribute.Bag,
+			_ expr.Evaluator, _ adapter.Handler) (adapter.CheckResult, error) {
+			if _, ok := ctx.Deadline(); ok {
+				atomic.AddInt32(&deadlines, 1)
+			}
+			if slow {
+				<-ctx.Done()
+				cancelled <- struct{}{}
+			}
+			return adapter.CheckResult{}, nil
+		}
+	}
+	rt.ra[len(rt.ra)-1].timeout = 10 * time.Millisecond
+
+	m := newDispatcher(nil, rt, gp, DefaultIdentityAttribute)
+	m.SetDefaultTimeouts(map[adptTmpl.TemplateVariety]time.Duration{
+		adptTmpl.TEMPLATE_VARIETY_CHECK: time.Minute,
+	})
+
+	_, err := m.Check(context.Background(), attribute.GetMutableBag(nil))
+	if err == nil || !strings.Contains(err.Error(), "myhandler_B") || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("got %v, want myhandler_B to time out", err)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-cancelled:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("the context of the slow handler was not cancelled")
+		}
+	}
+	if got := atomic.LoadInt32(&deadlines); got != 6 {
+		t.Fatalf("got %d calls with a deadline, want 6", got)
+	}
+}
+
+func TestDispatcher_abandonedDispatches(t *testing.T) {
+	m := &dispatcher{}
+	release := make(chan struct{})
+	var calls int32
+	slow := func(ctx context.Context) *result {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &result{}
+	}
+
+	for i := 0; i < maxAbandonedDispatches; i++ {
+		if out := m.dispatchWithTimeout(context.Background(), time.Millisecond, slow, "op", "h1"); out.err != errDispatchTimeout {
+			t.Fatalf("got %v, want a timeout", out.err)
+		}
+	}
+	out := m.dispatchWithTimeout(context.Background(), time.Millisecond, slow, "op", "h1")
+	if out.err == nil || !strings.Contains(out.err.Error(), "not attempted") {
+		t.Fatalf("got %v, want the dispatch not to be attempted", out.err)
+	}
+	if got := atomic.LoadInt32(&calls); got != maxAbandonedDispatches {
+		t.Fatalf("got %d calls, want %d", got, maxAbandonedDispatches)
+	}
+
+	// the other handlers are still called.
+	fast := func(ctx context.Context) *result { return &result{} }
+	if out = m.dispatchWithTimeout(context.Background(), time.Minute, fast, "op", "h2"); out.err != nil {
+		t.Fatalf("got %v, want success", out.err)
+	}
+
+	close(release)
+	for i := 0; m.abandoned.full("h1"); i++ {
+		if i == 1000 {
+			t.Fatalf("the abandoned dispatches were not released")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if out = m.dispatchWithTimeout(context.Background(), time.Minute, slow, "op", "h1"); out.err != nil {
+		t.Fatalf("got %v, want success", out.err)
+	}
+}
+
 func TestQuota(t *testing.T) {
 	gp := pool.NewGoroutinePool(1, true)
 	tname := "metric1"
@@ -219,6 +358,30 @@ func TestQuota(t *testing.T) {
 	_ = gp.Close()
 }
 
+func TestQuota_DryRun(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	defer func() { _ = gp.Close() }()
+
+	fp := &fakeProc{
+		quotaResult: adapter.QuotaResult{Amount: 200},
+	}
+	rt := newFakeResolver("metric1", nil, false, fp)
+	rt.ra[0].dryRun = true
+	m := newDispatcher(nil, rt, gp, DefaultIdentityAttribute)
+
+	qr, err := m.Quota(context.Background(), attribute.GetMutableBag(nil), &QuotaMethodArgs{Quota: "i1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the dry-run action does not prevent the dispatch to the next handler.
+	if fp.called != 2 {
+		t.Fatalf("got %v calls, want 2", fp.called)
+	}
+	if qr == nil || qr.Amount != 200 {
+		t.Fatalf("got %v, want an amount of 200", qr)
+	}
+}
+
 func TestPreprocess(t *testing.T) {
 	tname := "kube1"
 	gp := pool.NewGoroutinePool(1, true)
@@ -274,6 +437,21 @@ func TestPreprocess(t *testing.T) {
 	}
 }
 
+func TestResolverChangeListener(t *testing.T) {
+	gp := pool.NewGoroutinePool(1, true)
+	m := newDispatcher(nil, nil, gp, DefaultIdentityAttribute)
+
+	var n ResolverChangeNotifier = m
+	l := &fakedispatcher{}
+	n.AddResolverChangeListener(l)
+
+	m.ChangeResolver(&fakeResolver{})
+	m.ChangeResolver(&fakeResolver{})
+	if l.called != 2 {
+		t.Fatalf("listener notified %d times, want 2", l.called)
+	}
+}
+
 // fakes
 
 type fakeResolver struct {
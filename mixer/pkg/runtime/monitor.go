@@ -26,6 +26,7 @@ const (
 	responseMsg  = "response_message"
 	errorStr     = "error"
 	targetStr    = "target"
+	ruleStr      = "rule"
 )
 
 var (
@@ -48,6 +49,32 @@ var (
 			Buckets:   buckets,
 		}, promLabelNames)
 
+	dryRunLabelNames = []string{ruleStr, meshFunction, handlerName, responseCode, errorStr}
+	dryRunCounter    = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mixer",
+			Subsystem: "adapter",
+			Name:      "dry_run_count",
+			Help:      "Total number of adapter dispatches of the rules in dry-run mode, which results are not applied.",
+		}, dryRunLabelNames)
+
+	timeoutLabelNames      = []string{meshFunction, handlerName, adapterName}
+	dispatchTimeoutCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mixer",
+			Subsystem: "adapter",
+			Name:      "dispatch_timeout_count",
+			Help:      "Total number of adapter dispatches which did not complete before their deadline.",
+		}, timeoutLabelNames)
+
+	dispatchAbandonedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mixer",
+			Subsystem: "adapter",
+			Name:      "dispatch_abandoned",
+			Help:      "Number of adapter dispatches which timed out and have not returned yet.",
+		}, []string{handlerName})
+
 	resolveLabelNames = []string{targetStr, errorStr}
 	resolveCounter    = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -89,6 +116,9 @@ var (
 func init() {
 	prometheus.MustRegister(dispatchCounter)
 	prometheus.MustRegister(dispatchDuration)
+	prometheus.MustRegister(dryRunCounter)
+	prometheus.MustRegister(dispatchTimeoutCounter)
+	prometheus.MustRegister(dispatchAbandonedGauge)
 
 	prometheus.MustRegister(resolveCounter)
 	prometheus.MustRegister(resolveDuration)
@@ -41,11 +41,39 @@ type Rule struct {
 	name string
 	// rtype is gathered from labels.
 	rtype ResourceType
+	// dryRun is gathered from labels.
+	dryRun dryRunMode
 }
 
 func (r Rule) String() string {
-	return fmt.Sprintf("[name:<%s>, match:<%s>, type:%s, actions: %v",
-		r.name, r.match, r.rtype, r.actions)
+	return fmt.Sprintf("[name:<%s>, match:<%s>, type:%s, dryRun:%s, actions: %v",
+		r.name, r.match, r.rtype, r.dryRun, r.actions)
+}
+
+// dryRunMode defines whether the results of the actions of a rule are applied.
+type dryRunMode int
+
+const (
+	// dryRunOff applies the results of the actions.
+	dryRunOff dryRunMode = iota
+
+	// dryRunSkipReport runs the check, quota and preprocess actions, but only logs and counts their
+	// results. The report actions are skipped.
+	dryRunSkipReport
+
+	// dryRunMirrorReport is like dryRunSkipReport, except that the report actions are dispatched.
+	dryRunMirrorReport
+)
+
+func (d dryRunMode) String() string {
+	switch d {
+	case dryRunSkipReport:
+		return "skipReport"
+	case dryRunMirrorReport:
+		return "mirrorReport"
+	default:
+		return "off"
+	}
 }
 
 // resolver is the runtime view of the configuration database.
@@ -66,6 +94,9 @@ type resolver struct {
 	// rules in the configuration database keyed by $namespace.
 	rules map[string][]*Rule
 
+	// attributes is the attribute vocabulary of the configuration. It is used for debugging.
+	attributes expr.AttributeDescriptorFinder
+
 	// refCount tracks the number requests currently using this
 	// configuration. resolver state can be cleaned up when this count is 0.
 	refCount int32
@@ -220,6 +251,11 @@ func (r *resolver) filterActions(rulesArr [][]*Rule, attrs attribute.Bag,
 				continue
 			}
 
+			if rule.dryRun == dryRunSkipReport && variety == adptTmpl.TEMPLATE_VARIETY_REPORT {
+				log.Debugf("filterActions: rule %s removed in dry-run", rule.name)
+				continue
+			}
+
 			// do not evaluate empty predicates.
 			if len(rule.match) != 0 {
 				if selected, err = r.evaluator.EvalPredicate(rule.match, attrs); err != nil {
@@ -237,6 +273,38 @@ func (r *resolver) filterActions(rulesArr [][]*Rule, attrs attribute.Bag,
 	return res, nselected, nil
 }
 
+// debugRules evaluates the match conditions of all the rules that apply to the attributes.
+func (r *resolver) debugRules(attrs attribute.Bag) ([]*DebugRule, error) {
+	_, ns, err := destAndNamespace(attrs, r.identityAttribute)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesArr := appendRules(make([][]*Rule, 0, 2), r.rules, r.defaultConfigNamespace)
+	if r.defaultConfigNamespace != ns {
+		rulesArr = appendRules(rulesArr, r.rules, ns)
+	}
+
+	var res []*DebugRule
+	for _, rules := range rulesArr {
+		for _, rule := range rules {
+			dr := &DebugRule{
+				Name:    rule.name,
+				Match:   rule.match,
+				Matched: true,
+				DryRun:  rule.dryRun != dryRunOff,
+			}
+			if len(rule.match) != 0 {
+				if dr.Matched, err = r.evaluator.EvalPredicate(rule.match, attrs); err != nil {
+					dr.Error = err.Error()
+				}
+			}
+			res = append(res, dr)
+		}
+	}
+	return res, nil
+}
+
 func (r *resolver) incRefCount() {
 	atomic.AddInt32(&r.refCount, 1)
 }
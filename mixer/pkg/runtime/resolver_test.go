@@ -31,6 +31,7 @@ type testcase struct {
 	selectError  string
 	variety      adptTmpl.TemplateVariety
 	callVariety  adptTmpl.TemplateVariety
+	dryRun       dryRunMode
 	err          string
 	nactions     int
 }
@@ -121,6 +122,46 @@ func TestResolver_Resolve(t *testing.T) {
 				ia: "myservice.myns",
 			},
 		},
+		{
+			desc: "success dry-run check",
+			bag: map[string]interface{}{
+				ia: "myservice.myns",
+			},
+			rules: []fakeRuleCfg{
+				{ns, 5},
+				{"myns", 3},
+			},
+			dryRun:   dryRunSkipReport,
+			nactions: 8,
+		},
+		{
+			desc: "success dry-run skipped report",
+			bag: map[string]interface{}{
+				ia: "myservice.myns",
+			},
+			rules: []fakeRuleCfg{
+				{ns, 5},
+				{"myns", 3},
+			},
+			variety:     adptTmpl.TEMPLATE_VARIETY_REPORT,
+			callVariety: adptTmpl.TEMPLATE_VARIETY_REPORT,
+			dryRun:      dryRunSkipReport,
+			nactions:    0,
+		},
+		{
+			desc: "success dry-run mirrored report",
+			bag: map[string]interface{}{
+				ia: "myservice.myns",
+			},
+			rules: []fakeRuleCfg{
+				{ns, 5},
+				{"myns", 3},
+			},
+			variety:     adptTmpl.TEMPLATE_VARIETY_REPORT,
+			callVariety: adptTmpl.TEMPLATE_VARIETY_REPORT,
+			dryRun:      dryRunMirrorReport,
+			nactions:    8,
+		},
 		{
 			desc: "failure no identity",
 			err:  "identity not found",
@@ -142,7 +183,7 @@ func TestResolver_Resolve(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			rules := newRules(tc.variety, tc.rules)
+			rules := newRules(tc.variety, tc.dryRun, tc.rules)
 			bag := attribute.GetFakeMutableBagForTesting(tc.bag)
 			eval := fakePred(tc.selectReject, tc.selectError)
 			var rv Resolver = newResolver(eval, ia, ns, rules, 1)
@@ -210,12 +251,13 @@ func assertResolverError(t *testing.T, got error, want string) {
 	}
 }
 
-func newFakeRule(vr adptTmpl.TemplateVariety, length int) *Rule {
+func newFakeRule(vr adptTmpl.TemplateVariety, dryRun dryRunMode, length int) *Rule {
 	return &Rule{
 		match: "request.size=2000",
 		actions: map[adptTmpl.TemplateVariety][]*Action{
 			vr: make([]*Action, length),
 		},
+		dryRun: dryRun,
 	}
 }
 
@@ -224,10 +266,10 @@ type fakeRuleCfg struct {
 	ruleLength int
 }
 
-func newRules(vr adptTmpl.TemplateVariety, frule []fakeRuleCfg) map[string][]*Rule {
+func newRules(vr adptTmpl.TemplateVariety, dryRun dryRunMode, frule []fakeRuleCfg) map[string][]*Rule {
 	rules := map[string][]*Rule{}
 	for _, fr := range frule {
-		rules[fr.ns] = append(rules[fr.ns], newFakeRule(vr, fr.ruleLength))
+		rules[fr.ns] = append(rules[fr.ns], newFakeRule(vr, dryRun, fr.ruleLength))
 	}
 	return rules
 }
@@ -17,6 +17,7 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"istio.io/istio/mixer/pkg/adapter"
 	"istio.io/istio/mixer/pkg/config/store"
@@ -48,10 +49,34 @@ type Args struct {
 	// Maximum number of goroutines in the adapter worker pool
 	AdapterWorkerPoolSize int
 
+	// Default maximum duration of the calls to the handlers, by kind of call. No timeout is enforced if 0.
+	// Handlers can override them with the istio-timeout labels.
+	AdapterCheckTimeout      time.Duration
+	AdapterReportTimeout     time.Duration
+	AdapterQuotaTimeout      time.Duration
+	AdapterPreprocessTimeout time.Duration
+
 	// Maximum number of entries in the expression cache
 	ExpressionEvalCacheSize int
 
+	// Maximum number of results in the check cache. The check cache is disabled if 0.
+	// The cache is bounded by its number of entries, not by the memory they use.
+	CheckCacheMaxEntries int
+
+	// Path of the file into which a sample of the Check and Report requests is recorded. Recording is disabled if empty.
+	RecordFile string
+
+	// Fraction of the requests which are recorded, in (0, 1].
+	RecordSampleRate float64
+
+	// Number of bytes of requests, before compression, after which the recording stops. Unbounded if 0.
+	RecordMaxSize int64
+
+	// Duration after which the recording stops. Unbounded if 0.
+	RecordMaxDuration time.Duration
+
 	// URL of the config store. Use k8s://path_to_kubeconfig or fs:// for file system. If path_to_kubeconfig is empty, in-cluster kubeconfig is used.")
+	// Use etcd://host:port/prefix or consul://host:port/prefix for resources stored in etcd or Consul.
 	// If this is empty (and ConfigStore isn't specified), "k8s://" will be used.
 	ConfigStoreURL string
 
@@ -93,6 +118,10 @@ type Args struct {
 	// Enables gRPC-level tracing
 	EnableGRPCTracing bool
 
+	// Exposes the /debug/rules endpoint on the monitoring port, which explains how the rules apply to a set of
+	// attributes, and runs the attribute generation and check adapters on demand.
+	EnableDebugRules bool
+
 	// If true, each request to Mixer will be executed in a single go routine (useful for debugging)
 	SingleThreaded bool
 }
@@ -107,6 +136,9 @@ func NewArgs() *Args {
 		APIWorkerPoolSize:             1024,
 		AdapterWorkerPoolSize:         1024,
 		ExpressionEvalCacheSize:       evaluator.DefaultCacheSize,
+		RecordSampleRate:              0.01,
+		RecordMaxSize:                 100 * 1024 * 1024,
+		RecordMaxDuration:             time.Hour,
 		ConfigDefaultNamespace:        mixerRuntime.DefaultConfigNamespace,
 		ConfigIdentityAttribute:       "destination.service",
 		ConfigIdentityAttributeDomain: "svc.cluster.local",
@@ -126,10 +158,28 @@ func (a *Args) validate() error {
 		return fmt.Errorf("adapter worker pool size must be >= 0 and <= 2^31-1, got pool size %d", a.AdapterWorkerPoolSize)
 	}
 
+	if a.AdapterCheckTimeout < 0 || a.AdapterReportTimeout < 0 || a.AdapterQuotaTimeout < 0 || a.AdapterPreprocessTimeout < 0 {
+		return fmt.Errorf("adapter timeouts must be >= 0, got check %v, report %v, quota %v, preprocess %v",
+			a.AdapterCheckTimeout, a.AdapterReportTimeout, a.AdapterQuotaTimeout, a.AdapterPreprocessTimeout)
+	}
+
 	if a.ExpressionEvalCacheSize <= 0 {
 		return fmt.Errorf("expressiion evaluation cache size must be >= 0 and <= 2^31-1, got cache size %d", a.ExpressionEvalCacheSize)
 	}
 
+	if a.CheckCacheMaxEntries < 0 {
+		return fmt.Errorf("check cache max entries must be >= 0 and <= 2^31-1, got %d", a.CheckCacheMaxEntries)
+	}
+
+	if a.RecordFile != "" && (a.RecordSampleRate <= 0 || a.RecordSampleRate > 1) {
+		return fmt.Errorf("record sample rate must be > 0 and <= 1, got sample rate %v", a.RecordSampleRate)
+	}
+
+	if a.RecordMaxSize < 0 || a.RecordMaxDuration < 0 {
+		return fmt.Errorf("record max size and duration must be >= 0, got max size %d, max duration %v",
+			a.RecordMaxSize, a.RecordMaxDuration)
+	}
+
 	return nil
 }
 
@@ -141,10 +191,20 @@ func (a *Args) String() string {
 	b.WriteString(fmt.Sprint("MaxConcurrentStreams: ", a.MaxConcurrentStreams, "\n"))
 	b.WriteString(fmt.Sprint("APIWorkerPoolSize: ", a.APIWorkerPoolSize, "\n"))
 	b.WriteString(fmt.Sprint("AdapterWorkerPoolSize: ", a.AdapterWorkerPoolSize, "\n"))
+	b.WriteString(fmt.Sprint("AdapterCheckTimeout: ", a.AdapterCheckTimeout, "\n"))
+	b.WriteString(fmt.Sprint("AdapterReportTimeout: ", a.AdapterReportTimeout, "\n"))
+	b.WriteString(fmt.Sprint("AdapterQuotaTimeout: ", a.AdapterQuotaTimeout, "\n"))
+	b.WriteString(fmt.Sprint("AdapterPreprocessTimeout: ", a.AdapterPreprocessTimeout, "\n"))
 	b.WriteString(fmt.Sprint("ExpressionEvalCacheSize: ", a.ExpressionEvalCacheSize, "\n"))
+	b.WriteString(fmt.Sprint("CheckCacheMaxEntries: ", a.CheckCacheMaxEntries, "\n"))
+	b.WriteString(fmt.Sprint("RecordFile: ", a.RecordFile, "\n"))
+	b.WriteString(fmt.Sprint("RecordSampleRate: ", a.RecordSampleRate, "\n"))
+	b.WriteString(fmt.Sprint("RecordMaxSize: ", a.RecordMaxSize, "\n"))
+	b.WriteString(fmt.Sprint("RecordMaxDuration: ", a.RecordMaxDuration, "\n"))
 	b.WriteString(fmt.Sprint("APIPort: ", a.APIPort, "\n"))
 	b.WriteString(fmt.Sprint("MonitoringPort: ", a.MonitoringPort, "\n"))
 	b.WriteString(fmt.Sprint("SingleThreaded: ", a.SingleThreaded, "\n"))
+	b.WriteString(fmt.Sprint("EnableDebugRules: ", a.EnableDebugRules, "\n"))
 	b.WriteString(fmt.Sprint("ConfigStoreURL: ", a.ConfigStoreURL, "\n"))
 	b.WriteString(fmt.Sprint("ConfigDefaultNamespace: ", a.ConfigDefaultNamespace, "\n"))
 	b.WriteString(fmt.Sprint("ConfigIdentityAttribute: ", a.ConfigIdentityAttribute, "\n"))
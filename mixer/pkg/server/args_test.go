@@ -16,6 +16,7 @@ package server
 
 import (
 	"testing"
+	"time"
 )
 
 func TestValidation(t *testing.T) {
@@ -41,6 +42,31 @@ func TestValidation(t *testing.T) {
 	if err := a.validate(); err == nil {
 		t.Errorf("Got unexpected success")
 	}
+
+	a = NewArgs()
+	a.CheckCacheMaxEntries = -1
+	if err := a.validate(); err == nil {
+		t.Errorf("Got unexpected success")
+	}
+
+	a = NewArgs()
+	a.RecordFile = "requests.rec"
+	a.RecordSampleRate = 0
+	if err := a.validate(); err == nil {
+		t.Errorf("Got unexpected success")
+	}
+
+	a = NewArgs()
+	a.RecordMaxDuration = -time.Second
+	if err := a.validate(); err == nil {
+		t.Errorf("Got unexpected success")
+	}
+
+	a = NewArgs()
+	a.AdapterQuotaTimeout = -time.Second
+	if err := a.validate(); err == nil {
+		t.Errorf("Got unexpected success")
+	}
 }
 
 func TestString(t *testing.T) {
@@ -15,19 +15,27 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	mixerpb "istio.io/api/mixer/v1"
+	"istio.io/istio/mixer/pkg/attribute"
+	mixerRuntime "istio.io/istio/mixer/pkg/runtime"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/version"
 )
 
 type monitor struct {
 	monitoringServer *http.Server
+	mux              *http.ServeMux
 	// This channel is closed after the server stops serving requests.
 	closed chan struct{}
 }
@@ -35,6 +43,11 @@ type monitor struct {
 const (
 	metricsPath = "/metrics"
 	versionPath = "/version"
+
+	// debugRulesPath is the path of the endpoint which explains how the rules apply to the attributes
+	// posted to it, encoded as a mixerpb.CompressedAttributes message. If the "dispatch" query parameter
+	// is true, the check instances are also dispatched to the handlers.
+	debugRulesPath = "/debug/rules"
 )
 
 func startMonitor(port uint16) (*monitor, error) {
@@ -61,6 +74,7 @@ func startMonitor(port uint16) (*monitor, error) {
 		}
 	})
 
+	m.mux = mux
 	m.monitoringServer = &http.Server{
 		Handler: mux,
 	}
@@ -73,6 +87,49 @@ func startMonitor(port uint16) (*monitor, error) {
 	return m, nil
 }
 
+// enableDebug exposes the debugging endpoints of the dispatcher.
+func (m *monitor) enableDebug(d mixerRuntime.Debugger) {
+	m.mux.HandleFunc(debugRulesPath, func(out http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(out, "the attributes must be posted", http.StatusMethodNotAllowed)
+			return
+		}
+		dispatch, _ := strconv.ParseBool(req.URL.Query().Get("dispatch"))
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(out, fmt.Sprintf("unable to read the attributes: %v", err), http.StatusBadRequest)
+			return
+		}
+		var attrs mixerpb.CompressedAttributes
+		if err = proto.Unmarshal(body, &attrs); err != nil {
+			http.Error(out, fmt.Sprintf("unable to decode the attributes: %v", err), http.StatusBadRequest)
+			return
+		}
+		bag, err := attribute.GetBagFromProto(&attrs, nil)
+		if err != nil {
+			http.Error(out, fmt.Sprintf("unable to decode the attributes: %v", err), http.StatusBadRequest)
+			return
+		}
+		defer bag.Done()
+
+		info, err := d.Debug(req.Context(), bag, dispatch)
+		if err != nil {
+			http.Error(out, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		b, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			http.Error(out, fmt.Sprintf("unable to encode the result: %v", err), http.StatusInternalServerError)
+			return
+		}
+		out.Header().Set("Content-Type", "application/json")
+		if _, err = out.Write(b); err != nil {
+			log.Errorf("Unable to write the debug info: %v", err)
+		}
+	})
+}
+
 func (m *monitor) Close() error {
 	var err error
 
@@ -19,6 +19,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -27,13 +28,17 @@ import (
 	"google.golang.org/grpc"
 
 	mixerpb "istio.io/api/mixer/v1"
+	adptTmpl "istio.io/api/mixer/v1/template"
 	"istio.io/istio/mixer/pkg/adapter"
 	"istio.io/istio/mixer/pkg/api"
+	"istio.io/istio/mixer/pkg/attribute"
+	"istio.io/istio/mixer/pkg/checkcache"
 	"istio.io/istio/mixer/pkg/config"
 	"istio.io/istio/mixer/pkg/config/store"
 	"istio.io/istio/mixer/pkg/expr"
 	"istio.io/istio/mixer/pkg/il/evaluator"
 	"istio.io/istio/mixer/pkg/pool"
+	"istio.io/istio/mixer/pkg/recording"
 	mixerRuntime "istio.io/istio/mixer/pkg/runtime"
 	"istio.io/istio/mixer/pkg/template"
 	"istio.io/istio/pkg/log"
@@ -51,6 +56,7 @@ type Server struct {
 	monitor   *monitor
 	tracer    io.Closer
 	configDir string
+	recorder  *recording.Recorder
 
 	dispatcher mixerRuntime.Dispatcher
 
@@ -175,11 +181,49 @@ func newServer(a *Args, p *patchTable) (*Server, error) {
 		return nil, fmt.Errorf("unable to create runtime dispatcherForTesting: %v", err)
 	}
 	s.dispatcher = dispatcher
+	if t, ok := dispatcher.(mixerRuntime.TimeoutSetter); ok {
+		t.SetDefaultTimeouts(map[adptTmpl.TemplateVariety]time.Duration{
+			adptTmpl.TEMPLATE_VARIETY_CHECK:               a.AdapterCheckTimeout,
+			adptTmpl.TEMPLATE_VARIETY_REPORT:              a.AdapterReportTimeout,
+			adptTmpl.TEMPLATE_VARIETY_QUOTA:               a.AdapterQuotaTimeout,
+			adptTmpl.TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR: a.AdapterPreprocessTimeout,
+		})
+	}
+	if a.EnableDebugRules {
+		if d, ok := dispatcher.(mixerRuntime.Debugger); ok && s.monitor.mux != nil {
+			s.monitor.enableDebug(d)
+		} else {
+			log.Warn("Rules debugging disabled, the dispatcher does not support it")
+		}
+	}
 
 	// get the grpc server wired up
 	grpc.EnableTracing = a.EnableGRPCTracing
 	s.server = grpc.NewServer(grpcOptions...)
-	mixerpb.RegisterMixerServer(s.server, api.NewGRPCServer(dispatcher, s.gp))
+
+	var checkCache *checkcache.Cache
+	if a.CheckCacheMaxEntries > 0 {
+		// the cache must be invalidated on config changes, which only dispatchers notifying of
+		// resolver changes allow.
+		if n, ok := dispatcher.(mixerRuntime.ResolverChangeNotifier); ok {
+			checkCache = checkcache.New(a.CheckCacheMaxEntries)
+			n.AddResolverChangeListener(checkCache)
+		} else {
+			log.Warn("Check cache disabled, the dispatcher does not notify of resolver changes")
+		}
+	}
+
+	if a.RecordFile != "" {
+		if s.recorder, err = recording.NewFile(a.RecordFile, recording.Options{
+			SampleRate:  a.RecordSampleRate,
+			MaxSize:     a.RecordMaxSize,
+			MaxDuration: a.RecordMaxDuration,
+		}, attribute.GlobalList()); err != nil {
+			_ = s.Close()
+			return nil, fmt.Errorf("unable to record requests: %v", err)
+		}
+	}
+	mixerpb.RegisterMixerServer(s.server, api.NewGRPCServer(dispatcher, s.gp, checkCache, s.recorder))
 
 	if a.LivenessProbeOptions.IsValid() {
 		s.livenessProbe = probe.NewFileController(a.LivenessProbeOptions)
@@ -245,6 +289,10 @@ func (s *Server) Close() error {
 		_ = s.gp.Close()
 	}
 
+	if s.recorder != nil {
+		_ = s.recorder.Close()
+	}
+
 	if s.adapterGP != nil {
 		_ = s.adapterGP.Close()
 	}
@@ -0,0 +1,83 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package apikey
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'apikey' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+	var err error
+	msg.Api = inst.Api
+	msg.ApiVersion = inst.ApiVersion
+	msg.ApiOperation = inst.ApiOperation
+	msg.ApiKey = inst.ApiKey
+	if msg.Timestamp, err = remote.EncodeValue(inst.Timestamp); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'apikey' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+	var err error
+	inst.Api = msg.Api
+	inst.ApiVersion = msg.ApiVersion
+	inst.ApiOperation = msg.ApiOperation
+	inst.ApiKey = msg.ApiKey
+	if inst.Timestamp, err = remote.DecodeTimestamp(msg.Timestamp); err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+type handleApiKeyServer struct {
+	handler Handler
+}
+
+// NewHandleApiKeyServiceServer returns a HandleApiKeyService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleApiKeyServiceServer(handler Handler) HandleApiKeyServiceServer {
+	return &handleApiKeyServer{handler: handler}
+}
+
+// HandleApiKey dispatches the instance of the request to the handler.
+func (s *handleApiKeyServer) HandleApiKey(ctx context.Context, req *HandleApiKeyRequest) (*remote.CheckResult, error) {
+	inst, err := DecodeInstance(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.handler.HandleApiKey(ctx, inst)
+	if err != nil {
+		return nil, err
+	}
+	return remote.EncodeCheckResult(result), nil
+}
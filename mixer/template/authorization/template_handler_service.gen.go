@@ -0,0 +1,161 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package authorization
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'authorization' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+	var err error
+	if msg.Subject, err = encodeSubject(inst.Subject); err != nil {
+		return nil, err
+	}
+	if msg.Action, err = encodeAction(inst.Action); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'authorization' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+	var err error
+	if inst.Subject, err = decodeSubjectMsg(msg.Subject); err != nil {
+		return nil, err
+	}
+	if inst.Action, err = decodeActionMsg(msg.Action); err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+func encodeSubject(res *Subject) (*SubjectMsg, error) {
+	if res == nil {
+		return nil, nil
+	}
+	msg := &SubjectMsg{}
+	var err error
+	msg.User = res.User
+	msg.Groups = res.Groups
+	if res.Properties != nil {
+		msg.Properties = make(map[string]*remote.Value, len(res.Properties))
+		for k, v := range res.Properties {
+			if msg.Properties[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msg, nil
+}
+
+func decodeSubjectMsg(msg *SubjectMsg) (*Subject, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	res := &Subject{}
+	var err error
+	res.User = msg.User
+	res.Groups = msg.Groups
+	if msg.Properties != nil {
+		res.Properties = make(map[string]interface{}, len(msg.Properties))
+		for k, v := range msg.Properties {
+			if res.Properties[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func encodeAction(res *Action) (*ActionMsg, error) {
+	if res == nil {
+		return nil, nil
+	}
+	msg := &ActionMsg{}
+	var err error
+	msg.Namespace = res.Namespace
+	msg.Service = res.Service
+	msg.Method = res.Method
+	msg.Path = res.Path
+	if res.Properties != nil {
+		msg.Properties = make(map[string]*remote.Value, len(res.Properties))
+		for k, v := range res.Properties {
+			if msg.Properties[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msg, nil
+}
+
+func decodeActionMsg(msg *ActionMsg) (*Action, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	res := &Action{}
+	var err error
+	res.Namespace = msg.Namespace
+	res.Service = msg.Service
+	res.Method = msg.Method
+	res.Path = msg.Path
+	if msg.Properties != nil {
+		res.Properties = make(map[string]interface{}, len(msg.Properties))
+		for k, v := range msg.Properties {
+			if res.Properties[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+type handleAuthorizationServer struct {
+	handler Handler
+}
+
+// NewHandleAuthorizationServiceServer returns a HandleAuthorizationService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleAuthorizationServiceServer(handler Handler) HandleAuthorizationServiceServer {
+	return &handleAuthorizationServer{handler: handler}
+}
+
+// HandleAuthorization dispatches the instance of the request to the handler.
+func (s *handleAuthorizationServer) HandleAuthorization(ctx context.Context, req *HandleAuthorizationRequest) (*remote.CheckResult, error) {
+	inst, err := DecodeInstance(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.handler.HandleAuthorization(ctx, inst)
+	if err != nil {
+		return nil, err
+	}
+	return remote.EncodeCheckResult(result), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package checknothing
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'checknothing' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'checknothing' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+
+	return inst, nil
+}
+
+type handleCheckNothingServer struct {
+	handler Handler
+}
+
+// NewHandleCheckNothingServiceServer returns a HandleCheckNothingService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleCheckNothingServiceServer(handler Handler) HandleCheckNothingServiceServer {
+	return &handleCheckNothingServer{handler: handler}
+}
+
+// HandleCheckNothing dispatches the instance of the request to the handler.
+func (s *handleCheckNothingServer) HandleCheckNothing(ctx context.Context, req *HandleCheckNothingRequest) (*remote.CheckResult, error) {
+	inst, err := DecodeInstance(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.handler.HandleCheckNothing(ctx, inst)
+	if err != nil {
+		return nil, err
+	}
+	return remote.EncodeCheckResult(result), nil
+}
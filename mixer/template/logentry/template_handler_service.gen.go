@@ -0,0 +1,114 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package logentry
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'logentry' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+	var err error
+	if inst.Variables != nil {
+		msg.Variables = make(map[string]*remote.Value, len(inst.Variables))
+		for k, v := range inst.Variables {
+			if msg.Variables[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if msg.Timestamp, err = remote.EncodeValue(inst.Timestamp); err != nil {
+		return nil, err
+	}
+	msg.Severity = inst.Severity
+	msg.MonitoredResourceType = inst.MonitoredResourceType
+	if inst.MonitoredResourceDimensions != nil {
+		msg.MonitoredResourceDimensions = make(map[string]*remote.Value, len(inst.MonitoredResourceDimensions))
+		for k, v := range inst.MonitoredResourceDimensions {
+			if msg.MonitoredResourceDimensions[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'logentry' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+	var err error
+	if msg.Variables != nil {
+		inst.Variables = make(map[string]interface{}, len(msg.Variables))
+		for k, v := range msg.Variables {
+			if inst.Variables[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if inst.Timestamp, err = remote.DecodeTimestamp(msg.Timestamp); err != nil {
+		return nil, err
+	}
+	inst.Severity = msg.Severity
+	inst.MonitoredResourceType = msg.MonitoredResourceType
+	if msg.MonitoredResourceDimensions != nil {
+		inst.MonitoredResourceDimensions = make(map[string]interface{}, len(msg.MonitoredResourceDimensions))
+		for k, v := range msg.MonitoredResourceDimensions {
+			if inst.MonitoredResourceDimensions[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inst, nil
+}
+
+type handleLogEntryServer struct {
+	handler Handler
+}
+
+// NewHandleLogEntryServiceServer returns a HandleLogEntryService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleLogEntryServiceServer(handler Handler) HandleLogEntryServiceServer {
+	return &handleLogEntryServer{handler: handler}
+}
+
+// HandleLogEntry dispatches the instances of the request to the handler.
+func (s *handleLogEntryServer) HandleLogEntry(ctx context.Context, req *HandleLogEntryRequest) (*remote.ReportResult, error) {
+	instances := make([]*Instance, 0, len(req.Instances))
+	for _, msg := range req.Instances {
+		inst, err := DecodeInstance(msg)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+	if err := s.handler.HandleLogEntry(ctx, instances); err != nil {
+		return nil, err
+	}
+	return &remote.ReportResult{}, nil
+}
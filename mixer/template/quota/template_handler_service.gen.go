@@ -0,0 +1,85 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package quota
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'quota' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+	var err error
+	if inst.Dimensions != nil {
+		msg.Dimensions = make(map[string]*remote.Value, len(inst.Dimensions))
+		for k, v := range inst.Dimensions {
+			if msg.Dimensions[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'quota' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+	var err error
+	if msg.Dimensions != nil {
+		inst.Dimensions = make(map[string]interface{}, len(msg.Dimensions))
+		for k, v := range msg.Dimensions {
+			if inst.Dimensions[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inst, nil
+}
+
+type handleQuotaServer struct {
+	handler Handler
+}
+
+// NewHandleQuotaServiceServer returns a HandleQuotaService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleQuotaServiceServer(handler Handler) HandleQuotaServiceServer {
+	return &handleQuotaServer{handler: handler}
+}
+
+// HandleQuota dispatches the instance and the quota arguments of the request to the handler.
+func (s *handleQuotaServer) HandleQuota(ctx context.Context, req *HandleQuotaRequest) (*remote.QuotaResult, error) {
+	inst, err := DecodeInstance(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.handler.HandleQuota(ctx, inst, remote.DecodeQuotaArgs(req.QuotaArgs))
+	if err != nil {
+		return nil, err
+	}
+	return remote.EncodeQuotaResult(result), nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package reportnothing
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'reportnothing' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'reportnothing' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+
+	return inst, nil
+}
+
+type handleReportNothingServer struct {
+	handler Handler
+}
+
+// NewHandleReportNothingServiceServer returns a HandleReportNothingService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleReportNothingServiceServer(handler Handler) HandleReportNothingServiceServer {
+	return &handleReportNothingServer{handler: handler}
+}
+
+// HandleReportNothing dispatches the instances of the request to the handler.
+func (s *handleReportNothingServer) HandleReportNothing(ctx context.Context, req *HandleReportNothingRequest) (*remote.ReportResult, error) {
+	instances := make([]*Instance, 0, len(req.Instances))
+	for _, msg := range req.Instances {
+		inst, err := DecodeInstance(msg)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+	if err := s.handler.HandleReportNothing(ctx, instances); err != nil {
+		return nil, err
+	}
+	return &remote.ReportResult{}, nil
+}
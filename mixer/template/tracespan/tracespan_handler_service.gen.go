@@ -0,0 +1,108 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package tracespan
+
+import (
+	"context"
+
+	"istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the 'tracespan' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+	if inst == nil {
+		return nil, nil
+	}
+	msg := &InstanceMsg{Name: inst.Name}
+	var err error
+	msg.TraceId = inst.TraceId
+	msg.SpanId = inst.SpanId
+	msg.ParentSpanId = inst.ParentSpanId
+	msg.SpanName = inst.SpanName
+	if msg.StartTime, err = remote.EncodeValue(inst.StartTime); err != nil {
+		return nil, err
+	}
+	if msg.EndTime, err = remote.EncodeValue(inst.EndTime); err != nil {
+		return nil, err
+	}
+	if inst.SpanTags != nil {
+		msg.SpanTags = make(map[string]*remote.Value, len(inst.SpanTags))
+		for k, v := range inst.SpanTags {
+			if msg.SpanTags[k], err = remote.EncodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the 'tracespan' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	inst := &Instance{Name: msg.Name}
+	var err error
+	inst.TraceId = msg.TraceId
+	inst.SpanId = msg.SpanId
+	inst.ParentSpanId = msg.ParentSpanId
+	inst.SpanName = msg.SpanName
+	if inst.StartTime, err = remote.DecodeTimestamp(msg.StartTime); err != nil {
+		return nil, err
+	}
+	if inst.EndTime, err = remote.DecodeTimestamp(msg.EndTime); err != nil {
+		return nil, err
+	}
+	if msg.SpanTags != nil {
+		inst.SpanTags = make(map[string]interface{}, len(msg.SpanTags))
+		for k, v := range msg.SpanTags {
+			if inst.SpanTags[k], err = remote.DecodeValue(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inst, nil
+}
+
+type handleTraceSpanServer struct {
+	handler Handler
+}
+
+// NewHandleTraceSpanServiceServer returns a HandleTraceSpanService that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandleTraceSpanServiceServer(handler Handler) HandleTraceSpanServiceServer {
+	return &handleTraceSpanServer{handler: handler}
+}
+
+// HandleTraceSpan dispatches the instances of the request to the handler.
+func (s *handleTraceSpanServer) HandleTraceSpan(ctx context.Context, req *HandleTraceSpanRequest) (*remote.ReportResult, error) {
+	instances := make([]*Instance, 0, len(req.Instances))
+	for _, msg := range req.Instances {
+		inst, err := DecodeInstance(msg)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+	if err := s.handler.HandleTraceSpan(ctx, instances); err != nil {
+		return nil, err
+	}
+	return &remote.ReportResult{}, nil
+}
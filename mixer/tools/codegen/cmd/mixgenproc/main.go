@@ -28,6 +28,8 @@ import (
 func withArgs(args []string, errorf func(format string, a ...interface{})) {
 	var outInterfaceFile string
 	var oAugmentedTmplFile string
+	var outServiceProtoFile string
+	var outServiceGoFile string
 	var mappings []string
 
 	rootCmd := cobra.Command{
@@ -56,13 +58,29 @@ func withArgs(args []string, errorf func(format string, a ...interface{})) {
 			if err != nil {
 				errorf("Invalid path %s: %v", oAugmentedTmplFile, err)
 			}
+			if outServiceProtoFile != "" {
+				if outServiceProtoFile, err = filepath.Abs(outServiceProtoFile); err != nil {
+					errorf("Invalid path %s: %v", outServiceProtoFile, err)
+				}
+			}
+			if outServiceGoFile != "" {
+				if outServiceGoFile, err = filepath.Abs(outServiceGoFile); err != nil {
+					errorf("Invalid path %s: %v", outServiceGoFile, err)
+				}
+			}
 			importMapping := make(map[string]string)
 			for _, maps := range mappings {
 				m := strings.Split(maps, ":")
 				importMapping[strings.TrimSpace(m[0])] = strings.TrimSpace(m[1])
 			}
 
-			generator := interfacegen.Generator{OutInterfacePath: outInterfaceFile, OAugmentedTmplPath: oAugmentedTmplFile, ImptMap: importMapping}
+			generator := interfacegen.Generator{
+				OutInterfacePath:    outInterfaceFile,
+				OAugmentedTmplPath:  oAugmentedTmplFile,
+				OutServiceProtoPath: outServiceProtoFile,
+				OutServiceGoPath:    outServiceGoFile,
+				ImptMap:             importMapping,
+			}
 			if err := generator.Generate(args[0]); err != nil {
 				errorf("%v", err)
 			}
@@ -77,6 +95,14 @@ func withArgs(args []string, errorf func(format string, a ...interface{})) {
 	rootCmd.PersistentFlags().StringVarP(&oAugmentedTmplFile, "output_template", "t", "./generated_template.proto", "Output "+
 		"path for augmented template file.")
 
+	rootCmd.PersistentFlags().StringVarP(&outServiceProtoFile, "output_service", "s", "", "Output "+
+		"path for the gRPC service through which Mixer dispatches instances to out of process adapters. "+
+		"Not generated for attribute generating templates.")
+
+	rootCmd.PersistentFlags().StringVarP(&outServiceGoFile, "output_service_go", "g", "", "Output "+
+		"path for the Go source file converting instances to the gRPC service messages and implementing "+
+		"the service on top of an adapter handler.")
+
 	rootCmd.PersistentFlags().StringArrayVarP(&mappings, "importmapping",
 		"m", []string{},
 		"colon separated mapping of proto import to Go package names."+
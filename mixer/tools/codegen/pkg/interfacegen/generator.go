@@ -42,10 +42,16 @@ const (
 )
 
 // Generator generates Go interfaces for adapters to implement for a given Template.
+//
+// When OutServiceProtoPath and OutServiceGoPath are set, it also generates the gRPC service
+// through which Mixer dispatches the instances to out of process adapters, along with the
+// conversions between the instances and the service messages.
 type Generator struct {
-	OutInterfacePath   string
-	OAugmentedTmplPath string
-	ImptMap            map[string]string
+	OutInterfacePath    string
+	OAugmentedTmplPath  string
+	OutServiceProtoPath string
+	OutServiceGoPath    string
+	ImptMap             map[string]string
 }
 
 func toProtoMap(k string, v string) string {
@@ -88,6 +94,17 @@ func (g *Generator) Generate(fdsFile string) error {
 		return err
 	}
 
+	var serviceProtoData, serviceGoData []byte
+	genService := hasService(model) && g.OutServiceProtoPath != "" && g.OutServiceGoPath != ""
+	if genService {
+		if serviceProtoData, err = g.getServiceProtoContent(model); err != nil {
+			return err
+		}
+		if serviceGoData, err = g.getServiceGoContent(model); err != nil {
+			return err
+		}
+	}
+
 	// Everything succeeded, now write to the file.
 	f1, err := os.Create(g.OutInterfacePath)
 	if err != nil {
@@ -112,6 +129,26 @@ func (g *Generator) Generate(fdsFile string) error {
 		return err
 	}
 
+	if genService {
+		if err = writeFile(g.OutServiceProtoPath, serviceProtoData); err != nil {
+			return err
+		}
+		return writeFile(g.OutServiceGoPath, serviceGoData)
+	}
+	return nil
+}
+
+func writeFile(path string, data []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }() // nolint: gas
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()           // nolint: gas
+		_ = os.Remove(f.Name()) // nolint: gas
+		return err
+	}
 	return nil
 }
 
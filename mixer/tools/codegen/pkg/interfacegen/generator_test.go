@@ -37,20 +37,27 @@ func TestGenerator_Generate(t *testing.T) {
 	}
 
 	tests := []struct {
-		name, descriptor, wantIntFace, wantProto string
+		name, descriptor, wantIntFace, wantProto, wantServiceProto, wantServiceGo string
 	}{
 		{"Report", "testdata/report/template_proto.descriptor_set",
 			"testdata/report/template_handler.gen.go.golden",
-			"testdata/report/template_instance.proto.golden"},
+			"testdata/report/template_instance.proto.golden",
+			"testdata/report/template_handler_service.proto.golden",
+			"testdata/report/template_handler_service.gen.go.golden"},
 		{"Quota", "testdata/quota/template_proto.descriptor_set",
 			"testdata/quota/template_handler.gen.go.golden",
-			"testdata/quota/template_instance.proto.golden"},
+			"testdata/quota/template_instance.proto.golden",
+			"testdata/quota/template_handler_service.proto.golden",
+			"testdata/quota/template_handler_service.gen.go.golden"},
 		{"Check", "testdata/check/template_proto.descriptor_set",
 			"testdata/check/template_handler.gen.go.golden",
-			"testdata/check/template_instance.proto.golden"},
+			"testdata/check/template_instance.proto.golden",
+			"testdata/check/template_handler_service.proto.golden",
+			"testdata/check/template_handler_service.gen.go.golden"},
 		{"APA", "testdata/apa/template_proto.descriptor_set",
 			"testdata/apa/template_handler.gen.go.golden",
-			"testdata/apa/template_instance.proto.golden"},
+			"testdata/apa/template_instance.proto.golden",
+			"", ""},
 	}
 	for _, v := range tests {
 		t.Run(v.name, func(t *testing.T) {
@@ -62,6 +69,12 @@ func TestGenerator_Generate(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
+			tmpDir, err := ioutil.TempDir("", "interfacegen")
+			if err != nil {
+				t.Fatal(err)
+			}
+			oServiceProto := path.Join(tmpDir, "template_handler_service.proto")
+			oServiceGo := path.Join(tmpDir, "template_handler_service.gen.go")
 
 			defer func() {
 				if !t.Failed() {
@@ -71,10 +84,19 @@ func TestGenerator_Generate(t *testing.T) {
 					if removeErr := os.Remove(oTmpl.Name()); removeErr != nil {
 						t.Logf("Could not remove temporary file %s: %v", oTmpl.Name(), removeErr)
 					}
+					if removeErr := os.RemoveAll(tmpDir); removeErr != nil {
+						t.Logf("Could not remove temporary directory %s: %v", tmpDir, removeErr)
+					}
 				}
 			}()
 
-			g := Generator{OutInterfacePath: oIntface.Name(), OAugmentedTmplPath: oTmpl.Name(), ImptMap: importmap}
+			g := Generator{
+				OutInterfacePath:    oIntface.Name(),
+				OAugmentedTmplPath:  oTmpl.Name(),
+				OutServiceProtoPath: oServiceProto,
+				OutServiceGoPath:    oServiceGo,
+				ImptMap:             importmap,
+			}
 
 			if err := g.Generate(v.descriptor); err != nil {
 				t.Fatalf("Generate(%s) produced an error: %v", v.descriptor, err)
@@ -87,6 +109,24 @@ func TestGenerator_Generate(t *testing.T) {
 			if same := fileCompare(oTmpl.Name(), v.wantProto, t.Errorf, true); !same {
 				t.Errorf("File %s does not match baseline %s.", oTmpl.Name(), v.wantProto)
 			}
+
+			if v.wantServiceProto == "" {
+				// Attribute generating templates have no handler service.
+				for _, f := range []string{oServiceProto, oServiceGo} {
+					if _, err := os.Stat(f); !os.IsNotExist(err) {
+						t.Errorf("Generate(%s) should not have generated %s", v.descriptor, f)
+					}
+				}
+				return
+			}
+
+			if same := fileCompare(oServiceProto, v.wantServiceProto, t.Errorf, true); !same {
+				t.Errorf("File %s does not match baseline %s.", oServiceProto, v.wantServiceProto)
+			}
+
+			if same := fileCompare(oServiceGo, v.wantServiceGo, t.Errorf, false); !same {
+				t.Errorf("File %s does not match baseline %s.", oServiceGo, v.wantServiceGo)
+			}
 		})
 	}
 }
@@ -0,0 +1,229 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interfacegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+	"text/template"
+
+	tmpl "istio.io/istio/mixer/tools/codegen/pkg/interfacegen/template"
+	"istio.io/istio/mixer/tools/codegen/pkg/modelgen"
+)
+
+const (
+	resourceMsgServiceSuffix = "Msg"
+	remoteValueProtoName     = "istio.mixer.adapter.remote.v1.Value"
+	remoteValueGoName        = "*remote.Value"
+	remoteEncodeValue        = "remote.EncodeValue"
+	remoteDecodeValue        = "remote.DecodeValue"
+	attributeGeneratorTmpl   = "TEMPLATE_VARIETY_ATTRIBUTE_GENERATOR"
+)
+
+// Decoders of the standard template types, which are sent to the adapters as remote values.
+var standardTypeDecoders = map[string]string{
+	"istio.mixer.v1.template.Duration":     "remote.DecodeDuration",
+	"istio.mixer.v1.template.TimeStamp":    "remote.DecodeTimestamp",
+	"istio.mixer.v1.template.IPAddress":    "remote.DecodeIPAddress",
+	"istio.mixer.v1.template.DNSName":      "remote.DecodeDNSName",
+	"istio.mixer.v1.template.EmailAddress": "remote.DecodeEmailAddress",
+	"istio.mixer.v1.template.Uri":          "remote.DecodeURI",
+}
+
+// hasService tells whether the handler service of the template is generated. Mixer only
+// dispatches the instances of the check, report and quota templates to remote adapters.
+func hasService(model *modelgen.Model) bool {
+	return model.VarietyName != attributeGeneratorTmpl
+}
+
+// elemType returns the type of the elements of a repeated field.
+func elemType(ti modelgen.TypeInfo) modelgen.TypeInfo {
+	if ti.IsRepeated {
+		ti.IsRepeated = false
+		ti.Name = strings.TrimPrefix(strings.TrimPrefix(ti.Name, "repeated "), "[]")
+	}
+	return ti
+}
+
+// fieldCodec describes how the values of a field are converted between an instance and its message.
+type fieldCodec struct {
+	// The conversion functions, empty if the values are copied as is.
+	encode, decode string
+	// The element types of the message and instance fields.
+	msgType, goType string
+}
+
+type serviceGenerator struct {
+	trimPackageName func(string) string
+}
+
+func newServiceGenerator(model *modelgen.Model) *serviceGenerator {
+	re := regexp.MustCompile(`(?i)` + model.PackageName + "\\.")
+	return &serviceGenerator{
+		trimPackageName: func(fullName string) string { return re.ReplaceAllString(fullName, "") },
+	}
+}
+
+// codec returns the codec of a non repeated, non map type.
+func (s *serviceGenerator) codec(protoType, goType modelgen.TypeInfo) fieldCodec {
+	goName := strings.Replace(goType.Name, fullGoNameOfValueTypeEnum, "interface{}", 1)
+	switch {
+	case protoType.IsValueType:
+		return fieldCodec{
+			encode:  remoteEncodeValue,
+			decode:  remoteDecodeValue,
+			msgType: remoteValueGoName,
+			goType:  goName,
+		}
+	case protoType.IsResourceMessage:
+		name := s.trimPackageName(protoType.Name)
+		return fieldCodec{
+			encode:  "encode" + name,
+			decode:  "decode" + name + resourceMsgServiceSuffix,
+			msgType: "*" + name + resourceMsgServiceSuffix,
+			goType:  goName,
+		}
+	}
+	if decode, ok := standardTypeDecoders[protoType.Name]; ok {
+		return fieldCodec{encode: remoteEncodeValue, decode: decode, msgType: remoteValueGoName, goType: goName}
+	}
+	return fieldCodec{msgType: goName, goType: goName}
+}
+
+func (s *serviceGenerator) messageFieldType(protoType modelgen.TypeInfo) string {
+	elem := func(ti modelgen.TypeInfo) string {
+		switch {
+		case ti.IsValueType:
+			return remoteValueProtoName
+		case ti.IsResourceMessage:
+			return s.trimPackageName(ti.Name) + resourceMsgServiceSuffix
+		}
+		if _, ok := standardTypeDecoders[ti.Name]; ok {
+			return remoteValueProtoName
+		}
+		return ti.Name
+	}
+	switch {
+	case protoType.IsMap:
+		return toProtoMap(protoType.MapKey.Name, elem(*protoType.MapValue))
+	case protoType.IsRepeated:
+		return "repeated " + elem(elemType(protoType))
+	}
+	return elem(protoType)
+}
+
+// convertFields returns the statements converting the fields of src into the fields of dst.
+func (s *serviceGenerator) convertFields(src, dst string, fields []modelgen.FieldInfo, encode bool) string {
+	var b bytes.Buffer
+	usesErr := false
+	for _, f := range fields {
+		from, to := src+"."+f.GoName, dst+"."+f.GoName
+		var c fieldCodec
+		var container string
+		switch {
+		case f.ProtoType.IsMap:
+			c = s.codec(*f.ProtoType.MapValue, *f.GoType.MapValue)
+			container = "map[" + f.GoType.MapKey.Name + "]"
+		case f.ProtoType.IsRepeated:
+			c = s.codec(elemType(f.ProtoType), elemType(f.GoType))
+			container = "[]"
+		default:
+			c = s.codec(f.ProtoType, f.GoType)
+		}
+		fn, toType := c.encode, c.msgType
+		if !encode {
+			fn, toType = c.decode, c.goType
+		}
+
+		switch {
+		case fn == "":
+			fmt.Fprintf(&b, "%s = %s\n", to, from)
+		case container == "":
+			fmt.Fprintf(&b, "if %s, err = %s(%s); err != nil {\nreturn nil, err\n}\n", to, fn, from)
+		default:
+			fmt.Fprintf(&b, "if %s != nil {\n%s = make(%s%s, len(%s))\n", from, to, container, toType, from)
+			fmt.Fprintf(&b, "for k, v := range %s {\n", from)
+			fmt.Fprintf(&b, "if %s[k], err = %s(v); err != nil {\nreturn nil, err\n}\n}\n}\n", to, fn)
+		}
+		usesErr = usesErr || fn != ""
+	}
+	stmts := strings.TrimSuffix(b.String(), "\n")
+	if usesErr {
+		return "var err error\n" + stmts
+	}
+	return stmts
+}
+
+// comment indents the lines of a comment and trims their trailing spaces.
+func comment(indent, c string) string {
+	lines := strings.Split(c, "\n")
+	for i, l := range lines {
+		lines[i] = indent + strings.TrimRight(l, " \t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (g *Generator) getServiceProtoContent(model *modelgen.Model) ([]byte, error) {
+	s := newServiceGenerator(model)
+	serviceProtoTmpl, err := template.New("ServiceProtoTmpl").Funcs(
+		template.FuncMap{
+			"messageFieldType": s.messageFieldType,
+			"comment":          comment,
+			"getResourceMessageName": func(name string) string {
+				return name + resourceMsgServiceSuffix
+			},
+		}).Parse(tmpl.ServiceProtoTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load template: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = serviceProtoTmpl.Execute(buf, model); err != nil {
+		return nil, fmt.Errorf("cannot execute the template with the given data: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *Generator) getServiceGoContent(model *modelgen.Model) ([]byte, error) {
+	s := newServiceGenerator(model)
+	serviceTmpl, err := template.New("ServiceTmpl").Funcs(
+		template.FuncMap{
+			"encodeFields": func(src, dst string, fields []modelgen.FieldInfo) string {
+				return s.convertFields(src, dst, fields, true)
+			},
+			"decodeFields": func(src, dst string, fields []modelgen.FieldInfo) string {
+				return s.convertFields(src, dst, fields, false)
+			},
+			"getResourceMessageName": func(name string) string {
+				return name + resourceMsgServiceSuffix
+			},
+		}).Parse(tmpl.ServiceTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load template: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = serviceTmpl.Execute(buf, model); err != nil {
+		return nil, fmt.Errorf("cannot execute the template with the given data: %v", err)
+	}
+	fmtd, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not format generated code: %v : %s", err, buf.String())
+	}
+	return fmtd, nil
+}
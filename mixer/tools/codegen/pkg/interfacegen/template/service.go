@@ -0,0 +1,205 @@
+package template
+
+// ServiceProtoTemplate defines the template used to generate the gRPC service through
+// which Mixer dispatches the instances of a given template to out of process adapters.
+var ServiceProtoTemplate = `// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+syntax = "proto3";
+
+package {{.PackageName}};
+
+import "mixer/pkg/adapter/remote/remote.proto";
+
+option go_package = "{{.GoPackageName}}";
+
+// Handle{{.InterfaceName}}Service is implemented by the out of process adapters that handle
+// the '{{.TemplateName}}' template.
+service Handle{{.InterfaceName}}Service {
+  // Handle{{.InterfaceName}} is called by Mixer at request time to deliver instances
+  // to the adapter.
+  {{if eq .VarietyName "TEMPLATE_VARIETY_CHECK" -}}
+  rpc Handle{{.InterfaceName}}(Handle{{.InterfaceName}}Request) returns (istio.mixer.adapter.remote.v1.CheckResult);
+  {{- else if eq .VarietyName "TEMPLATE_VARIETY_QUOTA" -}}
+  rpc Handle{{.InterfaceName}}(Handle{{.InterfaceName}}Request) returns (istio.mixer.adapter.remote.v1.QuotaResult);
+  {{- else -}}
+  rpc Handle{{.InterfaceName}}(Handle{{.InterfaceName}}Request) returns (istio.mixer.adapter.remote.v1.ReportResult);
+  {{- end}}
+}
+
+// Request message for the Handle{{.InterfaceName}} method.
+message Handle{{.InterfaceName}}Request {
+{{- if eq .VarietyName "TEMPLATE_VARIETY_REPORT"}}
+  // The instances to handle.
+  repeated InstanceMsg instances = 1;
+{{- else}}
+  // The instance to handle.
+  InstanceMsg instance = 1;
+{{- end}}
+{{- if eq .VarietyName "TEMPLATE_VARIETY_QUOTA"}}
+
+  // The arguments of the quota allocation.
+  istio.mixer.adapter.remote.v1.QuotaArgs quota_args = 2;
+{{- end}}
+}
+
+// InstanceMsg is the wire representation of the instances of the '{{.TemplateName}}' template.{{if ne .TemplateMessage.Comment ""}}
+//
+{{comment "" .TemplateMessage.Comment}}{{end}}
+message InstanceMsg {
+  // Name of the instance as specified in configuration.
+  string name = 72295727;
+{{range .TemplateMessage.Fields}}
+{{if ne .Comment ""}}{{comment "  " .Comment}}
+{{end}}  {{messageFieldType .ProtoType}} {{.ProtoName}} = {{.Number}};
+{{end -}}
+}
+{{range .ResourceMessages}}
+{{if ne .Comment ""}}{{comment "" .Comment}}
+{{end}}message {{getResourceMessageName .Name}} {
+{{- range $i, $f := .Fields}}
+{{if $i}}
+{{end}}{{if ne $f.Comment ""}}{{comment "  " $f.Comment}}
+{{end}}  {{messageFieldType $f.ProtoType}} {{$f.ProtoName}} = {{$f.Number}};
+{{- end}}
+}
+{{end -}}
+`
+
+// ServiceTemplate defines the template used to generate the conversions between the instances
+// of a given template and their gRPC messages, along with the server side of the gRPC service,
+// which dispatches the instances to an adapter Handler.
+var ServiceTemplate = `// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// THIS FILE IS AUTOMATICALLY GENERATED.
+
+package {{.GoPackageName}}
+
+import (
+  "context"
+
+  "istio.io/istio/mixer/pkg/adapter/remote"
+)
+
+// EncodeInstance converts an instance of the '{{.TemplateName}}' template into the message sent
+// to the out of process adapters.
+func EncodeInstance(inst *Instance) (*InstanceMsg, error) {
+  if inst == nil {
+    return nil, nil
+  }
+  msg := &InstanceMsg{Name: inst.Name}
+  {{encodeFields "inst" "msg" .TemplateMessage.Fields}}
+  return msg, nil
+}
+
+// DecodeInstance converts the message received by an out of process adapter back into an
+// instance of the '{{.TemplateName}}' template.
+func DecodeInstance(msg *InstanceMsg) (*Instance, error) {
+  if msg == nil {
+    return nil, nil
+  }
+  inst := &Instance{Name: msg.Name}
+  {{decodeFields "msg" "inst" .TemplateMessage.Fields}}
+  return inst, nil
+}
+
+{{range .ResourceMessages}}
+func encode{{.Name}}(res *{{.Name}}) (*{{getResourceMessageName .Name}}, error) {
+  if res == nil {
+    return nil, nil
+  }
+  msg := &{{getResourceMessageName .Name}}{}
+  {{encodeFields "res" "msg" .Fields}}
+  return msg, nil
+}
+
+func decode{{getResourceMessageName .Name}}(msg *{{getResourceMessageName .Name}}) (*{{.Name}}, error) {
+  if msg == nil {
+    return nil, nil
+  }
+  res := &{{.Name}}{}
+  {{decodeFields "msg" "res" .Fields}}
+  return res, nil
+}
+{{end}}
+
+type handle{{.InterfaceName}}Server struct {
+  handler Handler
+}
+
+// NewHandle{{.InterfaceName}}ServiceServer returns a Handle{{.InterfaceName}}Service that decodes the
+// instances it receives and dispatches them to the handler, so that the adapters implementing
+// Handler can be deployed out of process.
+func NewHandle{{.InterfaceName}}ServiceServer(handler Handler) Handle{{.InterfaceName}}ServiceServer {
+  return &handle{{.InterfaceName}}Server{handler: handler}
+}
+
+{{if eq .VarietyName "TEMPLATE_VARIETY_CHECK" -}}
+// Handle{{.InterfaceName}} dispatches the instance of the request to the handler.
+func (s *handle{{.InterfaceName}}Server) Handle{{.InterfaceName}}(ctx context.Context, req *Handle{{.InterfaceName}}Request) (*remote.CheckResult, error) {
+  inst, err := DecodeInstance(req.Instance)
+  if err != nil {
+    return nil, err
+  }
+  result, err := s.handler.Handle{{.InterfaceName}}(ctx, inst)
+  if err != nil {
+    return nil, err
+  }
+  return remote.EncodeCheckResult(result), nil
+}
+{{else if eq .VarietyName "TEMPLATE_VARIETY_QUOTA" -}}
+// Handle{{.InterfaceName}} dispatches the instance and the quota arguments of the request to the handler.
+func (s *handle{{.InterfaceName}}Server) Handle{{.InterfaceName}}(ctx context.Context, req *Handle{{.InterfaceName}}Request) (*remote.QuotaResult, error) {
+  inst, err := DecodeInstance(req.Instance)
+  if err != nil {
+    return nil, err
+  }
+  result, err := s.handler.Handle{{.InterfaceName}}(ctx, inst, remote.DecodeQuotaArgs(req.QuotaArgs))
+  if err != nil {
+    return nil, err
+  }
+  return remote.EncodeQuotaResult(result), nil
+}
+{{else -}}
+// Handle{{.InterfaceName}} dispatches the instances of the request to the handler.
+func (s *handle{{.InterfaceName}}Server) Handle{{.InterfaceName}}(ctx context.Context, req *Handle{{.InterfaceName}}Request) (*remote.ReportResult, error) {
+  instances := make([]*Instance, 0, len(req.Instances))
+  for _, msg := range req.Instances {
+    inst, err := DecodeInstance(msg)
+    if err != nil {
+      return nil, err
+    }
+    instances = append(instances, inst)
+  }
+  if err := s.handler.Handle{{.InterfaceName}}(ctx, instances); err != nil {
+    return nil, err
+  }
+  return &remote.ReportResult{}, nil
+}
+{{end}}
+`
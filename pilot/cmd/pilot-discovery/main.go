@@ -26,6 +26,7 @@ import (
 
 	"istio.io/istio/pilot/cmd"
 	"istio.io/istio/pilot/pkg/bootstrap"
+	"istio.io/istio/pilot/pkg/proxy/envoy"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/version"
 )
@@ -81,6 +82,13 @@ func init() {
 		"Use a Kubernetes configuration file instead of in-cluster configuration")
 	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Mesh.ConfigFile, "meshConfig", "/etc/istio/config/mesh",
 		fmt.Sprintf("File name for Istio mesh configuration. If not specified, a default mesh will be used."))
+	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Mesh.CRLFile, "crlFile", "",
+		"Path of the certificate revocation list the proxies check for mutual TLS, e.g. /etc/certs/crl.pem. "+
+			"Revocation is not checked if empty.")
+	discoveryCmd.PersistentFlags().IntVar(&serverArgs.Mesh.LocalityMinHealthyPercent, "localityMinHealthyPercent", 20,
+		"Minimum percentage of the healthy endpoints of a service in the zone of a proxy for the proxy to prefer "+
+			"them, in [0, 100]. Below, the proxy fails over to the endpoints of the other zones of its region, then of other regions. "+
+			"Only applies to the proxies using ADS: the v1 SDS hosts are tagged with their zone, but zone aware routing is not enabled for v1 proxies")
 	discoveryCmd.PersistentFlags().StringVarP(&serverArgs.Namespace, "namespace", "n", "",
 		"Select a namespace where the controller resides. If not set, uses ${POD_NAMESPACE} environment variable")
 
@@ -94,19 +102,31 @@ func init() {
 		"Controller resync interval")
 	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Config.ControllerOptions.DomainSuffix, "domain", "cluster.local",
 		"DNS domain suffix")
+	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Config.ControllerOptions.ClusterID, "clusterID", "",
+		"Identifier of the Kubernetes cluster of the Kubernetes registry, set on its service instances")
+	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Service.ClusterRegistriesDir, "clusterRegistriesDir", "",
+		"Directory of the kubeconfig files of the remote Kubernetes clusters, named after the clusters, "+
+			"whose services are merged with the ones of the Kubernetes registry")
 
 	discoveryCmd.PersistentFlags().IntVar(&serverArgs.DiscoveryOptions.Port, "port", 8080,
 		"Discovery service port")
+	discoveryCmd.PersistentFlags().IntVar(&serverArgs.DiscoveryOptions.GrpcPort, "grpcPort", 15010,
+		"Aggregated discovery service (xDS v2) gRPC port, 0 to disable")
 	discoveryCmd.PersistentFlags().IntVar(&serverArgs.DiscoveryOptions.MonitoringPort, "monitoringPort", 9093,
 		"HTTP port to use for the exposing pilot self-monitoring information")
 	discoveryCmd.PersistentFlags().BoolVar(&serverArgs.DiscoveryOptions.EnableProfiling, "profile", true,
 		"Enable profiling via web interface host:port/debug/pprof")
 	discoveryCmd.PersistentFlags().BoolVar(&serverArgs.DiscoveryOptions.EnableCaching, "discovery_cache", true,
 		"Enable caching discovery service responses")
+	discoveryCmd.PersistentFlags().IntVar(&serverArgs.DiscoveryOptions.CacheMaxEntries, "discoveryCacheMaxEntries",
+		envoy.DefaultCacheMaxEntries, "Maximum number of responses held by each discovery cache")
+	discoveryCmd.PersistentFlags().DurationVar(&serverArgs.DiscoveryOptions.CacheTTL, "discoveryCacheTTL", 5*time.Minute,
+		"Expiration time of cached discovery responses, 0 to disable expiration")
 	// TODO (rshriram): Need v1/v2 endpoints and option to selectively
 	// enable webhook for specific xDS config (cds/lds/etc).
 	discoveryCmd.PersistentFlags().StringVar(&serverArgs.DiscoveryOptions.WebhookEndpoint, "webhookEndpoint", "",
-		"Webhook API endpoint (supports DNS, IP, and unix domain socket.")
+		"Webhook API endpoint (supports DNS, IP, and unix domain socket). "+
+			"It only applies to the v1 REST APIs and requires disabling ADS with --grpcPort=0")
 
 	discoveryCmd.PersistentFlags().StringVar(&serverArgs.Service.Consul.Config, "consulconfig", "",
 		"Consul Config file for discovery")
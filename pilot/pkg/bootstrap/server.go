@@ -69,8 +69,10 @@ var (
 	configDescriptor = model.ConfigDescriptor{
 		model.RouteRule,
 		model.V1alpha2RouteRule,
+		model.Gateway,
 		model.EgressRule,
 		model.DestinationPolicy,
+		model.DestinationRule,
 		model.HTTPAPISpec,
 		model.HTTPAPISpecBinding,
 		model.QuotaSpec,
@@ -86,6 +88,11 @@ type MeshArgs struct {
 	ConfigFile      string
 	MixerAddress    string
 	RdsRefreshDelay *durpb.Duration
+	// CRLFile is the path of the certificate revocation list the proxies check for mutual TLS.
+	CRLFile string
+	// LocalityMinHealthyPercent is the share of the healthy endpoints of a service below which the
+	// proxies fail over to the endpoints of other zones, in [0, 100]. It applies to the ADS proxies.
+	LocalityMinHealthyPercent int
 }
 
 // ConfigArgs provide configuration options for the configuration controller. If FileDir is set, that directory will
@@ -114,6 +121,9 @@ type ServiceArgs struct {
 	Registries []string
 	Consul     ConsulArgs
 	Eureka     EurekaArgs
+	// ClusterRegistriesDir is a directory of kubeconfig files, e.g. a mounted secret, giving access to
+	// the remote clusters whose services are merged with the ones of the Kubernetes registry.
+	ClusterRegistriesDir string
 }
 
 // AdmissionArgs provides configuration options for the admission controller. This is a partial duplicate of
@@ -392,17 +402,26 @@ func (s *Server) initServiceControllers(args *PilotArgs) error {
 				ServiceAccounts:  discovery2,
 				Controller:       &mockController{},
 			}
-			serviceControllers.AddRegistry(registry1)
-			serviceControllers.AddRegistry(registry2)
+			for _, registry := range []aggregate.Registry{registry1, registry2} {
+				if err := serviceControllers.AddRegistry(registry); err != nil {
+					return err
+				}
+			}
 		case KubernetesRegistry:
 			kubectl := kube.NewController(s.kubeClient, args.Config.ControllerOptions)
-			serviceControllers.AddRegistry(
+			if err := serviceControllers.AddRegistry(
 				aggregate.Registry{
 					Name:             serviceregistry.ServiceRegistry(serviceRegistry),
 					ServiceDiscovery: kubectl,
 					ServiceAccounts:  kubectl,
 					Controller:       kubectl,
-				})
+					ClusterID:        args.Config.ControllerOptions.ClusterID,
+				}); err != nil {
+				return err
+			}
+			if args.Service.ClusterRegistriesDir != "" {
+				s.initClusterRegistries(args, serviceControllers)
+			}
 			if s.mesh.IngressControllerMode != meshconfig.MeshConfig_OFF {
 				// Wrap the config controller with a cache.
 				configController, err := configaggregate.MakeCache([]model.ConfigStoreCache{
@@ -428,29 +447,32 @@ func (s *Server) initServiceControllers(args *PilotArgs) error {
 			}
 		case ConsulRegistry:
 			log.Infof("Consul url: %v", args.Service.Consul.ServerURL)
-			conctl, conerr := consul.NewController(
-				args.Service.Consul.ServerURL, 2*time.Second)
+			conctl, conerr := consul.NewController(args.Service.Consul.ServerURL)
 			if conerr != nil {
 				return fmt.Errorf("failed to create Consul controller: %v", conerr)
 			}
-			serviceControllers.AddRegistry(
+			if err := serviceControllers.AddRegistry(
 				aggregate.Registry{
 					Name:             serviceregistry.ServiceRegistry(r),
 					ServiceDiscovery: conctl,
 					ServiceAccounts:  conctl,
 					Controller:       conctl,
-				})
+				}); err != nil {
+				return err
+			}
 		case EurekaRegistry:
 			log.Infof("Eureka url: %v", args.Service.Eureka.ServerURL)
 			eurekaClient := eureka.NewClient(args.Service.Eureka.ServerURL)
-			serviceControllers.AddRegistry(
+			if err := serviceControllers.AddRegistry(
 				aggregate.Registry{
 					Name: serviceregistry.ServiceRegistry(r),
 					// TODO: Remove sync time hardcoding!
 					Controller:       eureka.NewController(eurekaClient, 2*time.Second),
 					ServiceDiscovery: eureka.NewServiceDiscovery(eurekaClient),
 					ServiceAccounts:  eureka.NewServiceAccounts(),
-				})
+				}); err != nil {
+				return err
+			}
 
 		case CloudFoundryRegistry:
 			cfConfig, err := cloudfoundry.LoadConfig(args.Config.CFConfig)
@@ -465,18 +487,25 @@ func (s *Server) initServiceControllers(args *PilotArgs) error {
 			if err != nil {
 				return multierror.Prefix(err, "creating cloud foundry client")
 			}
-			serviceControllers.AddRegistry(aggregate.Registry{
+			healthChecks, err := cfConfig.ParseHealthChecks()
+			if err != nil {
+				return multierror.Prefix(err, "loading cloud foundry config")
+			}
+			if err = serviceControllers.AddRegistry(aggregate.Registry{
 				Name: serviceregistry.ServiceRegistry(r),
 				Controller: &cloudfoundry.Controller{
 					Ticker: cloudfoundry.NewTicker(cfConfig.Copilot.PollInterval),
 					Client: client,
 				},
 				ServiceDiscovery: &cloudfoundry.ServiceDiscovery{
-					Client:      client,
-					ServicePort: cfConfig.ServicePort,
+					Client:       client,
+					ServicePort:  cfConfig.ServicePort,
+					HealthChecks: healthChecks,
 				},
 				ServiceAccounts: cloudfoundry.NewServiceAccounts(),
-			})
+			}); err != nil {
+				return err
+			}
 
 		default:
 			return multierror.Prefix(nil, "Service registry "+r+" is not supported.")
@@ -494,13 +523,59 @@ func (s *Server) initServiceControllers(args *PilotArgs) error {
 	return nil
 }
 
+// initClusterRegistries adds a Kubernetes registry for each remote cluster of the kubeconfig files in
+// the cluster registries directory, which is monitored for the clusters joining and leaving the mesh.
+// A kubeconfig named after the local cluster is skipped, its registry is added by initServiceControllers.
+func (s *Server) initClusterRegistries(args *PilotArgs, serviceControllers *aggregate.Controller) {
+	localClusterID := args.Config.ControllerOptions.ClusterID
+	monitor := kube.NewClusterMonitor(args.Service.ClusterRegistriesDir,
+		func(clusterID, kubeconfig string) error {
+			if clusterID == localClusterID {
+				log.Infof("Skipping the kubeconfig of remote cluster %s, which is the local cluster", clusterID)
+				return nil
+			}
+			_, client, err := kube.CreateInterface(kubeconfig)
+			if err != nil {
+				return err
+			}
+			options := args.Config.ControllerOptions
+			options.ClusterID = clusterID
+			kubectl := kube.NewController(client, options)
+			return serviceControllers.AddRegistry(
+				aggregate.Registry{
+					Name:             serviceregistry.ServiceRegistry(KubernetesRegistry),
+					ServiceDiscovery: kubectl,
+					ServiceAccounts:  kubectl,
+					Controller:       kubectl,
+					ClusterID:        clusterID,
+				})
+		},
+		func(clusterID string) {
+			if clusterID != localClusterID {
+				serviceControllers.DeleteRegistry(clusterID)
+			}
+		})
+
+	s.addStartFunc(func(stop chan struct{}) error {
+		monitor.Start(stop)
+		return nil
+	})
+}
+
 func (s *Server) initDiscoveryService(args *PilotArgs) error {
+	if args.Mesh.LocalityMinHealthyPercent < 0 || args.Mesh.LocalityMinHealthyPercent > 100 {
+		return fmt.Errorf("locality min healthy percent must be in [0, 100], got %d", args.Mesh.LocalityMinHealthyPercent)
+	}
+
 	environment := model.Environment{
 		Mesh:             s.mesh,
 		IstioConfigStore: model.MakeIstioStore(s.configController),
 		ServiceDiscovery: s.serviceController,
 		ServiceAccounts:  s.serviceController,
 		MixerSAN:         s.mixerSAN,
+		CRLFile:          args.Mesh.CRLFile,
+
+		LocalityMinHealthyPercent: args.Mesh.LocalityMinHealthyPercent,
 	}
 
 	// Set up discovery service
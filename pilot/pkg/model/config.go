@@ -241,6 +241,17 @@ type IstioConfigStore interface {
 	// destination instances.
 	RouteRulesByDestination(destination []*ServiceInstance, domain string) []Config
 
+	// RouteRulesByGateway selects v1alpha2 routing rules bound to the gateway with the given
+	// namespace and name. Rules refer to gateways as "namespace/name", or by name alone for
+	// the gateways in the namespace of the rule.
+	RouteRulesByGateway(namespace, name string) []Config
+
+	// Gateways selects the gateways that apply to the proxy co-located with
+	// the service instances.  The labels of a gateway must be a subset of the
+	// labels of at least one of the instances; a gateway without labels
+	// applies to all proxies that accept gateway configuration.
+	Gateways(instances []*ServiceInstance) []Config
+
 	// Policy returns a policy for a service version that match at least one of
 	// the source instances.  The labels must match precisely in the policy.
 	Policy(source []*ServiceInstance, destination string, labels Labels) *Config
@@ -609,6 +620,54 @@ func (store *istioConfigStore) routeRulesByDestinationV2(instances []*ServiceIns
 	return nil
 }
 
+func (store *istioConfigStore) RouteRulesByGateway(namespace, name string) []Config {
+	out := make([]Config, 0)
+	configs, err := store.List(V1alpha2RouteRule.Type, NamespaceAll)
+	if err != nil {
+		return nil
+	}
+
+	qualified := namespace + "/" + name
+	for _, config := range configs {
+		rule := config.Spec.(*routingv2.RouteRule)
+		for _, ref := range rule.Gateways {
+			if ref == qualified || (ref == name && config.Namespace == namespace) {
+				out = append(out, config)
+				break
+			}
+		}
+	}
+
+	// sort for output uniqueness
+	sort.Slice(out, func(i, j int) bool { return out[i].Key() < out[j].Key() })
+	return out
+}
+
+func (store *istioConfigStore) Gateways(instances []*ServiceInstance) []Config {
+	out := make([]Config, 0)
+	configs, err := store.List(Gateway.Type, NamespaceAll)
+	if err != nil {
+		return nil
+	}
+
+	for _, config := range configs {
+		if len(config.Labels) == 0 {
+			out = append(out, config)
+			continue
+		}
+		for _, instance := range instances {
+			if Labels(config.Labels).SubsetOf(instance.Labels) {
+				out = append(out, config)
+				break
+			}
+		}
+	}
+
+	// sort for output uniqueness
+	sort.Slice(out, func(i, j int) bool { return out[i].Key() < out[j].Key() })
+	return out
+}
+
 func (store *istioConfigStore) EgressRules() map[string]*routing.EgressRule {
 	out := make(map[string]*routing.EgressRule)
 	rs, err := store.List(EgressRule.Type, "")
@@ -477,6 +477,73 @@ func TestRouteRules(t *testing.T) {
 	}
 }
 
+func TestGateways(t *testing.T) {
+	instance := mock.MakeInstance(mock.HelloService, mock.PortHTTP, 0, "")
+	store := model.MakeIstioStore(memory.Make(model.IstioConfigTypes))
+
+	gateway := &routingv2.Gateway{
+		Servers: []*routingv2.Server{{
+			Hosts: []string{"*"},
+			Port:  &routingv2.Port{Number: 80, Protocol: "HTTP"},
+		}},
+	}
+	configs := []model.Config{
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.Gateway.Type, Name: "all", Namespace: "default"},
+			Spec:       gateway,
+		},
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.Gateway.Type, Name: "selected", Namespace: "default",
+				Labels: instance.Labels},
+			Spec: gateway,
+		},
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.V1alpha2RouteRule.Type, Name: "bound", Namespace: "default"},
+			Spec:       &routingv2.RouteRule{Hosts: []string{"hello"}, Gateways: []string{"selected"}},
+		},
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.V1alpha2RouteRule.Type, Name: "unbound", Namespace: "default"},
+			Spec:       &routingv2.RouteRule{Hosts: []string{"hello"}},
+		},
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.V1alpha2RouteRule.Type, Name: "other", Namespace: "other"},
+			Spec:       &routingv2.RouteRule{Hosts: []string{"hello"}, Gateways: []string{"selected"}},
+		},
+		{
+			ConfigMeta: model.ConfigMeta{Type: model.V1alpha2RouteRule.Type, Name: "qualified", Namespace: "other"},
+			Spec:       &routingv2.RouteRule{Hosts: []string{"hello"}, Gateways: []string{"default/selected"}},
+		},
+	}
+	for _, config := range configs {
+		if _, err := store.Create(config); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if out := store.Gateways([]*model.ServiceInstance{instance}); len(out) != 2 ||
+		out[0].Name != "all" || out[1].Name != "selected" {
+		t.Errorf("Gateways() => got %v, want all and selected", out)
+	}
+	if out := store.Gateways(nil); len(out) != 1 || out[0].Name != "all" {
+		t.Errorf("Gateways() => got %v, want all", out)
+	}
+	if out := store.RouteRulesByGateway("default", "selected"); len(out) != 2 ||
+		out[0].Name != "bound" || out[1].Name != "qualified" {
+		t.Errorf("RouteRulesByGateway() => got %v, want bound and qualified", out)
+	}
+	if out := store.RouteRulesByGateway("other", "selected"); len(out) != 1 || out[0].Name != "other" {
+		t.Errorf("RouteRulesByGateway() => got %v, want other", out)
+	}
+	if out := store.RouteRulesByGateway("default", "all"); len(out) != 0 {
+		t.Errorf("RouteRulesByGateway() => expected no match, got %v", out)
+	}
+
+	// erroring out list
+	if out := model.MakeIstioStore(errorStore{}).Gateways([]*model.ServiceInstance{instance}); len(out) != 0 {
+		t.Errorf("Gateways() => expected nil but got %v", out)
+	}
+}
+
 func TestEgressRules(t *testing.T) {
 	store := model.MakeIstioStore(memory.Make(model.IstioConfigTypes))
 	rule := &routing.EgressRule{
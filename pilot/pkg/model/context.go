@@ -45,6 +45,15 @@ type Environment struct {
 
 	// Mixer subject alternate name for mutual TLS
 	MixerSAN []string
+
+	// CRLFile is the path of the certificate revocation list checked by the proxies
+	// for mutual TLS. Revocation is not checked if empty.
+	CRLFile string
+
+	// LocalityMinHealthyPercent is the minimum percentage of the healthy endpoints of a service
+	// which must be in the zone (or region) of a proxy for the proxy to prefer them over the
+	// endpoints of other zones, in [0, 100]. It only applies to the proxies using ADS.
+	LocalityMinHealthyPercent int
 }
 
 // Node defines the proxy attributes used by xDS identification
@@ -132,6 +141,9 @@ const (
 	// RootCertFilename is mTLS root cert
 	RootCertFilename = "root-cert.pem"
 
+	// CRLFilename is the certificate revocation list published by Citadel next to the mTLS certs
+	CRLFilename = "crl.pem"
+
 	// IngressCertFilename is the ingress cert file name
 	IngressCertFilename = "tls.crt"
 
@@ -0,0 +1,172 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// HealthCheck declares the active health check of the instances of a service port, which the
+// proxies run in addition to the health checks of the service registry.
+type HealthCheck struct {
+	// Path of the HTTP health check. The instances of ports which do not carry HTTP traffic
+	// are checked for accepting TCP connections.
+	Path string `json:"path,omitempty"`
+
+	// ExpectedStatuses are the HTTP statuses of a healthy instance. Only 200 is expected if empty.
+	// The proxies support a single expected status.
+	ExpectedStatuses []int `json:"expected_statuses,omitempty"`
+
+	// Interval between two checks of an instance.
+	Interval time.Duration `json:"interval"`
+
+	// Timeout of a check.
+	Timeout time.Duration `json:"timeout"`
+
+	// HealthyThreshold is the number of successful checks after which an unhealthy instance
+	// is healthy again.
+	HealthyThreshold int `json:"healthy_threshold"`
+
+	// UnhealthyThreshold is the number of failed checks after which an instance is unhealthy.
+	UnhealthyThreshold int `json:"unhealthy_threshold"`
+}
+
+// Keys of the health check settings in the metadata of a service, after the prefix of the registry.
+const (
+	HealthCheckPath               = "path"
+	HealthCheckExpectedStatuses   = "expectedStatuses"
+	HealthCheckInterval           = "interval"
+	HealthCheckTimeout            = "timeout"
+	HealthCheckHealthyThreshold   = "healthyThreshold"
+	HealthCheckUnhealthyThreshold = "unhealthyThreshold"
+)
+
+var healthCheckSettings = map[string]bool{
+	HealthCheckPath:               true,
+	HealthCheckExpectedStatuses:   true,
+	HealthCheckInterval:           true,
+	HealthCheckTimeout:            true,
+	HealthCheckHealthyThreshold:   true,
+	HealthCheckUnhealthyThreshold: true,
+}
+
+// Default settings of the health checks.
+const (
+	DefaultHealthCheckInterval           = 10 * time.Second
+	DefaultHealthCheckTimeout            = time.Second
+	DefaultHealthCheckHealthyThreshold   = 2
+	DefaultHealthCheckUnhealthyThreshold = 3
+)
+
+// ParseHealthCheck parses the health check of a port declared in the metadata of a service by the
+// keys starting with the prefix. A setting applies to all the ports of the service if its key is
+// the prefix followed by its name, e.g. "istio.healthcheck.path", and to a single port if the name
+// is preceded by the port, e.g. "istio.healthcheck.9080.path", which takes precedence. It returns
+// nil if no health check is declared for the port. The settings which are not declared take their
+// default value.
+func ParseHealthCheck(metadata map[string]string, prefix string, port int) (*HealthCheck, error) {
+	portPrefix := strconv.Itoa(port) + "."
+	settings := make(map[string]string)
+	portSettings := make(map[string]string)
+	var errs error
+	for key, value := range metadata {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, prefix)
+		target := settings
+		if i := strings.Index(name, "."); i >= 0 {
+			if !strings.HasPrefix(name, portPrefix) {
+				// a setting of another port
+				continue
+			}
+			name, target = name[len(portPrefix):], portSettings
+		}
+		if !healthCheckSettings[name] {
+			errs = multierror.Append(errs, fmt.Errorf("%s is not a health check setting", key))
+			continue
+		}
+		target[name] = value
+	}
+	for name, value := range portSettings {
+		settings[name] = value
+	}
+	if len(settings) == 0 {
+		return nil, errs
+	}
+
+	out := &HealthCheck{
+		Path:               settings[HealthCheckPath],
+		Interval:           DefaultHealthCheckInterval,
+		Timeout:            DefaultHealthCheckTimeout,
+		HealthyThreshold:   DefaultHealthCheckHealthyThreshold,
+		UnhealthyThreshold: DefaultHealthCheckUnhealthyThreshold,
+	}
+
+	parseDuration := func(name string, d *time.Duration) {
+		if value, exists := settings[name]; exists {
+			var err error
+			if *d, err = time.ParseDuration(value); err != nil || *d <= 0 {
+				errs = multierror.Append(errs, fmt.Errorf("health check %s must be a positive duration: %q", name, value))
+			}
+		}
+	}
+	parseThreshold := func(name string, n *int) {
+		if value, exists := settings[name]; exists {
+			var err error
+			if *n, err = strconv.Atoi(value); err != nil || *n <= 0 {
+				errs = multierror.Append(errs, fmt.Errorf("health check %s must be a positive integer: %q", name, value))
+			}
+		}
+	}
+	parseDuration(HealthCheckInterval, &out.Interval)
+	parseDuration(HealthCheckTimeout, &out.Timeout)
+	parseThreshold(HealthCheckHealthyThreshold, &out.HealthyThreshold)
+	parseThreshold(HealthCheckUnhealthyThreshold, &out.UnhealthyThreshold)
+
+	if out.Path != "" && !strings.HasPrefix(out.Path, "/") {
+		errs = multierror.Append(errs, fmt.Errorf("health check %s must be an absolute path: %q", HealthCheckPath, out.Path))
+	}
+
+	if value, exists := settings[HealthCheckExpectedStatuses]; exists {
+		for _, status := range strings.Split(value, ",") {
+			code, err := strconv.Atoi(strings.TrimSpace(status))
+			if err != nil || code < 100 || code > 599 {
+				errs = multierror.Append(errs, fmt.Errorf("health check %s must be HTTP statuses: %q",
+					HealthCheckExpectedStatuses, value))
+				break
+			}
+			out.ExpectedStatuses = append(out.ExpectedStatuses, code)
+		}
+		switch {
+		case out.Path == "":
+			errs = multierror.Append(errs, fmt.Errorf("health check %s requires a %s",
+				HealthCheckExpectedStatuses, HealthCheckPath))
+		case len(out.ExpectedStatuses) > 1:
+			errs = multierror.Append(errs, fmt.Errorf("health check %s must be a single status, the proxies do not support more: %q",
+				HealthCheckExpectedStatuses, value))
+		}
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+	return out, nil
+}
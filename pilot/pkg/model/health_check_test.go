@@ -0,0 +1,136 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseHealthCheck(t *testing.T) {
+	const prefix = "istio.healthcheck."
+
+	cases := []struct {
+		name     string
+		metadata map[string]string
+		want     *HealthCheck
+		err      bool
+	}{
+		{
+			name:     "no health check",
+			metadata: map[string]string{"istio.protocol": "http"},
+		},
+		{
+			name:     "defaults",
+			metadata: map[string]string{prefix + HealthCheckPath: "/health"},
+			want: &HealthCheck{
+				Path:               "/health",
+				Interval:           DefaultHealthCheckInterval,
+				Timeout:            DefaultHealthCheckTimeout,
+				HealthyThreshold:   DefaultHealthCheckHealthyThreshold,
+				UnhealthyThreshold: DefaultHealthCheckUnhealthyThreshold,
+			},
+		},
+		{
+			name: "tcp health check",
+			metadata: map[string]string{
+				prefix + HealthCheckInterval:           "5s",
+				prefix + HealthCheckTimeout:            "500ms",
+				prefix + HealthCheckHealthyThreshold:   "1",
+				prefix + HealthCheckUnhealthyThreshold: "5",
+			},
+			want: &HealthCheck{
+				Interval:           5 * time.Second,
+				Timeout:            500 * time.Millisecond,
+				HealthyThreshold:   1,
+				UnhealthyThreshold: 5,
+			},
+		},
+		{
+			name:     "invalid interval",
+			metadata: map[string]string{prefix + HealthCheckInterval: "-1s"},
+			err:      true,
+		},
+		{
+			name:     "invalid threshold",
+			metadata: map[string]string{prefix + HealthCheckUnhealthyThreshold: "many"},
+			err:      true,
+		},
+		{
+			name:     "relative path",
+			metadata: map[string]string{prefix + HealthCheckPath: "health"},
+			err:      true,
+		},
+		{
+			name: "port settings",
+			metadata: map[string]string{
+				prefix + HealthCheckPath:                       "/health",
+				prefix + HealthCheckInterval:                   "5s",
+				prefix + "9080." + HealthCheckPath:             "/ready",
+				prefix + "9080." + HealthCheckExpectedStatuses: "204",
+				prefix + "9090." + HealthCheckInterval:         "1s",
+			},
+			want: &HealthCheck{
+				Path:               "/ready",
+				ExpectedStatuses:   []int{204},
+				Interval:           5 * time.Second,
+				Timeout:            DefaultHealthCheckTimeout,
+				HealthyThreshold:   DefaultHealthCheckHealthyThreshold,
+				UnhealthyThreshold: DefaultHealthCheckUnhealthyThreshold,
+			},
+		},
+		{
+			name:     "settings of another port",
+			metadata: map[string]string{prefix + "9090." + HealthCheckPath: "/health"},
+		},
+		{
+			name:     "unknown setting",
+			metadata: map[string]string{prefix + "method": "HEAD"},
+			err:      true,
+		},
+		{
+			name: "invalid expected status",
+			metadata: map[string]string{
+				prefix + HealthCheckPath:             "/health",
+				prefix + HealthCheckExpectedStatuses: "2xx",
+			},
+			err: true,
+		},
+		{
+			name: "several expected statuses",
+			metadata: map[string]string{
+				prefix + HealthCheckPath:             "/health",
+				prefix + HealthCheckExpectedStatuses: "200,204",
+			},
+			err: true,
+		},
+		{
+			name:     "expected status without path",
+			metadata: map[string]string{prefix + HealthCheckExpectedStatuses: "204"},
+			err:      true,
+		},
+	}
+
+	for _, c := range cases {
+		got, err := ParseHealthCheck(c.metadata, prefix, 9080)
+		if (err != nil) != c.err {
+			t.Errorf("%s: ParseHealthCheck() => %v, want error %v", c.name, err, c.err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: ParseHealthCheck() => %#v, want %#v", c.name, got, c.want)
+		}
+	}
+}
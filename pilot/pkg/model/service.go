@@ -84,6 +84,10 @@ type Port struct {
 	// Envoy-to-Envoy communication.
 	// This value is extracted from service annotation.
 	AuthenticationPolicy meshconfig.AuthenticationPolicy `json:"authentication_policy"`
+
+	// HealthCheck is the active health check of the instances, if any.
+	// This value is extracted from the service metadata of the registries.
+	HealthCheck *HealthCheck `json:"health_check,omitempty"`
 }
 
 // PortList is a set of ports
@@ -208,12 +212,16 @@ type LabelsCollection []Labels
 //      --> NetworkEndpoint(172.16.0.2:8888), Service(catalog.myservice.com), Labels(foo=bar)
 //      --> NetworkEndpoint(172.16.0.3:8888), Service(catalog.myservice.com), Labels(kitty=cat)
 //      --> NetworkEndpoint(172.16.0.4:8888), Service(catalog.myservice.com), Labels(kitty=cat)
+//
+// The instances of a service may run in several clusters of a mesh, the ClusterID
+// identifies the cluster of the registry which discovered the instance.
 type ServiceInstance struct {
 	Endpoint         NetworkEndpoint `json:"endpoint,omitempty"`
 	Service          *Service        `json:"service,omitempty"`
 	Labels           Labels          `json:"labels,omitempty"`
 	AvailabilityZone string          `json:"az,omitempty"`
 	ServiceAccount   string          `json:"serviceaccount,omitempty"`
+	ClusterID        string          `json:"cluster,omitempty"`
 }
 
 // ServiceDiscovery enumerates Istio service instances.
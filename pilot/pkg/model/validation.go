@@ -1176,7 +1176,11 @@ func validateLoadBalancer(settings *routingv2.LoadBalancerSettings) (errs error)
 	}
 
 	// simple load balancing is always valid
-	// TODO: settings.GetConsistentHash()
+	if consistentHash := settings.GetConsistentHash(); consistentHash != nil {
+		if err := ValidateHTTPHeaderName(consistentHash.HttpHeader); err != nil {
+			errs = appendErrors(errs, fmt.Errorf("consistent hash http header name invalid: %v", err))
+		}
+	}
 
 	return
 }
@@ -1743,7 +1747,17 @@ func ValidateRouteRuleV2(msg proto.Message) (errs error) {
 		return errors.New("cannot cast to v1alpha2 routing rule")
 	}
 
-	// TODO: routeRule.Gateways
+	for _, gateway := range routeRule.Gateways {
+		// gateways are referred to by name, or as namespace/name
+		parts := strings.Split(gateway, "/")
+		valid := len(parts) <= 2
+		for _, part := range parts {
+			valid = valid && IsDNS1123Label(part)
+		}
+		if !valid {
+			errs = appendErrors(errs, fmt.Errorf("gateway name %q must be a valid label or namespace/label", gateway))
+		}
+	}
 
 	if len(routeRule.Hosts) == 0 {
 		errs = multierror.Append(errs, errors.New("at least one host required"))
@@ -2031,6 +2031,25 @@ func TestValidateGateway(t *testing.T) {
 	}
 }
 
+func TestValidateRouteRuleV2Gateways(t *testing.T) {
+	cases := []struct {
+		gateway string
+		valid   bool
+	}{
+		{gateway: "ingress", valid: true},
+		{gateway: "istio-system/ingress", valid: true},
+		{gateway: "Ingress", valid: false},
+		{gateway: "istio-system/", valid: false},
+		{gateway: "a/b/c", valid: false},
+	}
+	for _, c := range cases {
+		err := ValidateRouteRuleV2(&routingv2.RouteRule{Hosts: []string{"hello"}, Gateways: []string{c.gateway}})
+		if invalid := err != nil && strings.Contains(err.Error(), "gateway name"); invalid == c.valid {
+			t.Errorf("ValidateRouteRuleV2(gateway %q) => %v, want valid %v", c.gateway, err, c.valid)
+		}
+	}
+}
+
 func TestValidateServer(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2727,6 +2746,46 @@ func TestValidateConnectionPool(t *testing.T) {
 	}
 }
 
+func TestValidateLoadBalancer(t *testing.T) {
+	cases := []struct {
+		name  string
+		in    routingv2.LoadBalancerSettings
+		valid bool
+	}{
+		{name: "valid simple", in: routingv2.LoadBalancerSettings{
+			LbPolicy: &routingv2.LoadBalancerSettings_Simple{Simple: routingv2.LoadBalancerSettings_LEAST_CONN},
+		}, valid: true},
+
+		{name: "valid consistent hash", in: routingv2.LoadBalancerSettings{
+			LbPolicy: &routingv2.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &routingv2.LoadBalancerSettings_ConsistentHashLB{
+					HttpHeader:      "x-user-id",
+					MinimumRingSize: 2048,
+				},
+			},
+		}, valid: true},
+
+		{name: "invalid consistent hash, missing header", in: routingv2.LoadBalancerSettings{
+			LbPolicy: &routingv2.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &routingv2.LoadBalancerSettings_ConsistentHashLB{MinimumRingSize: 2048},
+			},
+		}, valid: false},
+
+		{name: "invalid consistent hash, upper case header", in: routingv2.LoadBalancerSettings{
+			LbPolicy: &routingv2.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &routingv2.LoadBalancerSettings_ConsistentHashLB{HttpHeader: "X-User-Id"},
+			},
+		}, valid: false},
+	}
+
+	for _, c := range cases {
+		if got := validateLoadBalancer(&c.in); (got == nil) != c.valid {
+			t.Errorf("validateLoadBalancer failed on %v: got valid=%v but wanted valid=%v: %v",
+				c.name, got == nil, c.valid, got)
+		}
+	}
+}
+
 func TestValidateOutlierDetection(t *testing.T) {
 	cases := []struct {
 		name  string
@@ -0,0 +1,450 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	xdsapi "github.com/envoyproxy/go-control-plane/api"
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/log"
+)
+
+// ADSServer serves the Envoy v2 Aggregated Discovery Service over gRPC. Each
+// connected proxy is identified by its service node string (the Envoy node
+// ID) and receives a snapshot of clusters, endpoints, listeners and routes
+// generated by the same passes that serve the v1 REST APIs. Snapshots are
+// recomputed and pushed to all connected proxies whenever Push is called.
+type ADSServer struct {
+	env        model.Environment
+	addr       string
+	grpcServer *grpc.Server
+	snapshots  cache.Cache
+
+	mu sync.Mutex
+	// nodes holds the proxies with at least one open stream, and streams counts
+	// their open streams, since a reconnecting proxy may briefly hold two.
+	nodes   map[cache.Key]model.Node
+	streams map[cache.Key]int
+	version uint64
+
+	// pushCh coalesces push requests that arrive while a push is in progress.
+	pushCh chan struct{}
+}
+
+// adsNodeHash keys snapshots by the Envoy node ID, which must be a valid
+// Istio service node.
+type adsNodeHash struct{}
+
+// Hash implements cache.NodeHash.
+func (adsNodeHash) Hash(node *xdsapi.Node) (cache.Key, error) {
+	if node == nil {
+		return "", fmt.Errorf("missing node in discovery request")
+	}
+	if _, err := model.ParseServiceNode(node.Id); err != nil {
+		return "", err
+	}
+	return cache.Key(node.Id), nil
+}
+
+// NewADSServer creates an aggregated discovery service on the given port.
+func NewADSServer(env model.Environment, port int) *ADSServer {
+	out := &ADSServer{
+		env:     env,
+		addr:    ":" + strconv.Itoa(port),
+		nodes:   make(map[cache.Key]model.Node),
+		streams: make(map[cache.Key]int),
+		pushCh:  make(chan struct{}, 1),
+	}
+
+	// The callback is invoked on the first request from a node for which no
+	// snapshot exists yet. It may be called with the cache lock held, so the
+	// snapshot is computed asynchronously.
+	out.snapshots = cache.NewSimpleCache(adsNodeHash{}, func(key cache.Key) {
+		go out.addNode(key)
+	})
+
+	out.grpcServer = grpc.NewServer()
+	xdsapi.RegisterAggregatedDiscoveryServiceServer(out.grpcServer,
+		adsStreamTracker{Server: xds.NewServer(out.snapshots), ads: out})
+	return out
+}
+
+// adsStreamTracker tracks the streams of the proxies, so that the proxies are
+// forgotten when their last stream ends.
+type adsStreamTracker struct {
+	xds.Server
+	ads *ADSServer
+}
+
+// StreamAggregatedResources implements xdsapi.AggregatedDiscoveryServiceServer.
+func (t adsStreamTracker) StreamAggregatedResources(
+	stream xdsapi.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
+	tracked := &trackedStream{AggregatedDiscoveryService_StreamAggregatedResourcesServer: stream, ads: t.ads}
+	defer tracked.close()
+	return t.Server.StreamAggregatedResources(tracked)
+}
+
+// trackedStream opens the stream of the node of its first request.
+type trackedStream struct {
+	xdsapi.AggregatedDiscoveryService_StreamAggregatedResourcesServer
+	ads *ADSServer
+
+	mu     sync.Mutex
+	key    cache.Key
+	opened bool
+	closed bool
+}
+
+// Recv implements xdsapi.AggregatedDiscoveryService_StreamAggregatedResourcesServer.
+func (s *trackedStream) Recv() (*xdsapi.DiscoveryRequest, error) {
+	req, err := s.AggregatedDiscoveryService_StreamAggregatedResourcesServer.Recv()
+	if err != nil {
+		return req, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.opened && !s.closed {
+		if key, hashErr := (adsNodeHash{}).Hash(req.Node); hashErr == nil {
+			s.key, s.opened = key, true
+			if s.ads.openStream(key) {
+				go s.ads.addNode(key)
+			}
+		}
+	}
+	return req, err
+}
+
+func (s *trackedStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.opened {
+		s.ads.closeStream(s.key)
+	}
+}
+
+// Start starts serving ADS. Serving can be cancelled at any time by closing the provided stop channel.
+func (a *ADSServer) Start(stop chan struct{}) (net.Addr, error) {
+	listener, err := net.Listen("tcp", a.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := a.grpcServer.Serve(listener); err != nil {
+			log.Warna(err)
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-a.pushCh:
+				a.pushAll()
+			case <-stop:
+				a.grpcServer.GracefulStop()
+				return
+			}
+		}
+	}()
+
+	log.Infof("Aggregated discovery service started at %s", listener.Addr().String())
+	return listener.Addr(), nil
+}
+
+// Push schedules the recomputation of snapshots for all known proxies. Multiple
+// calls made while a push is pending result in a single push.
+func (a *ADSServer) Push() {
+	select {
+	case a.pushCh <- struct{}{}:
+	default:
+	}
+}
+
+// openStream counts a new stream of a node, and returns true if the node has
+// no snapshot being pushed yet.
+func (a *ADSServer) openStream(key cache.Key) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.streams[key]++
+	_, exists := a.nodes[key]
+	return !exists
+}
+
+// closeStream forgets a node when its last stream ends, so that snapshots are
+// no longer computed for it.
+func (a *ADSServer) closeStream(key cache.Key) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.streams[key]--
+	if a.streams[key] <= 0 {
+		delete(a.streams, key)
+		delete(a.nodes, key)
+	}
+}
+
+// addNode pushes the first snapshot of a node with an open stream, unless the
+// node is already known.
+func (a *ADSServer) addNode(key cache.Key) {
+	node, err := model.ParseServiceNode(string(key))
+	if err != nil {
+		log.Warnf("ADS: ignoring invalid node %q: %v", key, err)
+		return
+	}
+
+	a.mu.Lock()
+	if _, exists := a.nodes[key]; exists || a.streams[key] == 0 {
+		a.mu.Unlock()
+		return
+	}
+	a.nodes[key] = node
+	version := a.nextVersion()
+	a.mu.Unlock()
+
+	a.pushNode(key, node, version)
+}
+
+func (a *ADSServer) pushAll() {
+	a.mu.Lock()
+	nodes := make(map[cache.Key]model.Node, len(a.nodes))
+	for key, node := range a.nodes {
+		nodes[key] = node
+	}
+	version := a.nextVersion()
+	a.mu.Unlock()
+
+	for key, node := range nodes {
+		a.pushNode(key, node, version)
+	}
+}
+
+// nextVersion must be called with the lock held.
+func (a *ADSServer) nextVersion() string {
+	a.version++
+	return strconv.FormatUint(a.version, 10)
+}
+
+func (a *ADSServer) pushNode(key cache.Key, node model.Node, version string) {
+	methodName := "ADSPush"
+	incCalls(methodName)
+
+	snapshot, err := a.buildSnapshot(node, version)
+	if err != nil {
+		// Keep the previous snapshot; the proxy retains its current config.
+		incErrors(methodName)
+		log.Warnf("ADS: failed to build snapshot for %s: %v", key, err)
+		return
+	}
+	if err = a.snapshots.SetSnapshot(key, snapshot); err != nil {
+		incErrors(methodName)
+		log.Warnf("ADS: failed to set snapshot for %s: %v", key, err)
+	}
+}
+
+// buildSnapshot computes the full set of v2 resources for a proxy. The webhook
+// only applies to the v1 responses, and can't be configured along with ADS.
+func (a *ADSServer) buildSnapshot(node model.Node, version string) (cache.Snapshot, error) {
+	clusters, err := buildClusters(a.env, node)
+	if err != nil {
+		return cache.Snapshot{}, err
+	}
+	listeners, err := buildListeners(a.env, node)
+	if err != nil {
+		return cache.Snapshot{}, err
+	}
+
+	// the endpoints are prioritized by their proximity to the zone of the proxy
+	az := ""
+	instances, err := a.env.HostInstances(map[string]*model.Node{node.IPAddress: &node})
+	if err != nil {
+		return cache.Snapshot{}, err
+	}
+	if len(instances) > 0 {
+		az = instances[0].AvailabilityZone
+	}
+
+	v2Clusters := make([]proto.Message, 0, len(clusters))
+	v2Endpoints := make([]proto.Message, 0)
+	for _, cluster := range clusters {
+		v2Cluster, err := buildV2Cluster(cluster)
+		if err != nil {
+			return cache.Snapshot{}, err
+		}
+		v2Clusters = append(v2Clusters, v2Cluster)
+
+		if cluster.Type == ClusterTypeSDS {
+			endpoints, err := a.buildV2Endpoints(cluster.ServiceName, az)
+			if err != nil {
+				return cache.Snapshot{}, err
+			}
+			v2Endpoints = append(v2Endpoints, endpoints)
+		}
+	}
+
+	v2Listeners := make([]proto.Message, 0, len(listeners))
+	routeNames := make(map[string]bool)
+	for _, listener := range listeners {
+		v2Listener, names, err := buildV2Listener(listener)
+		if err != nil {
+			return cache.Snapshot{}, err
+		}
+		v2Listeners = append(v2Listeners, v2Listener)
+		for _, name := range names {
+			routeNames[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(routeNames))
+	for name := range routeNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	v2Routes := make([]proto.Message, 0, len(names))
+	for _, name := range names {
+		routeConfig, err := buildRDSRoute(a.env.Mesh, node, name, a.env.ServiceDiscovery, a.env.IstioConfigStore)
+		if err != nil {
+			return cache.Snapshot{}, err
+		}
+		v2Route, err := buildV2RouteConfiguration(name, routeConfig)
+		if err != nil {
+			return cache.Snapshot{}, err
+		}
+		v2Routes = append(v2Routes, v2Route)
+	}
+
+	observeResources("ADSClusters", uint32(len(v2Clusters)))
+	observeResources("ADSListeners", uint32(len(v2Listeners)))
+	return cache.NewSnapshot(version, v2Endpoints, v2Clusters, v2Routes, v2Listeners), nil
+}
+
+// buildV2Endpoints resolves the endpoints of an EDS cluster named by a v1 service key, for a
+// proxy in the given availability zone.
+func (a *ADSServer) buildV2Endpoints(serviceKey, az string) (*xdsapi.ClusterLoadAssignment, error) {
+	hostname, ports, labels := model.ParseServiceKey(serviceKey)
+	instances, err := a.env.Instances(hostname, ports.GetNames(), labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xdsapi.ClusterLoadAssignment{
+		ClusterName: serviceKey,
+		Endpoints:   buildLocalityLbEndpoints(instances, az, a.env.LocalityMinHealthyPercent),
+	}, nil
+}
+
+// Proximity of the locality of an endpoint to the locality of a proxy, which is the priority
+// of the endpoint before failover thresholds are applied.
+const (
+	sameZone = iota
+	sameRegion
+	otherRegion
+	numProximities
+)
+
+// buildLocalityLbEndpoints groups the endpoints by locality. Envoy sends requests to the endpoints
+// of the highest priority, i.e. the closest to the proxy, and fails over to the next priority as
+// these endpoints become unhealthy. The endpoints of a proximity are merged with the ones of the next
+// proximity while they hold less than minHealthyPercent of the healthy endpoints of the service, so
+// that a few endpoints left in the zone of the proxy are not overloaded.
+func buildLocalityLbEndpoints(instances []*model.ServiceInstance, az string,
+	minHealthyPercent int) []xdsapi.LocalityLbEndpoints {
+	proxyLocality := parseLocality(az)
+
+	var counts [numProximities]int
+	byAZ := make(map[string][]xdsapi.LbEndpoint)
+	proximities := make(map[string]int)
+	for _, instance := range instances {
+		endpointAZ := instance.AvailabilityZone
+		if _, exists := proximities[endpointAZ]; !exists {
+			proximities[endpointAZ] = proximity(proxyLocality, parseLocality(endpointAZ))
+		}
+		counts[proximities[endpointAZ]]++
+		byAZ[endpointAZ] = append(byAZ[endpointAZ], xdsapi.LbEndpoint{
+			Endpoint: &xdsapi.Endpoint{
+				Address: buildV2Address(instance.Endpoint.Address, uint32(instance.Endpoint.Port)),
+			},
+		})
+	}
+
+	// Envoy expects contiguous priorities starting at 0
+	var priorities [numProximities]uint32
+	priority, healthy := uint32(0), 0
+	for p := 0; p < numProximities; p++ {
+		priorities[p] = priority
+		healthy += counts[p]
+		if counts[p] > 0 && healthy*100 >= minHealthyPercent*len(instances) {
+			priority++
+		}
+	}
+
+	azs := make([]string, 0, len(byAZ))
+	for endpointAZ := range byAZ {
+		azs = append(azs, endpointAZ)
+	}
+	sort.Slice(azs, func(i, j int) bool {
+		if pi, pj := proximities[azs[i]], proximities[azs[j]]; pi != pj {
+			return pi < pj
+		}
+		return azs[i] < azs[j]
+	})
+
+	out := make([]xdsapi.LocalityLbEndpoints, 0, len(azs))
+	for _, endpointAZ := range azs {
+		out = append(out, xdsapi.LocalityLbEndpoints{
+			Locality:    parseLocality(endpointAZ),
+			LbEndpoints: byAZ[endpointAZ],
+			Priority:    priorities[proximities[endpointAZ]],
+		})
+	}
+	return out
+}
+
+// parseLocality parses an availability zone in the region/zone format of the Kubernetes registry.
+// Availability zones without a region, e.g. Consul datacenters, are zones of an unnamed region.
+func parseLocality(az string) *xdsapi.Locality {
+	if az == "" {
+		return nil
+	}
+	if i := strings.Index(az, "/"); i >= 0 {
+		return &xdsapi.Locality{Region: az[:i], Zone: az[i+1:]}
+	}
+	return &xdsapi.Locality{Zone: az}
+}
+
+func proximity(proxy, endpoint *xdsapi.Locality) int {
+	switch {
+	case proxy == nil:
+		// all endpoints share the highest priority if the zone of the proxy is unknown
+		return sameZone
+	case endpoint == nil || endpoint.Region != proxy.Region:
+		return otherRegion
+	case endpoint.Zone != proxy.Zone:
+		return sameRegion
+	}
+	return sameZone
+}
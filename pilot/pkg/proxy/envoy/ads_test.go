@@ -0,0 +1,118 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/proxy/envoy/mock"
+)
+
+func TestParseLocality(t *testing.T) {
+	if got := parseLocality(""); got != nil {
+		t.Errorf("parseLocality(\"\") => %v, want nil", got)
+	}
+	if got := parseLocality("us-east1/us-east1-b"); got.Region != "us-east1" || got.Zone != "us-east1-b" {
+		t.Errorf("parseLocality(us-east1/us-east1-b) => %v", got)
+	}
+	if got := parseLocality("dc1"); got.Region != "" || got.Zone != "dc1" {
+		t.Errorf("parseLocality(dc1) => %v", got)
+	}
+}
+
+func TestBuildLocalityLbEndpoints(t *testing.T) {
+	// makeInstances creates the given number of instances per availability zone
+	makeInstances := func(counts map[string]int) []*model.ServiceInstance {
+		var out []*model.ServiceInstance
+		for az, n := range counts {
+			for i := 0; i < n; i++ {
+				out = append(out, mock.MakeInstance(mock.HelloService, mock.PortHTTP, len(out), az))
+			}
+		}
+		return out
+	}
+
+	cases := []struct {
+		name       string
+		counts     map[string]int
+		az         string
+		minHealthy int
+		// want lists the availability zone and priority of the localities
+		want []string
+	}{
+		{
+			name:   "unknown proxy zone",
+			counts: map[string]int{"r1/z1": 2, "r1/z2": 2},
+			want:   []string{"r1/z1:0", "r1/z2:0"},
+		},
+		{
+			name:       "closest zones first",
+			counts:     map[string]int{"r1/z1": 2, "r1/z2": 2, "r2/z1": 2, "": 1},
+			az:         "r1/z2",
+			minHealthy: 20,
+			want:       []string{"r1/z2:0", "r1/z1:1", ":2", "r2/z1:2"},
+		},
+		{
+			name:       "failover of a zone below the threshold",
+			counts:     map[string]int{"r1/z1": 1, "r1/z2": 4, "r2/z1": 5},
+			az:         "r1/z1",
+			minHealthy: 20,
+			want:       []string{"r1/z1:0", "r1/z2:0", "r2/z1:1"},
+		},
+		{
+			name:       "failover of a region below the threshold",
+			counts:     map[string]int{"r1/z1": 1, "r1/z2": 1, "r2/z1": 8},
+			az:         "r1/z1",
+			minHealthy: 50,
+			want:       []string{"r1/z1:0", "r1/z2:0", "r2/z1:0"},
+		},
+		{
+			name:       "no endpoints in the zone of the proxy",
+			counts:     map[string]int{"r1/z2": 2, "r2/z1": 2},
+			az:         "r1/z1",
+			minHealthy: 20,
+			want:       []string{"r1/z2:0", "r2/z1:1"},
+		},
+	}
+
+	for _, c := range cases {
+		instances := makeInstances(c.counts)
+		got := make([]string, 0)
+		endpoints := 0
+		for _, locality := range buildLocalityLbEndpoints(instances, c.az, c.minHealthy) {
+			az := ""
+			if locality.Locality != nil {
+				az = locality.Locality.Zone
+				if locality.Locality.Region != "" {
+					az = locality.Locality.Region + "/" + az
+				}
+			}
+			if n := c.counts[az]; n != len(locality.LbEndpoints) {
+				t.Errorf("%s: got %d endpoints in %q, want %d", c.name, len(locality.LbEndpoints), az, n)
+			}
+			endpoints += len(locality.LbEndpoints)
+			got = append(got, fmt.Sprintf("%s:%d", az, locality.Priority))
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got localities %v, want %v", c.name, got, c.want)
+		}
+		if endpoints != len(instances) {
+			t.Errorf("%s: got %d endpoints, want %d", c.name, endpoints, len(instances))
+		}
+	}
+}
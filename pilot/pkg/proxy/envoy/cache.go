@@ -0,0 +1,376 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"istio.io/istio/pkg/cache"
+	"istio.io/istio/pkg/log"
+)
+
+// Reasons for discovery cache invalidation, reported in the cache_invalidations metric.
+const (
+	invalidationService  = "service"
+	invalidationInstance = "instance"
+	invalidationConfig   = "config"
+	invalidationEvicted  = "evicted"
+	invalidationFlush    = "flush"
+)
+
+const (
+	// DefaultCacheMaxEntries is the default bound on the number of responses held by each discovery cache.
+	DefaultCacheMaxEntries = 10000
+
+	// cacheEvictionInterval is the frequency at which expired cache responses are evicted.
+	cacheEvictionInterval = 5 * time.Second
+
+	// proxyWithoutInstances is the dependency recorded for responses computed for a
+	// proxy that has no co-located service instances yet. Such responses are
+	// invalidated by any instance event that does not identify the instance address.
+	proxyWithoutInstances = ""
+)
+
+var cacheInvalidationCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "cache_invalidations",
+		Help:      "Count of invalidated responses for a particular cache within Pilot, by reason",
+	}, []string{metricLabelCacheName, metricLabelReason, metricBuildVersion})
+
+func init() {
+	prometheus.MustRegister(cacheInvalidationCounter)
+}
+
+type discoveryCacheStatEntry struct {
+	Hit  uint64 `json:"hit"`
+	Miss uint64 `json:"miss"`
+}
+
+type discoveryCacheStats struct {
+	Stats map[string]*discoveryCacheStatEntry `json:"cache_stats"`
+}
+
+// cacheDependencies records the inputs a cached discovery response was computed from.
+type cacheDependencies struct {
+	// services are the hostnames of the services whose instances affect the response.
+	services []string
+
+	// nodeIP is the address of the proxy the response was computed for, if the response is proxy specific.
+	nodeIP string
+}
+
+type discoveryCacheEntry struct {
+	hit           uint64 // atomic
+	miss          uint64 // atomic
+	size          int64  // atomic, bytes of the cached response, 0 if not present
+	resourceCount uint32
+	deps          cacheDependencies
+}
+
+// discoveryCache holds discovery responses keyed by request URL. Responses are
+// held in a bounded LRU cache with time-based expiration, and indexed by their
+// dependencies so that an event only invalidates the affected responses.
+// The hit and miss statistics and the indexes of a response are dropped
+// with it when it is invalidated, expires or is displaced.
+type discoveryCache struct {
+	name     string
+	disabled bool
+
+	responses cache.ExpiringCache
+
+	mu        sync.RWMutex
+	cache     map[string]*discoveryCacheEntry
+	byService map[string]map[string]bool
+	byNode    map[string]map[string]bool
+
+	// evicted holds the keys of the responses evicted by the LRU cache, which are
+	// pruned the next time the lock is held for writing.
+	evictedMu sync.Mutex
+	evicted   []string
+}
+
+func newDiscoveryCache(name string, enabled bool, maxEntries int, ttl time.Duration) *discoveryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultCacheMaxEntries
+	}
+	// Without an eviction interval, responses never expire and are only
+	// displaced when the cache is full.
+	evictionInterval := cacheEvictionInterval
+	if ttl <= 0 {
+		evictionInterval = 0
+	}
+	c := &discoveryCache{
+		name:      name,
+		disabled:  !enabled,
+		cache:     make(map[string]*discoveryCacheEntry),
+		byService: make(map[string]map[string]bool),
+		byNode:    make(map[string]map[string]bool),
+	}
+	c.responses = cache.NewLRUWithEvictionCallback(ttl, evictionInterval, int32(maxEntries), c.onEvict)
+	return c
+}
+
+// onEvict is called by the LRU cache with its lock held, possibly while the lock
+// of the discovery cache is held too, so it only records the key.
+func (c *discoveryCache) onEvict(key, _ interface{}) {
+	c.evictedMu.Lock()
+	c.evicted = append(c.evicted, key.(string))
+	c.evictedMu.Unlock()
+}
+
+// prune drops the entries and indexes of the evicted responses. It must be called
+// with the lock held for writing.
+func (c *discoveryCache) prune() {
+	c.evictedMu.Lock()
+	evicted := c.evicted
+	c.evicted = nil
+	c.evictedMu.Unlock()
+
+	for _, key := range evicted {
+		entry, ok := c.cache[key]
+		if !ok {
+			continue
+		}
+		// the response may have been cached again since it was evicted
+		if _, present := c.responses.Get(key); present {
+			continue
+		}
+		c.unindex(key, entry)
+		delete(c.cache, key)
+		if size := atomic.SwapInt64(&entry.size, 0); size > 0 {
+			cacheSizeGauge.With(c.cacheSizeLabels()).Sub(float64(size))
+			cacheInvalidationCounter.With(c.invalidationLabels(invalidationEvicted)).Inc()
+		}
+	}
+}
+
+func (c *discoveryCache) cachedDiscoveryResponse(key string) ([]byte, uint32, bool) {
+	if c.disabled {
+		return nil, 0, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Miss - entry.miss is updated in updateCachedDiscoveryResponse
+	entry, ok := c.cache[key]
+	if !ok {
+		return nil, 0, false
+	}
+	data, ok := c.responses.Get(key)
+	if !ok {
+		// The response was displaced or has expired since it was last used.
+		if size := atomic.SwapInt64(&entry.size, 0); size > 0 {
+			cacheSizeGauge.With(c.cacheSizeLabels()).Sub(float64(size))
+			cacheInvalidationCounter.With(c.invalidationLabels(invalidationEvicted)).Inc()
+		}
+		return nil, 0, false
+	}
+
+	// Hit
+	atomic.AddUint64(&entry.hit, 1)
+	cacheHitCounter.With(c.cacheSizeLabels()).Inc()
+	return data.([]byte), entry.resourceCount, true
+}
+
+func (c *discoveryCache) updateCachedDiscoveryResponse(key string, resourceCount uint32, data []byte,
+	deps cacheDependencies) {
+	if c.disabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune()
+
+	entry, ok := c.cache[key]
+	if !ok {
+		entry = &discoveryCacheEntry{}
+		c.cache[key] = entry
+	} else if _, present := c.responses.Get(key); present {
+		log.Warnf("Overriding cached data for entry %v", key)
+	}
+	c.unindex(key, entry)
+	entry.deps = deps
+	c.index(key, entry)
+
+	size := int64(len(key) + len(data))
+	oldSize := atomic.SwapInt64(&entry.size, size)
+	entry.resourceCount = resourceCount
+	c.responses.Set(key, data)
+
+	atomic.AddUint64(&entry.miss, 1)
+	cacheMissCounter.With(c.cacheSizeLabels()).Inc()
+	cacheSizeGauge.With(c.cacheSizeLabels()).Add(float64(size - oldSize))
+}
+
+// invalidate removes the responses that depend on any of the given services or proxy addresses.
+func (c *discoveryCache) invalidate(reason string, services []string, nodeIPs []string) {
+	if c.disabled {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune()
+
+	keys := make(map[string]bool)
+	for _, service := range services {
+		for key := range c.byService[service] {
+			keys[key] = true
+		}
+	}
+	for _, ip := range nodeIPs {
+		for key := range c.byNode[ip] {
+			keys[key] = true
+		}
+	}
+
+	var removed int
+	for key := range keys {
+		if c.remove(key) {
+			removed++
+		}
+	}
+	if removed > 0 {
+		cacheInvalidationCounter.With(c.invalidationLabels(reason)).Add(float64(removed))
+	}
+}
+
+// clear removes all cached responses.
+func (c *discoveryCache) clear(reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Reset the cache size metric for this cache.
+	cacheSizeGauge.Delete(c.cacheSizeLabels())
+
+	var removed int
+	for _, entry := range c.cache {
+		if atomic.LoadInt64(&entry.size) > 0 {
+			removed++
+		}
+	}
+	c.cache = make(map[string]*discoveryCacheEntry)
+	c.byService = make(map[string]map[string]bool)
+	c.byNode = make(map[string]map[string]bool)
+	c.responses.RemoveAll()
+
+	if removed > 0 {
+		cacheInvalidationCounter.With(c.invalidationLabels(reason)).Add(float64(removed))
+	}
+}
+
+// remove must be called with the lock held. It returns true if a response was removed.
+func (c *discoveryCache) remove(key string) bool {
+	entry, ok := c.cache[key]
+	if !ok {
+		return false
+	}
+	c.unindex(key, entry)
+	delete(c.cache, key)
+	c.responses.Remove(key)
+	size := atomic.SwapInt64(&entry.size, 0)
+	if size > 0 {
+		cacheSizeGauge.With(c.cacheSizeLabels()).Sub(float64(size))
+	}
+	return size > 0
+}
+
+// index must be called with the lock held.
+func (c *discoveryCache) index(key string, entry *discoveryCacheEntry) {
+	for _, service := range entry.deps.services {
+		keys, ok := c.byService[service]
+		if !ok {
+			keys = make(map[string]bool)
+			c.byService[service] = keys
+		}
+		keys[key] = true
+	}
+	if ip := entry.deps.nodeIP; ip != "" {
+		keys, ok := c.byNode[ip]
+		if !ok {
+			keys = make(map[string]bool)
+			c.byNode[ip] = keys
+		}
+		keys[key] = true
+	}
+}
+
+// unindex must be called with the lock held.
+func (c *discoveryCache) unindex(key string, entry *discoveryCacheEntry) {
+	for _, service := range entry.deps.services {
+		if keys, ok := c.byService[service]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(c.byService, service)
+			}
+		}
+	}
+	if ip := entry.deps.nodeIP; ip != "" {
+		if keys, ok := c.byNode[ip]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(c.byNode, ip)
+			}
+		}
+	}
+	entry.deps = cacheDependencies{}
+}
+
+func (c *discoveryCache) resetStats() {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, v := range c.cache {
+		atomic.StoreUint64(&v.hit, 0)
+		atomic.StoreUint64(&v.miss, 0)
+	}
+}
+
+func (c *discoveryCache) stats() map[string]*discoveryCacheStatEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune()
+
+	stats := make(map[string]*discoveryCacheStatEntry, len(c.cache))
+	for k, v := range c.cache {
+		stats[k] = &discoveryCacheStatEntry{
+			Hit:  atomic.LoadUint64(&v.hit),
+			Miss: atomic.LoadUint64(&v.miss),
+		}
+	}
+	return stats
+}
+
+func (c *discoveryCache) cacheSizeLabels() prometheus.Labels {
+	return prometheus.Labels{
+		metricLabelCacheName: c.name,
+		metricBuildVersion:   buildVersion,
+	}
+}
+
+func (c *discoveryCache) invalidationLabels(reason string) prometheus.Labels {
+	return prometheus.Labels{
+		metricLabelCacheName: c.name,
+		metricLabelReason:    reason,
+		metricBuildVersion:   buildVersion,
+	}
+}
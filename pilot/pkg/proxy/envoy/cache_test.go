@@ -0,0 +1,124 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"testing"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/proxy/envoy/mock"
+)
+
+func cached(c *discoveryCache, key string) bool {
+	_, _, ok := c.cachedDiscoveryResponse(key)
+	return ok
+}
+
+func TestDiscoveryCacheInvalidate(t *testing.T) {
+	c := newDiscoveryCache("test", true, 0, 0)
+	c.updateCachedDiscoveryResponse("hello", 1, []byte("hello"), cacheDependencies{services: []string{"hello"}})
+	c.updateCachedDiscoveryResponse("world", 1, []byte("world"), cacheDependencies{services: []string{"world"}})
+	c.updateCachedDiscoveryResponse("proxy", 1, []byte("proxy"),
+		cacheDependencies{services: []string{"world"}, nodeIP: "10.1.1.0"})
+
+	c.invalidate(invalidationInstance, []string{"hello"}, nil)
+	if cached(c, "hello") {
+		t.Error("expected hello to be invalidated")
+	}
+	if !cached(c, "world") || !cached(c, "proxy") {
+		t.Error("expected world and proxy to be retained")
+	}
+
+	c.invalidate(invalidationInstance, nil, []string{"10.1.1.0"})
+	if cached(c, "proxy") {
+		t.Error("expected proxy to be invalidated")
+	}
+	if !cached(c, "world") {
+		t.Error("expected world to be retained")
+	}
+
+	// the dependency index is cleared with the response
+	c.updateCachedDiscoveryResponse("proxy", 1, []byte("proxy"), cacheDependencies{nodeIP: "10.1.1.1"})
+	c.invalidate(invalidationInstance, []string{"world"}, []string{"10.1.1.0"})
+	if !cached(c, "proxy") {
+		t.Error("expected proxy to be retained after its dependencies changed")
+	}
+	if cached(c, "world") {
+		t.Error("expected world to be invalidated")
+	}
+
+	// the statistics of the invalidated responses are dropped
+	if stats := c.stats(); len(stats) != 1 || stats["proxy"] == nil {
+		t.Errorf("expected the statistics of the invalidated responses to be dropped, got %v", stats)
+	}
+
+	c.clear(invalidationFlush)
+	if cached(c, "proxy") {
+		t.Error("expected proxy to be invalidated by clear")
+	}
+	if stats := c.stats(); len(stats) != 0 || len(c.byService) != 0 || len(c.byNode) != 0 {
+		t.Errorf("expected the statistics and indexes to be dropped by clear, got %v", stats)
+	}
+}
+
+func TestDiscoveryCacheBounded(t *testing.T) {
+	c := newDiscoveryCache("test", true, 2, 0)
+	for _, key := range []string{"a", "b", "c"} {
+		c.updateCachedDiscoveryResponse(key, 1, []byte(key),
+			cacheDependencies{services: []string{key}, nodeIP: "10.1.1." + key})
+	}
+	if cached(c, "a") {
+		t.Error("expected least recently used response to be evicted")
+	}
+	if !cached(c, "b") || !cached(c, "c") {
+		t.Error("expected recent responses to be retained")
+	}
+
+	// the statistics and indexes of the evicted response are dropped
+	if stats := c.stats(); len(stats) != 2 || stats["a"] != nil {
+		t.Errorf("expected the statistics of the evicted response to be dropped, got %v", stats)
+	}
+	if c.byService["a"] != nil || c.byNode["10.1.1.a"] != nil || len(c.byService) != 2 || len(c.byNode) != 2 {
+		t.Errorf("expected the indexes of the evicted response to be dropped, got %v and %v", c.byService, c.byNode)
+	}
+}
+
+func TestDiscoveryServiceInstanceChanged(t *testing.T) {
+	_, _, ds := commonSetup(t)
+
+	sds := "/v1/registration/" + mock.HelloService.Key(mock.HelloService.Ports[0], nil)
+	worldSDS := "/v1/registration/" + mock.WorldService.Key(mock.WorldService.Ports[0], nil)
+	lds := "/v1/listeners/istio-proxy/" + mock.HelloProxyV0.ServiceNode()
+	for _, url := range []string{sds, worldSDS, lds} {
+		_ = makeDiscoveryRequest(ds, "GET", url, t)
+	}
+
+	ds.instanceChanged(&model.ServiceInstance{Service: mock.WorldService})
+
+	if _, _, ok := ds.sdsCache.cachedDiscoveryResponse(sds); !ok {
+		t.Error("expected endpoints of unrelated service to be retained")
+	}
+	if _, _, ok := ds.sdsCache.cachedDiscoveryResponse(worldSDS); ok {
+		t.Error("expected endpoints of changed service to be invalidated")
+	}
+
+	ds.instanceChanged(&model.ServiceInstance{
+		Service:  mock.HelloService,
+		Endpoint: model.NetworkEndpoint{Address: mock.HelloProxyV0.IPAddress},
+	})
+	if _, _, ok := ds.ldsCache.cachedDiscoveryResponse(lds); ok {
+		t.Error("expected listeners of co-located proxy to be invalidated")
+	}
+}
@@ -114,7 +114,7 @@ func BuildConfig(config meshconfig.ProxyConfig, pilotSAN []string) *Config {
 	case meshconfig.AuthenticationPolicy_NONE:
 		// do nothing
 	case meshconfig.AuthenticationPolicy_MUTUAL_TLS:
-		sslContext := buildClusterSSLContext(model.AuthCertsPath, pilotSAN)
+		sslContext := buildClusterSSLContext(model.AuthCertsPath, pilotSAN, "")
 		clusterRDS.SSLContext = sslContext
 		clusterLDS.SSLContext = sslContext
 		out.ClusterManager.SDS.Cluster.SSLContext = sslContext
@@ -145,10 +145,23 @@ func buildListeners(env model.Environment, node model.Node) (Listeners, error) {
 			return nil, err
 		}
 		listeners, _ := buildSidecarListenersClusters(env.Mesh, instances,
-			services, env.ManagementPorts(node.IPAddress), node, env.IstioConfigStore)
+			services, env.ManagementPorts(node.IPAddress), node, env.IstioConfigStore, env.CRLFile)
 		return listeners, nil
 	case model.Ingress:
-		return buildIngressListeners(env.Mesh, nil, env.ServiceDiscovery, env.IstioConfigStore, node), nil
+		instances, err := env.HostInstances(map[string]*model.Node{node.IPAddress: &node})
+		if err != nil {
+			return nil, err
+		}
+		listeners := buildIngressListeners(env.Mesh, nil, env.ServiceDiscovery, env.IstioConfigStore, node)
+		for _, listener := range buildGatewayListeners(env.Mesh, node, instances, env.IstioConfigStore) {
+			if l := listeners.GetByAddress(listener.Address); l != nil {
+				log.Warnf("Omitting gateway listener %s due to collision with ingress listener %s",
+					listener.Address, l.Name)
+				continue
+			}
+			listeners = append(listeners, listener)
+		}
+		return listeners, nil
 	}
 	return nil, nil
 }
@@ -168,10 +181,21 @@ func buildClusters(env model.Environment, node model.Node) (Clusters, error) {
 			return clusters, err
 		}
 		_, clusters = buildSidecarListenersClusters(env.Mesh, instances,
-			services, env.ManagementPorts(node.IPAddress), node, env.IstioConfigStore)
+			services, env.ManagementPorts(node.IPAddress), node, env.IstioConfigStore, env.CRLFile)
 	case model.Ingress:
 		httpRouteConfigs, _ := buildIngressRoutes(env.Mesh, node, nil, env.ServiceDiscovery, env.IstioConfigStore)
-		clusters = httpRouteConfigs.clusters().normalize()
+		clusters = httpRouteConfigs.clusters()
+
+		gatewayInstances, err := env.HostInstances(map[string]*model.Node{node.IPAddress: &node}) // nolint: vetshadow
+		if err != nil {
+			return clusters, err
+		}
+		services, err := env.Services() // nolint: vetshadow
+		if err != nil {
+			return clusters, err
+		}
+		gatewayRouteConfigs := buildGatewayHTTPRoutes(env.Mesh, node, gatewayInstances, services, env.IstioConfigStore)
+		clusters = append(clusters, gatewayRouteConfigs.clusters()...).normalize()
 	}
 
 	if err != nil {
@@ -180,12 +204,13 @@ func buildClusters(env model.Environment, node model.Node) (Clusters, error) {
 
 	// apply custom policies for outbound clusters
 	for _, cluster := range clusters {
-		applyClusterPolicy(cluster, instances, env.IstioConfigStore, env.Mesh, env.ServiceAccounts, node.Domain)
+		applyClusterPolicy(cluster, instances, env.IstioConfigStore, env.Mesh, env.ServiceAccounts, node.Domain,
+			env.CRLFile)
 	}
 
 	// append Mixer service definition if necessary
 	if env.Mesh.MixerAddress != "" {
-		clusters = append(clusters, buildMixerCluster(env.Mesh, node, env.MixerSAN))
+		clusters = append(clusters, buildMixerCluster(env.Mesh, node, env.MixerSAN, env.CRLFile))
 		clusters = append(clusters, buildMixerAuthFilterClusters(env.IstioConfigStore, env.Mesh, instances)...)
 	}
 
@@ -202,7 +227,8 @@ func buildSidecarListenersClusters(
 	services []*model.Service,
 	managementPorts model.PortList,
 	node model.Node,
-	config model.IstioConfigStore) (Listeners, Clusters) {
+	config model.IstioConfigStore,
+	crlFile string) (Listeners, Clusters) {
 
 	// ensure services are ordered to simplify generation logic
 	sort.Slice(services, func(i, j int) bool { return services[i].Hostname < services[j].Hostname })
@@ -211,11 +237,16 @@ func buildSidecarListenersClusters(
 	clusters := make(Clusters, 0)
 
 	if node.Type == model.Router {
+		// gateway listeners take precedence over outbound listeners on the same port
+		gatewayRoutes := buildGatewayHTTPRoutes(mesh, node, instances, services, config)
+		listeners = append(listeners, buildGatewayListeners(mesh, node, instances, config)...)
+		clusters = append(clusters, gatewayRoutes.clusters()...)
+
 		outbound, outClusters := buildOutboundListeners(mesh, node, instances, services, config)
 		listeners = append(listeners, outbound...)
 		clusters = append(clusters, outClusters...)
 	} else if mesh.ProxyListenPort > 0 {
-		inbound, inClusters := buildInboundListeners(mesh, node, instances, config)
+		inbound, inClusters := buildInboundListeners(mesh, node, instances, config, crlFile)
 		outbound, outClusters := buildOutboundListeners(mesh, node, instances, services, config)
 		mgmtListeners, mgmtClusters := buildMgmtPortListeners(mesh, managementPorts, node.IPAddress)
 
@@ -307,6 +338,21 @@ func buildRDSRoute(mesh *meshconfig.MeshConfig, node model.Node, routeName strin
 		return nil, errors.New("unrecognized node type")
 	}
 
+	// gateway routes take precedence over other routes for the same domains
+	if node.Type == model.Ingress || node.Type == model.Router {
+		instances, err := discovery.HostInstances(map[string]*model.Node{node.IPAddress: &node})
+		if err != nil {
+			return nil, err
+		}
+		services, err := discovery.Services()
+		if err != nil {
+			return nil, err
+		}
+		if gatewayConfigs := buildGatewayHTTPRoutes(mesh, node, instances, services, config); len(gatewayConfigs) > 0 {
+			httpConfigs = mergeHTTPRouteConfigs(gatewayConfigs, httpConfigs)
+		}
+	}
+
 	if routeName == RDSAll {
 		return httpConfigs.combine(), nil
 	}
@@ -413,14 +459,14 @@ func consolidateAuthPolicy(mesh *meshconfig.MeshConfig, serviceAuthPolicy meshco
 
 // mayApplyInboundAuth adds ssl_context to the listener if consolidateAuthPolicy.
 func mayApplyInboundAuth(listener *Listener, mesh *meshconfig.MeshConfig,
-	serviceAuthPolicy meshconfig.AuthenticationPolicy) {
+	serviceAuthPolicy meshconfig.AuthenticationPolicy, crlFile string) {
 	if consolidateAuthPolicy(mesh, serviceAuthPolicy) == meshconfig.AuthenticationPolicy_MUTUAL_TLS {
-		listener.SSLContext = buildListenerSSLContext(model.AuthCertsPath)
+		listener.SSLContext = buildListenerSSLContext(model.AuthCertsPath, crlFile)
 	}
 }
 
 // buildTCPListener constructs a listener for the TCP proxy
-// in addition, it enables mongo proxy filter based on the protocol
+// in addition, it enables mongo and redis proxy filters based on the protocol
 func buildTCPListener(tcpConfig *TCPRouteConfig, ip string, port int, protocol model.Protocol) *Listener {
 
 	baseTCPProxy := &NetworkFilter{
@@ -432,12 +478,6 @@ func buildTCPListener(tcpConfig *TCPRouteConfig, ip string, port int, protocol m
 		},
 	}
 
-	// Use Envoy's TCP proxy for TCP and Redis protocols. Currently, Envoy does not support CDS clusters
-	// for Redis proxy. Once Envoy supports CDS clusters, remove the following lines
-	if protocol == model.ProtocolRedis {
-		protocol = model.ProtocolTCP
-	}
-
 	switch protocol {
 	case model.ProtocolMongo:
 		// TODO: add a watcher for /var/lib/istio/mongo/certs
@@ -463,10 +503,14 @@ func buildTCPListener(tcpConfig *TCPRouteConfig, ip string, port int, protocol m
 		// cluster from the first route. The moment this route array
 		// has multiple routes, we need a fallback. For the moment,
 		// fallback to base TCP.
+		// The connections of the redis filter are pooled, so they
+		// carry no original destination: original_dst clusters
+		// fallback to base TCP as well.
 
 		// Unlike Mongo, Redis is a standalone filter, that is not
 		// stacked on top of tcp_proxy
-		if len(tcpConfig.Routes) == 1 {
+		if len(tcpConfig.Routes) == 1 && tcpConfig.Routes[0].clusterRef != nil &&
+			tcpConfig.Routes[0].clusterRef.Type != ClusterTypeOriginalDST {
 			return &Listener{
 				Name:    fmt.Sprintf("redis_%s_%d", ip, port),
 				Address: fmt.Sprintf("tcp://%s:%d", ip, port),
@@ -495,7 +539,7 @@ func buildTCPListener(tcpConfig *TCPRouteConfig, ip string, port int, protocol m
 // buildOutboundListeners combines HTTP routes and TCP listeners
 func buildOutboundListeners(mesh *meshconfig.MeshConfig, sidecar model.Node, instances []*model.ServiceInstance,
 	services []*model.Service, config model.IstioConfigStore) (Listeners, Clusters) {
-	listeners, clusters := buildOutboundTCPListeners(mesh, sidecar, services)
+	listeners, clusters := buildOutboundTCPListeners(mesh, sidecar, services, config)
 
 	egressTCPListeners, egressTCPClusters := buildEgressTCPListeners(mesh, sidecar, config)
 	listeners = append(listeners, egressTCPListeners...)
@@ -568,7 +612,9 @@ func buildDestinationHTTPRoutes(sidecar model.Node, service *model.Service,
 		if useDefaultRoute {
 			// default route for the destination is always the lowest priority route
 			cluster := buildOutboundCluster(service.Hostname, servicePort, nil, service.External())
-			routes = append(routes, buildDefaultRoute(cluster))
+			route := buildDefaultRoute(cluster)
+			applyHashPolicy(config, route, sidecar.Domain)
+			routes = append(routes, route)
 		}
 
 		return routes
@@ -638,7 +684,7 @@ func buildOutboundHTTPRoutes(mesh *meshconfig.MeshConfig, sidecar model.Node,
 // the connection's original destination. This avoids costly queries of instance
 // IPs and ports, but requires that ports of non-load balanced service be unique.
 func buildOutboundTCPListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
-	services []*model.Service) (Listeners, Clusters) {
+	services []*model.Service, config model.IstioConfigStore) (Listeners, Clusters) {
 	tcpListeners := make(Listeners, 0)
 	tcpClusters := make(Clusters, 0)
 
@@ -677,9 +723,9 @@ func buildOutboundTCPListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
 						tcpClusters = append(tcpClusters, cluster)
 					}
 					route := buildTCPRoute(cluster, nil)
-					config := &TCPRouteConfig{Routes: []*TCPRoute{route}}
-					listener := buildTCPListener(
-						config, WildcardAddress, servicePort.Port, servicePort.Protocol)
+					tcpConfig := &TCPRouteConfig{Routes: []*TCPRoute{route}}
+					listener := buildTCPListener(tcpConfig, WildcardAddress, servicePort.Port,
+						outboundTCPProtocol(config, cluster, servicePort.Protocol, sidecar.Domain))
 					if sidecar.Type == model.Router {
 						listener.BindToPort = true
 					}
@@ -687,9 +733,9 @@ func buildOutboundTCPListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
 				} else {
 					cluster := buildOutboundCluster(service.Hostname, servicePort, nil, service.External())
 					route := buildTCPRoute(cluster, []string{service.Address})
-					config := &TCPRouteConfig{Routes: []*TCPRoute{route}}
-					listener := buildTCPListener(
-						config, service.Address, servicePort.Port, servicePort.Protocol)
+					tcpConfig := &TCPRouteConfig{Routes: []*TCPRoute{route}}
+					listener := buildTCPListener(tcpConfig, service.Address, servicePort.Port,
+						outboundTCPProtocol(config, cluster, servicePort.Protocol, sidecar.Domain))
 					tcpClusters = append(tcpClusters, cluster)
 					tcpListeners = append(tcpListeners, listener)
 				}
@@ -700,12 +746,24 @@ func buildOutboundTCPListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
 	return tcpListeners, tcpClusters
 }
 
+// outboundTCPProtocol returns the protocol of the filters of an outbound TCP listener to the cluster.
+// The redis proxy spreads the commands over the members of the cluster by hashing their keys, so
+// it is only used when the destination rule of the service opts in to consistent hash load
+// balancing; Redis ports are proxied as TCP otherwise.
+func outboundTCPProtocol(config model.IstioConfigStore, cluster *Cluster, protocol model.Protocol,
+	domain string) model.Protocol {
+	if protocol == model.ProtocolRedis && consistentHash(config, cluster, domain) == nil {
+		return model.ProtocolTCP
+	}
+	return protocol
+}
+
 // buildInboundListeners creates listeners for the server-side (inbound)
 // configuration for co-located service instances. The function also returns
 // all inbound clusters since they are statically declared in the proxy
 // configuration and do not utilize CDS.
 func buildInboundListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
-	instances []*model.ServiceInstance, config model.IstioConfigStore) (Listeners, Clusters) {
+	instances []*model.ServiceInstance, config model.IstioConfigStore, crlFile string) (Listeners, Clusters) {
 	listeners := make(Listeners, 0, len(instances))
 	clusters := make(Clusters, 0, len(instances))
 
@@ -799,6 +857,10 @@ func buildInboundListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
 				endpoint.Port, "", false, IngressTraceOperation, false, config)
 
 		case model.ProtocolTCP, model.ProtocolHTTPS, model.ProtocolMongo, model.ProtocolRedis:
+			// the redis proxy only applies to outbound connections, see outboundTCPProtocol
+			if protocol == model.ProtocolRedis {
+				protocol = model.ProtocolTCP
+			}
 			listener = buildTCPListener(&TCPRouteConfig{
 				Routes: []*TCPRoute{buildTCPRoute(cluster, []string{endpoint.Address})},
 			}, endpoint.Address, endpoint.Port, protocol)
@@ -818,7 +880,7 @@ func buildInboundListeners(mesh *meshconfig.MeshConfig, sidecar model.Node,
 		}
 
 		if listener != nil {
-			mayApplyInboundAuth(listener, mesh, endpoint.ServicePort.AuthenticationPolicy)
+			mayApplyInboundAuth(listener, mesh, endpoint.ServicePort.AuthenticationPolicy, crlFile)
 			listeners = append(listeners, listener)
 		}
 	}
@@ -16,6 +16,7 @@ package envoy
 
 import (
 	"crypto/sha1"
+	"encoding/hex"
 	"io/ioutil"
 	"reflect"
 	"sort"
@@ -25,6 +26,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 
 	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pilot/pkg/config/memory"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/test/util"
 )
@@ -216,6 +218,131 @@ func TestTCPRouteConfigByRoute(t *testing.T) {
 	}
 }
 
+func TestBuildHealthCheck(t *testing.T) {
+	healthCheck := &model.HealthCheck{
+		Path:               "/health",
+		Interval:           5 * time.Second,
+		Timeout:            time.Second,
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 3,
+	}
+
+	const hostname = "hello.default.svc.cluster.local"
+	if got := buildHealthCheck(hostname, &model.Port{Name: "http", Port: 80, Protocol: model.ProtocolHTTP}); got != nil {
+		t.Errorf("got health check %#v for a port without health check", got)
+	}
+
+	got := buildHealthCheck(hostname, &model.Port{Name: "http", Port: 80, Protocol: model.ProtocolHTTP, HealthCheck: healthCheck})
+	want := &HealthCheck{
+		Type:               HealthCheckTypeHTTP,
+		TimeoutMS:          1000,
+		IntervalMS:         5000,
+		UnhealthyThreshold: 3,
+		HealthyThreshold:   2,
+		Path:               "/health",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got HTTP health check %#v, want %#v", got, want)
+	}
+
+	got = buildHealthCheck(hostname, &model.Port{Name: "mongo", Port: 27017, Protocol: model.ProtocolMongo, HealthCheck: healthCheck})
+	if got.Type != HealthCheckTypeTCP || got.Path != "" || got.Send == nil || got.Receive == nil || len(*got.Receive) != 0 {
+		t.Errorf("got TCP health check %#v, want a connection check", got)
+	}
+
+	// a status other than 200 is looked for in the response to a request sent over TCP.
+	noContent := *healthCheck
+	noContent.ExpectedStatuses = []int{204}
+	got = buildHealthCheck(hostname, &model.Port{Name: "http", Port: 80, Protocol: model.ProtocolHTTP, HealthCheck: &noContent})
+	request := "GET /health HTTP/1.1\r\nHost: " + hostname + "\r\nUser-Agent: Envoy/HC\r\nConnection: close\r\n\r\n"
+	if got.Type != HealthCheckTypeTCP || got.Path != "" ||
+		!reflect.DeepEqual(*got.Send, []HealthCheckPayload{{Binary: hex.EncodeToString([]byte(request))}}) ||
+		!reflect.DeepEqual(*got.Receive, []HealthCheckPayload{{Binary: hex.EncodeToString([]byte("HTTP/1.1 204 "))}}) {
+		t.Errorf("got health check %#v, want a request over TCP expecting 204", got)
+	}
+
+	got = buildHealthCheck(hostname, &model.Port{Name: "grpc", Port: 80, Protocol: model.ProtocolGRPC, HealthCheck: &noContent})
+	if got.Type != HealthCheckTypeTCP || len(*got.Send) != 0 {
+		t.Errorf("got health check %#v, want a connection check", got)
+	}
+}
+
+func TestBuildTCPListenerProtocols(t *testing.T) {
+	redisPort := &model.Port{Name: "redis", Port: 6379, Protocol: model.ProtocolRedis}
+	redisCluster := buildOutboundCluster("redis.default.svc.cluster.local", redisPort, nil, false)
+	origDstCluster := buildOriginalDSTCluster("orig-dst-cluster-tcp", nil)
+
+	cases := []struct {
+		name     string
+		cluster  *Cluster
+		protocol model.Protocol
+		want     []string
+	}{
+		{
+			name:     "tcp",
+			cluster:  redisCluster,
+			protocol: model.ProtocolTCP,
+			want:     []string{TCPProxyFilter},
+		},
+		{
+			name:     "mongo",
+			cluster:  redisCluster,
+			protocol: model.ProtocolMongo,
+			want:     []string{MongoProxyFilter, TCPProxyFilter},
+		},
+		{
+			name:     "redis",
+			cluster:  redisCluster,
+			protocol: model.ProtocolRedis,
+			want:     []string{RedisProxyFilter},
+		},
+		{
+			name:     "redis to original destination",
+			cluster:  origDstCluster,
+			protocol: model.ProtocolRedis,
+			want:     []string{TCPProxyFilter},
+		},
+	}
+
+	for _, c := range cases {
+		config := &TCPRouteConfig{Routes: []*TCPRoute{buildTCPRoute(c.cluster, nil)}}
+		listener := buildTCPListener(config, WildcardAddress, 6379, c.protocol)
+		got := make([]string, 0, len(listener.Filters))
+		for _, filter := range listener.Filters {
+			got = append(got, filter.Name)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got filters %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestBuildOutboundTCPListenersRedis(t *testing.T) {
+	mesh := makeMeshConfig()
+	sidecar := model.Node{Type: model.Sidecar, Domain: "default.svc.cluster.local"}
+	services := []*model.Service{{
+		Hostname: "redis.default.svc.cluster.local",
+		Address:  "10.1.0.1",
+		Ports:    model.PortList{{Name: "redis", Port: 6379, Protocol: model.ProtocolRedis}},
+	}}
+
+	registry := memory.Make(model.IstioConfigTypes)
+	config := model.MakeIstioStore(registry)
+	listeners, clusters := buildOutboundTCPListeners(&mesh, sidecar, services, config)
+	if len(listeners) != 1 || len(listeners[0].Filters) != 1 || listeners[0].Filters[0].Name != TCPProxyFilter {
+		t.Errorf("got listeners %#v, want a tcp_proxy listener without a destination rule", listeners)
+	}
+	if len(clusters) != 1 || clusters[0].LbType == LbTypeRingHash {
+		t.Errorf("got clusters %#v, want a cluster without ring hash load balancing", clusters)
+	}
+
+	addConfig(registry, destinationRuleRedisConsistentHash, t)
+	listeners, _ = buildOutboundTCPListeners(&mesh, sidecar, services, config)
+	if len(listeners) != 1 || len(listeners[0].Filters) != 1 || listeners[0].Filters[0].Name != RedisProxyFilter {
+		t.Errorf("got listeners %#v, want a redis_proxy listener with a consistent hash destination rule", listeners)
+	}
+}
+
 type fileConfig struct {
 	meta model.ConfigMeta
 	file string
@@ -242,6 +369,16 @@ var (
 		file: "testdata/destination-hello-v1alpha2.yaml.golden",
 	}
 
+	destinationRuleWorldConsistentHash = fileConfig{
+		meta: model.ConfigMeta{Type: model.DestinationRule.Type, Name: "destination-world-consistent-hash"},
+		file: "testdata/destination-world-consistent-hash-v1alpha2.yaml.golden",
+	}
+
+	destinationRuleRedisConsistentHash = fileConfig{
+		meta: model.ConfigMeta{Type: model.DestinationRule.Type, Name: "destination-redis-consistent-hash"},
+		file: "testdata/destination-redis-consistent-hash-v1alpha2.yaml.golden",
+	}
+
 	cbPolicy = fileConfig{
 		meta: model.ConfigMeta{Type: model.DestinationPolicy.Type, Name: "circuit-breaker"},
 		file: "testdata/cb-policy.yaml.golden",
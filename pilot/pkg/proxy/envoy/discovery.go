@@ -17,6 +17,7 @@ package envoy
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -24,8 +25,7 @@ import (
 	"net/http/pprof"
 	"sort"
 	"strconv"
-	"sync"
-	"sync/atomic"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
 	_ "github.com/golang/glog" // TODO(nmittler): Remove this
@@ -43,6 +43,7 @@ const (
 	metricsSubsystem     = "discovery"
 	metricLabelCacheName = "cache_name"
 	metricLabelMethod    = "method"
+	metricLabelReason    = "reason"
 	metricBuildVersion   = "build_version"
 )
 
@@ -126,133 +127,16 @@ type DiscoveryService struct {
 	server          *http.Server
 	webhookClient   *http.Client
 	webhookEndpoint string
-	// TODO Profile and optimize cache eviction policy to avoid
-	// flushing the entire cache when any route, service, or endpoint
-	// changes. An explicit cache expiration policy should be
-	// considered with this change to avoid memory exhaustion as the
-	// entire cache will no longer be periodically flushed and stale
-	// entries can linger in the cache indefinitely.
+	// Cached responses are invalidated selectively based on the services
+	// and proxies they depend on, see cacheDependencies.
 	sdsCache *discoveryCache
 	cdsCache *discoveryCache
 	rdsCache *discoveryCache
 	ldsCache *discoveryCache
-}
-
-type discoveryCacheStatEntry struct {
-	Hit  uint64 `json:"hit"`
-	Miss uint64 `json:"miss"`
-}
-
-type discoveryCacheStats struct {
-	Stats map[string]*discoveryCacheStatEntry `json:"cache_stats"`
-}
-
-type discoveryCacheEntry struct {
-	data          []byte
-	hit           uint64 // atomic
-	miss          uint64 // atomic
-	resourceCount uint32
-}
-
-type discoveryCache struct {
-	name     string
-	disabled bool
-	mu       sync.RWMutex
-	cache    map[string]*discoveryCacheEntry
-}
-
-func newDiscoveryCache(name string, enabled bool) *discoveryCache {
-	return &discoveryCache{
-		name:     name,
-		disabled: !enabled,
-		cache:    make(map[string]*discoveryCacheEntry),
-	}
-}
-
-func (c *discoveryCache) cachedDiscoveryResponse(key string) ([]byte, uint32, bool) {
-	if c.disabled {
-		return nil, 0, false
-	}
-
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Miss - entry.miss is updated in updateCachedDiscoveryResponse
-	entry, ok := c.cache[key]
-	if !ok || entry.data == nil {
-		return nil, 0, false
-	}
-
-	// Hit
-	atomic.AddUint64(&entry.hit, 1)
-	cacheHitCounter.With(c.cacheSizeLabels()).Inc()
-	return entry.data, entry.resourceCount, true
-}
-
-func (c *discoveryCache) updateCachedDiscoveryResponse(key string, resourceCount uint32, data []byte) {
-	if c.disabled {
-		return
-	}
-
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	entry, ok := c.cache[key]
-	var cacheSizeDelta float64
-	if !ok {
-		entry = &discoveryCacheEntry{}
-		c.cache[key] = entry
-		cacheSizeDelta = float64(len(key) + len(data))
-	} else if entry.data != nil {
-		cacheSizeDelta = float64(len(data) - len(entry.data))
-		log.Warnf("Overriding cached data for entry %v", key)
-	}
-	entry.resourceCount = resourceCount
-	entry.data = data
-	atomic.AddUint64(&entry.miss, 1)
-	cacheMissCounter.With(c.cacheSizeLabels()).Inc()
-	cacheSizeGauge.With(c.cacheSizeLabels()).Add(cacheSizeDelta)
-}
-
-func (c *discoveryCache) clear() {
-	// Reset the cache size metric for this cache.
-	cacheSizeGauge.Delete(c.cacheSizeLabels())
-
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	for _, v := range c.cache {
-		v.data = nil
-	}
-}
-
-func (c *discoveryCache) resetStats() {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	for _, v := range c.cache {
-		atomic.StoreUint64(&v.hit, 0)
-		atomic.StoreUint64(&v.miss, 0)
-	}
-}
-
-func (c *discoveryCache) stats() map[string]*discoveryCacheStatEntry {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	stats := make(map[string]*discoveryCacheStatEntry, len(c.cache))
-	for k, v := range c.cache {
-		stats[k] = &discoveryCacheStatEntry{
-			Hit:  atomic.LoadUint64(&v.hit),
-			Miss: atomic.LoadUint64(&v.miss),
-		}
-	}
-	return stats
-}
 
-func (c *discoveryCache) cacheSizeLabels() prometheus.Labels {
-	return prometheus.Labels{
-		metricLabelCacheName: c.name,
-		metricBuildVersion:   buildVersion,
-	}
+	// ads serves the v2 aggregated discovery API alongside the v1 REST APIs.
+	// It is nil unless a gRPC port is configured.
+	ads *ADSServer
 }
 
 type hosts struct {
@@ -294,21 +178,30 @@ const (
 // service instance.
 type DiscoveryServiceOptions struct {
 	Port            int
+	GrpcPort        int
 	MonitoringPort  int
 	EnableProfiling bool
 	EnableCaching   bool
+	CacheMaxEntries int
+	CacheTTL        time.Duration
 	WebhookEndpoint string
 }
 
 // NewDiscoveryService creates an Envoy discovery service on a given port
 func NewDiscoveryService(ctl model.Controller, configCache model.ConfigStoreCache,
 	environment model.Environment, o DiscoveryServiceOptions) (*DiscoveryService, error) {
+	// The webhook rewrites the v1 responses, the resources pushed over ADS would silently diverge from them.
+	if o.WebhookEndpoint != "" && o.GrpcPort > 0 {
+		return nil, errors.New("the webhook is not applied to the ADS resources, " +
+			"disable ADS with --grpcPort=0 to use --webhookEndpoint")
+	}
+
 	out := &DiscoveryService{
 		Environment: environment,
-		sdsCache:    newDiscoveryCache("sds", o.EnableCaching),
-		cdsCache:    newDiscoveryCache("cds", o.EnableCaching),
-		rdsCache:    newDiscoveryCache("rds", o.EnableCaching),
-		ldsCache:    newDiscoveryCache("lds", o.EnableCaching),
+		sdsCache:    newDiscoveryCache("sds", o.EnableCaching, o.CacheMaxEntries, o.CacheTTL),
+		cdsCache:    newDiscoveryCache("cds", o.EnableCaching, o.CacheMaxEntries, o.CacheTTL),
+		rdsCache:    newDiscoveryCache("rds", o.EnableCaching, o.CacheMaxEntries, o.CacheTTL),
+		ldsCache:    newDiscoveryCache("lds", o.EnableCaching, o.CacheMaxEntries, o.CacheTTL),
 	}
 
 	container := restful.NewContainer()
@@ -325,20 +218,28 @@ func NewDiscoveryService(ctl model.Controller, configCache model.ConfigStoreCach
 
 	out.server = &http.Server{Addr: ":" + strconv.Itoa(o.Port), Handler: container}
 
-	// Flush cached discovery responses whenever services, service
-	// instances, or routing configuration changes.
-	serviceHandler := func(*model.Service, model.Event) { out.clearCache() }
+	if o.GrpcPort > 0 {
+		out.ads = NewADSServer(environment, o.GrpcPort)
+	}
+
+	// Invalidate the affected cached discovery responses and push new
+	// configuration to ADS clients whenever services, service instances, or
+	// routing configuration changes.
+	serviceHandler := func(svc *model.Service, _ model.Event) { out.serviceChanged(svc) }
 	if err := ctl.AppendServiceHandler(serviceHandler); err != nil {
 		return nil, err
 	}
-	instanceHandler := func(*model.ServiceInstance, model.Event) { out.clearCache() }
+	instanceHandler := func(instance *model.ServiceInstance, _ model.Event) { out.instanceChanged(instance) }
 	if err := ctl.AppendInstanceHandler(instanceHandler); err != nil {
 		return nil, err
 	}
 
 	if configCache != nil {
-		configHandler := func(model.Config, model.Event) { out.clearCache() }
+		configHandler := func(model.Config, model.Event) { out.configChanged() }
 		configCache.RegisterEventHandler(model.RouteRule.Type, configHandler)
+		configCache.RegisterEventHandler(model.V1alpha2RouteRule.Type, configHandler)
+		configCache.RegisterEventHandler(model.DestinationRule.Type, configHandler)
+		configCache.RegisterEventHandler(model.Gateway.Type, configHandler)
 		configCache.RegisterEventHandler(model.IngressRule.Type, configHandler)
 		configCache.RegisterEventHandler(model.EgressRule.Type, configHandler)
 		configCache.RegisterEventHandler(model.DestinationPolicy.Type, configHandler)
@@ -439,6 +340,13 @@ func (ds *DiscoveryService) Start(stop chan struct{}) (net.Addr, error) {
 		return nil, err
 	}
 
+	if ds.ads != nil {
+		if _, err = ds.ads.Start(stop); err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+	}
+
 	go func() {
 		go func() {
 			if err := ds.server.Serve(listener); err != nil {
@@ -488,10 +396,94 @@ func (ds *DiscoveryService) ClearCacheStats(_ *restful.Request, _ *restful.Respo
 
 func (ds *DiscoveryService) clearCache() {
 	log.Infof("Cleared discovery service cache")
-	ds.sdsCache.clear()
-	ds.cdsCache.clear()
-	ds.rdsCache.clear()
-	ds.ldsCache.clear()
+	ds.clearCacheWithReason(invalidationFlush)
+}
+
+// serviceChanged invalidates the endpoints of the service and all proxy
+// configuration, since outbound listeners, routes and clusters are built
+// from the complete set of services.
+func (ds *DiscoveryService) serviceChanged(svc *model.Service) {
+	if svc != nil {
+		ds.sdsCache.invalidate(invalidationService, []string{svc.Hostname}, nil)
+	} else {
+		ds.sdsCache.clear(invalidationService)
+	}
+	ds.cdsCache.clear(invalidationService)
+	ds.rdsCache.clear(invalidationService)
+	ds.ldsCache.clear(invalidationService)
+	ds.pushADS()
+}
+
+// instanceChanged invalidates the endpoints of the instance's service and the
+// configuration of proxies co-located with instances of that service. Some
+// registries report aggregate instance changes without an endpoint address, in
+// which case proxies that had no instances when their configuration was
+// computed are invalidated as well.
+func (ds *DiscoveryService) instanceChanged(instance *model.ServiceInstance) {
+	if instance == nil || instance.Service == nil {
+		ds.clearCacheWithReason(invalidationInstance)
+		return
+	}
+
+	services := []string{instance.Service.Hostname}
+	var nodeIPs []string
+	if instance.Endpoint.Address != "" {
+		nodeIPs = []string{instance.Endpoint.Address}
+	} else {
+		services = append(services, proxyWithoutInstances)
+	}
+
+	ds.sdsCache.invalidate(invalidationInstance, services[:1], nil)
+	ds.cdsCache.invalidate(invalidationInstance, services, nodeIPs)
+	ds.rdsCache.invalidate(invalidationInstance, services, nodeIPs)
+	ds.ldsCache.invalidate(invalidationInstance, services, nodeIPs)
+	ds.pushADS()
+}
+
+// configChanged invalidates all proxy configuration. Endpoints do not depend on
+// routing configuration and are retained.
+func (ds *DiscoveryService) configChanged() {
+	ds.cdsCache.clear(invalidationConfig)
+	ds.rdsCache.clear(invalidationConfig)
+	ds.ldsCache.clear(invalidationConfig)
+	ds.pushADS()
+}
+
+func (ds *DiscoveryService) clearCacheWithReason(reason string) {
+	ds.sdsCache.clear(reason)
+	ds.cdsCache.clear(reason)
+	ds.rdsCache.clear(reason)
+	ds.ldsCache.clear(reason)
+	ds.pushADS()
+}
+
+func (ds *DiscoveryService) pushADS() {
+	if ds.ads != nil {
+		ds.ads.Push()
+	}
+}
+
+// nodeDependencies returns the dependencies of configuration computed for a
+// proxy: its address and the services of its co-located instances.
+func (ds *DiscoveryService) nodeDependencies(node model.Node) cacheDependencies {
+	deps := cacheDependencies{nodeIP: node.IPAddress}
+	if node.Type == model.Ingress {
+		return deps
+	}
+
+	instances, err := ds.HostInstances(map[string]*model.Node{node.IPAddress: &node})
+	if err != nil || len(instances) == 0 {
+		deps.services = []string{proxyWithoutInstances}
+		return deps
+	}
+	seen := make(map[string]bool, len(instances))
+	for _, instance := range instances {
+		if !seen[instance.Service.Hostname] {
+			seen[instance.Service.Hostname] = true
+			deps.services = append(deps.services, instance.Service.Hostname)
+		}
+	}
+	return deps
 }
 
 // ListAllEndpoints responds with all Services and is not restricted to a single service-key
@@ -521,16 +513,7 @@ func (ds *DiscoveryService) ListAllEndpoints(_ *restful.Request, response *restf
 					return
 				}
 				for _, instance := range instances {
-					// Only set tags if theres an AZ to set, ensures nil tags when there isnt
-					var t *tags
-					if instance.AvailabilityZone != "" {
-						t = &tags{AZ: instance.AvailabilityZone}
-					}
-					hosts = append(hosts, &host{
-						Address: instance.Endpoint.Address,
-						Port:    instance.Endpoint.Port,
-						Tags:    t,
-					})
+					hosts = append(hosts, buildHost(instance))
 				}
 				services = append(services, &keyAndService{
 					Key:   service.Key(port, nil),
@@ -571,10 +554,7 @@ func (ds *DiscoveryService) ListEndpoints(request *restful.Request, response *re
 			return
 		}
 		for _, ep := range endpoints {
-			hostArray = append(hostArray, &host{
-				Address: ep.Endpoint.Address,
-				Port:    ep.Endpoint.Port,
-			})
+			hostArray = append(hostArray, buildHost(ep))
 		}
 		if out, err = json.MarshalIndent(hosts{Hosts: hostArray}, " ", " "); err != nil {
 			errorResponse(methodName, response, http.StatusInternalServerError, "EDS "+err.Error())
@@ -582,13 +562,34 @@ func (ds *DiscoveryService) ListEndpoints(request *restful.Request, response *re
 		}
 		resourceCount = uint32(len(endpoints))
 		if resourceCount > 0 {
-			ds.sdsCache.updateCachedDiscoveryResponse(key, resourceCount, out)
+			ds.sdsCache.updateCachedDiscoveryResponse(key, resourceCount, out,
+				cacheDependencies{services: []string{hostname}})
 		}
 	}
 	observeResources(methodName, resourceCount)
 	writeResponse(response, out)
 }
 
+// buildHost converts a service instance to an SDS host. The host is tagged with the availability
+// zone of the instance.
+//
+// Envoy only routes by zone if its bootstrap names a static local cluster holding the hosts of the
+// service of the proxy, which pilot-agent cannot build as it does not know the services of its pod.
+// Zone aware routing is therefore not enabled for v1 proxies, only the ADS proxies prefer the
+// endpoints of their zone.
+func buildHost(instance *model.ServiceInstance) *host {
+	// Only set tags if theres an AZ to set, ensures nil tags when there isnt
+	var t *tags
+	if instance.AvailabilityZone != "" {
+		t = &tags{AZ: instance.AvailabilityZone}
+	}
+	return &host{
+		Address: instance.Endpoint.Address,
+		Port:    instance.Endpoint.Port,
+		Tags:    t,
+	}
+}
+
 func (ds *DiscoveryService) parseDiscoveryRequest(request *restful.Request) (model.Node, error) {
 	nodeInfo := request.PathParameter(ServiceNode)
 	svcNode, err := model.ParseServiceNode(nodeInfo)
@@ -658,7 +659,15 @@ func (ds *DiscoveryService) ListClusters(request *restful.Request, response *res
 		resourceCount = uint32(len(clusters))
 		// TODO: BUG. if resourceCount is 0, but transformedOutput has added resources, the cache wont update
 		if resourceCount > 0 {
-			ds.cdsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput)
+			// Service accounts used for mutual TLS are derived from the
+			// instances of the destination service.
+			deps := ds.nodeDependencies(svcNode)
+			for _, cluster := range clusters {
+				if _, ok := cluster.SSLContext.(*SSLContextWithSAN); ok && cluster.outbound {
+					deps.services = append(deps.services, cluster.hostname)
+				}
+			}
+			ds.cdsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput, deps)
 		}
 	}
 
@@ -704,7 +713,8 @@ func (ds *DiscoveryService) ListListeners(request *restful.Request, response *re
 		resourceCount = uint32(len(listeners))
 		// TODO: Bug. If resourceCount is 0 but transformedOutput adds listeners, cache wont update
 		if resourceCount > 0 {
-			ds.ldsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput)
+			ds.ldsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput,
+				ds.nodeDependencies(svcNode))
 		}
 	}
 	observeResources(methodName, resourceCount)
@@ -751,7 +761,8 @@ func (ds *DiscoveryService) ListRoutes(request *restful.Request, response *restf
 		if routeConfig != nil && routeConfig.VirtualHosts != nil { //TODO: fix same bug as above.
 			resourceCount = uint32(len(routeConfig.VirtualHosts))
 			if resourceCount > 0 {
-				ds.rdsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput)
+				ds.rdsCache.updateCachedDiscoveryResponse(key, resourceCount, transformedOutput,
+					ds.nodeDependencies(svcNode))
 			}
 		}
 	}
@@ -141,6 +141,26 @@ func TestDiscoveryLDSWebHooks(t *testing.T) {
 	compareResponse(response, "testdata/lds-webhook.json", t)
 }
 
+func TestDiscoveryWebHookWithADS(t *testing.T) {
+	mesh := makeMeshConfig()
+	_, err := NewDiscoveryService(
+		&mockController{},
+		nil,
+		model.Environment{
+			ServiceDiscovery: mock.Discovery,
+			ServiceAccounts:  mock.Discovery,
+			IstioConfigStore: model.MakeIstioStore(memory.Make(model.IstioConfigTypes)),
+			Mesh:             &mesh,
+		},
+		DiscoveryServiceOptions{
+			GrpcPort:        15010,
+			WebhookEndpoint: "http://webhook",
+		})
+	if err == nil {
+		t.Fatal("NewDiscoveryService succeeded with both a webhook and ADS")
+	}
+}
+
 func TestDiscoveryCDSWebHooks(t *testing.T) {
 	url := fmt.Sprintf("/v1/clusters/%s/%s", "istio-proxy", mock.HelloProxyV0.ServiceNode())
 
@@ -296,6 +316,17 @@ func TestClusterDiscoveryCircuitBreaker(t *testing.T) {
 	}
 }
 
+func TestClusterDiscoveryConsistentHash(t *testing.T) {
+	_, registry, ds := commonSetup(t)
+	// add weighted rule to split into two clusters which hash the same header
+	addConfig(registry, weightedRouteRuleV2, t)
+	addConfig(registry, destinationRuleWorldConsistentHash, t)
+
+	url := fmt.Sprintf("/v1/clusters/%s/%s", "istio-proxy", mock.HelloProxyV0.ServiceNode())
+	response := makeDiscoveryRequest(ds, "GET", url, t)
+	compareResponse(response, "testdata/cds-consistent-hash.json", t)
+}
+
 func TestClusterDiscoveryEgressRedirect(t *testing.T) {
 	_, registry, ds := commonSetup(t)
 	addConfig(registry, egressRule, t)
@@ -465,6 +496,16 @@ func TestRouteDiscoveryWeighted(t *testing.T) {
 	}
 }
 
+func TestRouteDiscoveryConsistentHash(t *testing.T) {
+	_, registry, ds := commonSetup(t)
+	addConfig(registry, weightedRouteRuleV2, t)
+	addConfig(registry, destinationRuleWorldConsistentHash, t)
+
+	url := fmt.Sprintf("/v1/routes/80/%s/%s", "istio-proxy", mock.HelloProxyV0.ServiceNode())
+	response := makeDiscoveryRequest(ds, "GET", url, t)
+	compareResponse(response, "testdata/rds-consistent-hash.json", t)
+}
+
 func TestRouteDiscoveryFault(t *testing.T) {
 	for _, faultConfig := range []fileConfig{faultRouteRule, faultRouteRuleV2} {
 		_, registry, ds := commonSetup(t)
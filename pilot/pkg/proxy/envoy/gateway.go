@@ -0,0 +1,310 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	routingv2 "istio.io/api/routing/v1alpha2"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/log"
+)
+
+// gatewayPort is the set of gateway servers exposed on a single port of the proxy.
+type gatewayPort struct {
+	protocol model.Protocol
+	tls      *routingv2.Server_TLSOptions
+	servers  []*routingv2.Server
+}
+
+// buildGatewayPorts groups the servers of the gateways by port number. Envoy
+// v1 attaches a single TLS context to a listener, so the servers on a port
+// must agree on the protocol and TLS settings. Conflicting servers are dropped.
+func buildGatewayPorts(gateways []model.Config) map[int]*gatewayPort {
+	ports := make(map[int]*gatewayPort)
+	for _, gateway := range gateways {
+		spec := gateway.Spec.(*routingv2.Gateway)
+		for _, server := range spec.Servers {
+			if server.Port == nil || server.Port.Number == 0 {
+				log.Warnf("Gateway %s: omitting server %v without a port number", gateway.Key(), server.Hosts)
+				continue
+			}
+
+			number := int(server.Port.Number)
+			protocol := model.ConvertCaseInsensitiveStringToProtocol(server.Port.Protocol)
+			if server.Tls != nil && server.Tls.Mode == routingv2.Server_TLSOptions_PASSTHROUGH &&
+				!server.Tls.HttpsRedirect {
+				log.Warnf("Gateway %s: TLS passthrough is not supported on port %d", gateway.Key(), number)
+				continue
+			}
+			if !protocol.IsHTTP() && protocol != model.ProtocolHTTPS {
+				log.Warnf("Gateway %s: unsupported protocol %q on port %d", gateway.Key(), server.Port.Protocol, number)
+				continue
+			}
+
+			port, exists := ports[number]
+			if !exists {
+				ports[number] = &gatewayPort{
+					protocol: protocol,
+					tls:      terminatedTLS(server.Tls),
+					servers:  []*routingv2.Server{server},
+				}
+				continue
+			}
+			if port.protocol != protocol {
+				log.Warnf("Gateway %s: protocol %q on port %d conflicts with %q",
+					gateway.Key(), protocol, number, port.protocol)
+				continue
+			}
+			if tls := terminatedTLS(server.Tls); !tlsEquals(port.tls, tls) {
+				log.Warnf("Gateway %s: TLS settings on port %d conflict with another server", gateway.Key(), number)
+				continue
+			}
+			port.servers = append(port.servers, server)
+		}
+	}
+	return ports
+}
+
+// terminatedTLS returns the TLS options if the server terminates TLS.
+func terminatedTLS(tls *routingv2.Server_TLSOptions) *routingv2.Server_TLSOptions {
+	if tls == nil || tls.ServerCertificate == "" {
+		return nil
+	}
+	return tls
+}
+
+func tlsEquals(a, b *routingv2.Server_TLSOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Mode == b.Mode &&
+		a.ServerCertificate == b.ServerCertificate &&
+		a.PrivateKey == b.PrivateKey &&
+		a.CaCertificates == b.CaCertificates
+}
+
+// buildGatewaySSLContext translates the server TLS options to a listener TLS context.
+func buildGatewaySSLContext(tls *routingv2.Server_TLSOptions) *SSLContext {
+	ssl := &SSLContext{
+		CertChainFile:  tls.ServerCertificate,
+		PrivateKeyFile: tls.PrivateKey,
+		ALPNProtocols:  strings.Join(ListenersALPNProtocols, ","),
+	}
+	if tls.Mode == routingv2.Server_TLSOptions_MUTUAL {
+		ssl.CaCertFile = tls.CaCertificates
+		ssl.RequireClientCertificate = true
+	}
+	return ssl
+}
+
+// buildGatewayListeners produces the listeners for the gateways that apply to the proxy.
+// Listeners are HTTP listeners that use RDS with the port number as the route name.
+func buildGatewayListeners(mesh *meshconfig.MeshConfig, node model.Node,
+	instances []*model.ServiceInstance, config model.IstioConfigStore) Listeners {
+	listeners := make(Listeners, 0)
+	for number, port := range buildGatewayPorts(config.Gateways(instances)) {
+		listener := buildHTTPListener(mesh, node, instances, nil, WildcardAddress, number, strconv.Itoa(number),
+			true, IngressTraceOperation, false, config)
+		if port.tls != nil {
+			listener.SSLContext = buildGatewaySSLContext(port.tls)
+			listener.sniDomains = buildGatewaySNIDomains(port.servers)
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners.normalize()
+}
+
+// buildGatewaySNIDomains returns the server names that select the listener TLS
+// context, or nil if any server accepts all hosts.
+func buildGatewaySNIDomains(servers []*routingv2.Server) []string {
+	domains := make([]string, 0)
+	for _, server := range servers {
+		for _, host := range server.Hosts {
+			if host == "*" {
+				return nil
+			}
+			domains = append(domains, host)
+		}
+	}
+	sort.Strings(domains)
+	return domains
+}
+
+// buildGatewayHTTPRoutes produces the HTTP route configs, keyed by port, for
+// the v1alpha2 route rules bound to the gateways that apply to the proxy.
+// A rule is exposed on every server whose hosts match a host of the rule.
+func buildGatewayHTTPRoutes(mesh *meshconfig.MeshConfig, node model.Node,
+	instances []*model.ServiceInstance, services []*model.Service,
+	config model.IstioConfigStore) HTTPRouteConfigs {
+	gateways := config.Gateways(instances)
+	ports := buildGatewayPorts(gateways)
+
+	vhosts := make(map[int]map[string][]*HTTPRoute)
+	redirects := make(map[int]map[string]bool)
+	for _, gateway := range gateways {
+		for _, rule := range config.RouteRulesByGateway(gateway.Namespace, gateway.Name) {
+			spec := rule.Spec.(*routingv2.RouteRule)
+			service, servicePort := gatewayDestination(spec, services, node.Domain)
+			if service == nil {
+				log.Warnf("Gateway %s: cannot find an HTTP destination service for route rule %s",
+					gateway.Key(), rule.Key())
+				continue
+			}
+
+			routes := buildHTTPRoutesV2(config, rule, service, servicePort, instances, node.Domain)
+			for _, route := range routes {
+				// enable mixer check on the route
+				if mesh.MixerAddress != "" {
+					route.OpaqueConfig = buildMixerOpaqueConfig(!mesh.DisablePolicyChecks, true, service.Hostname)
+				}
+			}
+
+			for number, port := range ports {
+				for _, host := range spec.Hosts {
+					server := port.serverFor(host)
+					if server == nil {
+						continue
+					}
+					if vhosts[number] == nil {
+						vhosts[number] = make(map[string][]*HTTPRoute)
+						redirects[number] = make(map[string]bool)
+					}
+					vhosts[number][host] = append(vhosts[number][host], routes...)
+					if server.Tls != nil && server.Tls.HttpsRedirect {
+						redirects[number][host] = true
+					}
+				}
+			}
+		}
+	}
+
+	configs := make(HTTPRouteConfigs)
+	for number, hosts := range vhosts {
+		rc := configs.EnsurePort(number)
+		for host, routes := range hosts {
+			sort.Sort(RoutesByPath(routes))
+			vhost := &VirtualHost{
+				Name:    host,
+				Domains: buildIngressVhostDomains(host, number),
+				Routes:  routes,
+			}
+			if redirects[number][host] {
+				vhost.RequireSSL = RequireSSLAll
+			}
+			rc.VirtualHosts = append(rc.VirtualHosts, vhost)
+		}
+	}
+	return configs.normalize()
+}
+
+// gatewayDestination returns the service and the HTTP port that default routes
+// of the rule are sent to. The service is named by a host of the rule or, for
+// rules that expose external host names, by the first route destination.
+func gatewayDestination(rule *routingv2.RouteRule, services []*model.Service,
+	domain string) (*model.Service, *model.Port) {
+	names := make([]string, 0, len(rule.Hosts))
+	names = append(names, rule.Hosts...)
+	for _, http := range rule.Http {
+		for _, dst := range http.Route {
+			if dst.Destination != nil {
+				names = append(names, dst.Destination.Name)
+			}
+		}
+	}
+
+	for _, name := range names {
+		hostname := model.ResolveFQDN(name, domain)
+		for _, service := range services {
+			if service.Hostname != hostname {
+				continue
+			}
+			for _, port := range service.Ports {
+				if port.Protocol.IsHTTP() {
+					return service, port
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// serverFor returns the first server on the port that exposes the host.
+func (port *gatewayPort) serverFor(host string) *routingv2.Server {
+	for _, server := range port.servers {
+		if gatewayHostMatches(server.Hosts, host) {
+			return server
+		}
+	}
+	return nil
+}
+
+// gatewayHostMatches checks whether a host is exposed by a server with the given hosts.
+// Server hosts may be "*" or contain a leading wildcard label.
+func gatewayHostMatches(serverHosts []string, host string) bool {
+	for _, serverHost := range serverHosts {
+		switch {
+		case serverHost == "*" || serverHost == host:
+			return true
+		case strings.HasPrefix(serverHost, "*.") && strings.HasSuffix(host, serverHost[1:]):
+			return true
+		}
+	}
+	return false
+}
+
+// mergeHTTPRouteConfigs adds the virtual hosts of the second route configs to
+// the first. Domains already served by a virtual host of the first are omitted.
+func mergeHTTPRouteConfigs(configs, other HTTPRouteConfigs) HTTPRouteConfigs {
+	out := make(HTTPRouteConfigs)
+	for port, rc := range configs {
+		out.EnsurePort(port).VirtualHosts = append(out.EnsurePort(port).VirtualHosts, rc.VirtualHosts...)
+	}
+
+	for port, rc := range other {
+		merged := out.EnsurePort(port)
+		domains := make(map[string]bool)
+		for _, vhost := range merged.VirtualHosts {
+			for _, domain := range vhost.Domains {
+				domains[domain] = true
+			}
+		}
+
+		for _, vhost := range rc.VirtualHosts {
+			filtered := make([]string, 0, len(vhost.Domains))
+			for _, domain := range vhost.Domains {
+				if domains[domain] {
+					log.Warnf("Omitting domain %q of virtual host %s on port %d due to collision with gateway",
+						domain, vhost.Name, port)
+					continue
+				}
+				filtered = append(filtered, domain)
+			}
+			if len(filtered) == 0 {
+				continue
+			}
+			if len(filtered) < len(vhost.Domains) {
+				copied := *vhost
+				copied.Domains = filtered
+				vhost = &copied
+			}
+			merged.VirtualHosts = append(merged.VirtualHosts, vhost)
+		}
+	}
+	return out.normalize()
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	routingv2 "istio.io/api/routing/v1alpha2"
+	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/proxy/envoy/mock"
+)
+
+func makeGatewayStore(t *testing.T, gatewayLabels map[string]string) model.IstioConfigStore {
+	store := model.MakeIstioStore(memory.Make(model.IstioConfigTypes))
+	configs := []struct {
+		typ    string
+		name   string
+		labels map[string]string
+		spec   proto.Message
+	}{
+		{model.Gateway.Type, "ingress", gatewayLabels, &routingv2.Gateway{
+			Servers: []*routingv2.Server{
+				{
+					Hosts: []string{"*"},
+					Port:  &routingv2.Port{Number: 8080, Protocol: "HTTP"},
+					Tls:   &routingv2.Server_TLSOptions{HttpsRedirect: true},
+				},
+				{
+					Hosts: []string{"hello.example.com"},
+					Port:  &routingv2.Port{Number: 8443, Protocol: "HTTPS"},
+					Tls: &routingv2.Server_TLSOptions{
+						Mode:              routingv2.Server_TLSOptions_MUTUAL,
+						ServerCertificate: "/etc/istio/certs/cert.pem",
+						PrivateKey:        "/etc/istio/certs/key.pem",
+						CaCertificates:    "/etc/istio/certs/ca.pem",
+					},
+				},
+				{
+					Hosts: []string{"*"},
+					Port:  &routingv2.Port{Number: 9000, Protocol: "TCP"},
+				},
+			},
+		}},
+		{model.V1alpha2RouteRule.Type, "hello", nil, &routingv2.RouteRule{
+			Hosts:    []string{"hello.example.com"},
+			Gateways: []string{"ingress"},
+			Http: []*routingv2.HTTPRoute{{
+				Route: []*routingv2.DestinationWeight{{
+					Destination: &routingv2.Destination{Name: "hello"},
+				}},
+			}},
+		}},
+		{model.V1alpha2RouteRule.Type, "world", nil, &routingv2.RouteRule{
+			Hosts: []string{"world"},
+		}},
+	}
+	for _, c := range configs {
+		config := model.Config{
+			ConfigMeta: model.ConfigMeta{
+				Type:      c.typ,
+				Name:      c.name,
+				Namespace: "default",
+				Domain:    "cluster.local",
+				Labels:    c.labels,
+			},
+			Spec: c.spec,
+		}
+		if _, err := store.Create(config); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func TestBuildGatewayListeners(t *testing.T) {
+	mesh := makeMeshConfig()
+	mesh.MixerAddress = ""
+	instance := mock.MakeInstance(mock.HelloService, mock.PortHTTP, 0, "")
+	store := makeGatewayStore(t, instance.Labels)
+
+	listeners := buildGatewayListeners(&mesh, mock.Ingress, []*model.ServiceInstance{instance}, store)
+	if len(listeners) != 2 {
+		t.Fatalf("got %d listeners, want 2: %v", len(listeners), listeners)
+	}
+
+	plain := listeners.GetByAddress("tcp://0.0.0.0:8080")
+	if plain == nil || plain.SSLContext != nil {
+		t.Errorf("got plain text listener %v", plain)
+	}
+
+	tls := listeners.GetByAddress("tcp://0.0.0.0:8443")
+	if tls == nil {
+		t.Fatalf("missing TLS listener in %v", listeners)
+	}
+	if tls.SSLContext == nil || tls.SSLContext.CertChainFile != "/etc/istio/certs/cert.pem" ||
+		tls.SSLContext.CaCertFile != "/etc/istio/certs/ca.pem" || !tls.SSLContext.RequireClientCertificate {
+		t.Errorf("got TLS context %#v", tls.SSLContext)
+	}
+	if !reflect.DeepEqual(tls.sniDomains, []string{"hello.example.com"}) {
+		t.Errorf("got SNI domains %v", tls.sniDomains)
+	}
+
+	if out := buildGatewayListeners(&mesh, mock.Ingress, nil, store); len(out) != 0 {
+		t.Errorf("expected no listeners for proxy without matching labels, got %v", out)
+	}
+}
+
+func TestBuildGatewayHTTPRoutes(t *testing.T) {
+	mesh := makeMeshConfig()
+	store := makeGatewayStore(t, nil)
+	services := []*model.Service{mock.HelloService, mock.WorldService}
+
+	configs := buildGatewayHTTPRoutes(&mesh, mock.Ingress, nil, services, store)
+	if len(configs) != 2 {
+		t.Fatalf("got route configs for ports %v, want 8080 and 8443", configs)
+	}
+	for _, port := range []int{8080, 8443} {
+		rc := configs[port]
+		if rc == nil || len(rc.VirtualHosts) != 1 {
+			t.Fatalf("got route config %v for port %d", rc, port)
+		}
+		vhost := rc.VirtualHosts[0]
+		if vhost.Name != "hello.example.com" || len(vhost.Routes) != 1 {
+			t.Errorf("got virtual host %#v for port %d", vhost, port)
+		}
+		if vhost.Routes[0].Cluster != "out.hello.default.svc.cluster.local|http" {
+			t.Errorf("got cluster %q for port %d", vhost.Routes[0].Cluster, port)
+		}
+		if vhost.Routes[0].OpaqueConfig == nil {
+			t.Errorf("expected mixer config on route for port %d", port)
+		}
+	}
+	if configs[8080].VirtualHosts[0].RequireSSL != RequireSSLAll {
+		t.Error("expected HTTPS redirect on plain text port")
+	}
+	if configs[8443].VirtualHosts[0].RequireSSL != "" {
+		t.Error("expected no HTTPS redirect on TLS port")
+	}
+}
+
+func TestMergeHTTPRouteConfigs(t *testing.T) {
+	gateway := HTTPRouteConfigs{80: {VirtualHosts: []*VirtualHost{
+		{Name: "hello", Domains: []string{"hello", "hello:80"}},
+	}}}
+	other := HTTPRouteConfigs{
+		80: {VirtualHosts: []*VirtualHost{
+			{Name: "hello.default.svc.cluster.local|http", Domains: []string{"hello", "hello.default"}},
+			{Name: "world.default.svc.cluster.local|http", Domains: []string{"world"}},
+			{Name: "*", Domains: []string{"hello:80"}},
+		}},
+		81: {VirtualHosts: []*VirtualHost{{Name: "hello", Domains: []string{"hello"}}}},
+	}
+
+	out := mergeHTTPRouteConfigs(gateway, other)
+	var names []string
+	for _, vhost := range out[80].VirtualHosts {
+		names = append(names, vhost.Name)
+	}
+	want := []string{"hello", "hello.default.svc.cluster.local|http", "world.default.svc.cluster.local|http"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("got virtual hosts %v, want %v", names, want)
+	}
+	if domains := out[80].VirtualHosts[1].Domains; !reflect.DeepEqual(domains, []string{"hello.default"}) {
+		t.Errorf("got domains %v for colliding virtual host", domains)
+	}
+	if domains := other[80].VirtualHosts[0].Domains; len(domains) != 2 {
+		t.Errorf("expected input route configs to be unchanged, got domains %v", domains)
+	}
+	if len(out[81].VirtualHosts) != 1 {
+		t.Errorf("expected virtual hosts on other ports to be retained, got %v", out[81])
+	}
+}
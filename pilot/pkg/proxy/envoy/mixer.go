@@ -106,7 +106,7 @@ type FilterMixerConfig struct {
 func (*FilterMixerConfig) isNetworkFilterConfig() {}
 
 // buildMixerCluster builds an outbound mixer cluster
-func buildMixerCluster(mesh *meshconfig.MeshConfig, role model.Node, mixerSAN []string) *Cluster {
+func buildMixerCluster(mesh *meshconfig.MeshConfig, role model.Node, mixerSAN []string, crlFile string) *Cluster {
 	mixerCluster := buildCluster(mesh.MixerAddress, MixerCluster, mesh.ConnectTimeout)
 	mixerCluster.CircuitBreaker = &CircuitBreaker{
 		Default: DefaultCBPriority{
@@ -122,7 +122,7 @@ func buildMixerCluster(mesh *meshconfig.MeshConfig, role model.Node, mixerSAN []
 		// do nothing
 	case meshconfig.AuthenticationPolicy_MUTUAL_TLS:
 		// apply SSL context to enable mutual TLS between Envoy proxies between app and mixer
-		mixerCluster.SSLContext = buildClusterSSLContext(model.AuthCertsPath, mixerSAN)
+		mixerCluster.SSLContext = buildClusterSSLContext(model.AuthCertsPath, mixerSAN, crlFile)
 	}
 
 	return mixerCluster
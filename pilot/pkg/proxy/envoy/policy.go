@@ -18,6 +18,11 @@
 package envoy
 
 import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	routing "istio.io/api/routing/v1alpha1"
 	routingv2 "istio.io/api/routing/v1alpha2"
@@ -41,7 +46,8 @@ func applyClusterPolicy(cluster *Cluster,
 	config model.IstioConfigStore,
 	mesh *meshconfig.MeshConfig,
 	accounts model.ServiceAccounts,
-	domain string) {
+	domain string,
+	crlFile string) {
 	duration := protoDurationToMS(mesh.ConnectTimeout)
 	cluster.ConnectTimeoutMs = duration
 
@@ -50,6 +56,11 @@ func applyClusterPolicy(cluster *Cluster,
 		return
 	}
 
+	// Original DST clusters have no hosts to check.
+	if cluster.Type != ClusterTypeOriginalDST {
+		cluster.HealthCheck = buildHealthCheck(cluster.hostname, cluster.port)
+	}
+
 	// Original DST cluster are used to route to services outside the mesh
 	// where Istio auth does not apply.
 	if cluster.Type != ClusterTypeOriginalDST {
@@ -58,7 +69,7 @@ func applyClusterPolicy(cluster *Cluster,
 			// apply auth policies
 			ports := model.PortList{cluster.port}.GetNames()
 			serviceAccounts := accounts.GetIstioServiceAccounts(cluster.hostname, ports)
-			cluster.SSLContext = buildClusterSSLContext(model.AuthCertsPath, serviceAccounts)
+			cluster.SSLContext = buildClusterSSLContext(model.AuthCertsPath, serviceAccounts, crlFile)
 		}
 	}
 
@@ -131,11 +142,15 @@ func applyLoadBalancePolicy(cluster *Cluster, policy *routingv2.LoadBalancerSett
 	}
 
 	if consistent := policy.GetConsistentHash(); consistent != nil {
-		// cluster.LbType = LbTypeRingHash
-		// TODO: need to set special values in the route config
+		// the hash key is set by the hash policy of the routes to the cluster, see applyHashPolicy
 		// see: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/load_balancing.html#ring-hash
-		log.Warn("Consistent hash load balancing type is not currently supported")
+		cluster.LbType = LbTypeRingHash
+		cluster.RingHashLbConfig = nil
+		if consistent.MinimumRingSize > 0 {
+			cluster.RingHashLbConfig = &RingHashLbConfig{MinimumRingSize: consistent.MinimumRingSize}
+		}
 	} else {
+		cluster.RingHashLbConfig = nil
 		switch policy.GetSimple() {
 		case routingv2.LoadBalancerSettings_LEAST_CONN:
 			cluster.LbType = LbTypeLeastRequest
@@ -149,6 +164,51 @@ func applyLoadBalancePolicy(cluster *Cluster, policy *routingv2.LoadBalancerSett
 	}
 }
 
+// buildHealthCheck translates the health check of a port of the service to an active health check of
+// the cluster. Ports which do not carry HTTP traffic are checked for accepting TCP connections.
+//
+// Envoy HTTP health checks only accept 200 responses. An HTTP/1.1 port which expects another status
+// is checked by sending the request over TCP and looking for the status line in the response.
+func buildHealthCheck(hostname string, port *model.Port) *HealthCheck {
+	if port == nil || port.HealthCheck == nil {
+		return nil
+	}
+
+	hc := port.HealthCheck
+	out := &HealthCheck{
+		Type:               HealthCheckTypeTCP,
+		TimeoutMS:          int64(hc.Timeout / time.Millisecond),
+		IntervalMS:         int64(hc.Interval / time.Millisecond),
+		UnhealthyThreshold: hc.UnhealthyThreshold,
+		HealthyThreshold:   hc.HealthyThreshold,
+		Send:               &[]HealthCheckPayload{},
+		Receive:            &[]HealthCheckPayload{},
+	}
+	if !port.Protocol.IsHTTP() || hc.Path == "" {
+		return out
+	}
+
+	status := http.StatusOK
+	if len(hc.ExpectedStatuses) > 0 {
+		status = hc.ExpectedStatuses[0]
+	}
+	switch {
+	case status == http.StatusOK:
+		out.Type = HealthCheckTypeHTTP
+		out.Path = hc.Path
+		out.Send, out.Receive = nil, nil
+	case port.Protocol == model.ProtocolHTTP:
+		request := fmt.Sprintf("GET %s HTTP/1.1\r\nHost: %s\r\nUser-Agent: Envoy/HC\r\nConnection: close\r\n\r\n",
+			hc.Path, hostname)
+		out.Send = &[]HealthCheckPayload{{Binary: hex.EncodeToString([]byte(request))}}
+		out.Receive = &[]HealthCheckPayload{{Binary: hex.EncodeToString([]byte(fmt.Sprintf("HTTP/1.1 %d ", status)))}}
+	default:
+		log.Warnf("Health check of %s:%d only checks connections, the %v protocol does not support expected status %d",
+			hostname, port.Port, port.Protocol, status)
+	}
+	return out
+}
+
 func buildOutlierDetection(outlier *routingv2.OutlierDetection) *OutlierDetection {
 	if outlier != nil && outlier.Http != nil {
 		out := &OutlierDetection{
@@ -249,3 +309,52 @@ func applyDestinationRule(config model.IstioConfigStore, cluster *Cluster, domai
 		}
 	}
 }
+
+// consistentHash returns the consistent hash load balancer settings of the destination rule that applies
+// to the outbound cluster, if any. As in applyDestinationRule, the settings of the subset of the cluster
+// override the ones of the destination.
+func consistentHash(config model.IstioConfigStore, cluster *Cluster,
+	domain string) *routingv2.LoadBalancerSettings_ConsistentHashLB {
+	if !cluster.outbound || cluster.Type == ClusterTypeOriginalDST {
+		return nil
+	}
+
+	destinationRuleConfig := config.DestinationRule(cluster.hostname, domain)
+	if destinationRuleConfig == nil {
+		return nil
+	}
+	destinationRule := destinationRuleConfig.Spec.(*routingv2.DestinationRule)
+
+	lb := destinationRule.GetTrafficPolicy().GetLoadBalancer()
+	for _, subset := range destinationRule.Subsets {
+		if cluster.tags.Equals(subset.Labels) {
+			if subsetLb := subset.GetTrafficPolicy().GetLoadBalancer(); subsetLb != nil {
+				lb = subsetLb
+			}
+			break
+		}
+	}
+	return lb.GetConsistentHash()
+}
+
+// applyHashPolicy sets the hash policy of the route from the consistent hash load balancer settings of
+// the clusters it routes to. Envoy supports a single hash policy per route, so the clusters of a
+// weighted route are expected to hash the same header.
+func applyHashPolicy(config model.IstioConfigStore, route *HTTPRoute, domain string) {
+	if route.Redirect() {
+		return
+	}
+
+	for _, cluster := range route.clusters {
+		consistent := consistentHash(config, cluster, domain)
+		if consistent == nil {
+			continue
+		}
+		if route.HashPolicy == nil {
+			route.HashPolicy = &HashPolicy{HeaderName: consistent.HttpHeader}
+		} else if route.HashPolicy.HeaderName != consistent.HttpHeader {
+			log.Warnf("Cluster %s hashes header %q but the route hashes header %q", cluster.Name,
+				consistent.HttpHeader, route.HashPolicy.HeaderName)
+		}
+	}
+}
@@ -64,6 +64,12 @@ const (
 	// ClusterTypeSDS name for clusters of type 'sds'
 	ClusterTypeSDS = "sds"
 
+	// HealthCheckTypeHTTP name for HTTP health checks
+	HealthCheckTypeHTTP = "http"
+
+	// HealthCheckTypeTCP name for TCP health checks
+	HealthCheckTypeTCP = "tcp"
+
 	// LbTypeRoundRobin is the name for round-robin LB
 	LbTypeRoundRobin = "round_robin"
 
@@ -97,7 +103,7 @@ const (
 	// RedisProxyFilter is the name of the Redis Proxy network filter.
 	RedisProxyFilter = "redis_proxy"
 
-	// RedisDefaultOpTimeout is the op timeout used for Redis Proxy filter
+	// RedisDefaultOpTimeout is the timeout of each command sent through the Redis Proxy filter
 	// Currently it is set to 30s (conversion happens in the filter)
 	// TODO - Allow this to be configured.
 	RedisDefaultOpTimeout = 30 * time.Second
@@ -260,6 +266,12 @@ type Decorator struct {
 	Operation string `json:"operation"`
 }
 
+// HashPolicy definition
+// See: https://www.envoyproxy.io/docs/envoy/latest/api-v1/route_config/route.html#hash-policy
+type HashPolicy struct {
+	HeaderName string `json:"header_name"`
+}
+
 // HTTPRoute definition
 type HTTPRoute struct {
 	Runtime *Runtime `json:"runtime,omitempty"`
@@ -293,6 +305,8 @@ type HTTPRoute struct {
 
 	Decorator *Decorator `json:"decorator,omitempty"`
 
+	HashPolicy *HashPolicy `json:"hash_policy,omitempty"`
+
 	// clusters contains the set of referenced clusters in the route; the field is special
 	// and used only to aggregate cluster information after composing routes
 	clusters Clusters
@@ -382,11 +396,15 @@ type WeightedClusterEntry struct {
 	Weight int    `json:"weight"`
 }
 
+// RequireSSLAll requires all requests to a virtual host to use TLS
+const RequireSSLAll = "all"
+
 // VirtualHost definition
 type VirtualHost struct {
-	Name    string       `json:"name"`
-	Domains []string     `json:"domains"`
-	Routes  []*HTTPRoute `json:"routes"`
+	Name       string       `json:"name"`
+	Domains    []string     `json:"domains"`
+	Routes     []*HTTPRoute `json:"routes"`
+	RequireSSL string       `json:"require_ssl,omitempty"`
 }
 
 func (host *VirtualHost) clusters() Clusters {
@@ -442,8 +460,9 @@ func (routes HTTPRouteConfigs) combine() *HTTPRouteConfig {
 	for port, config := range routes {
 		for _, host := range config.VirtualHosts {
 			vhost := &VirtualHost{
-				Name:   host.Name,
-				Routes: host.Routes,
+				Name:       host.Name,
+				Routes:     host.Routes,
+				RequireSSL: host.RequireSSL,
 			}
 			for _, domain := range host.Domains {
 				if port == 80 || strings.Contains(domain, ":") {
@@ -629,6 +648,9 @@ type Listener struct {
 	SSLContext     *SSLContext      `json:"ssl_context,omitempty"`
 	BindToPort     bool             `json:"bind_to_port"`
 	UseOriginalDst bool             `json:"use_original_dst,omitempty"`
+
+	// sniDomains restrict the TLS context to the server names, used only in ADS
+	sniDomains []string
 }
 
 // Listeners is a collection of listeners
@@ -665,6 +687,7 @@ type SSLContext struct {
 	CaCertFile               string `json:"ca_cert_file,omitempty"`
 	RequireClientCertificate bool   `json:"require_client_certificate"`
 	ALPNProtocols            string `json:"alpn_protocols,omitempty"`
+	CrlFile                  string `json:"crl_file,omitempty"`
 }
 
 // SSLContextExternal definition
@@ -678,6 +701,7 @@ type SSLContextWithSAN struct {
 	PrivateKeyFile       string   `json:"private_key_file"`
 	CaCertFile           string   `json:"ca_cert_file,omitempty"`
 	VerifySubjectAltName []string `json:"verify_subject_alt_name"`
+	CrlFile              string   `json:"crl_file,omitempty"`
 }
 
 // Admin definition
@@ -704,6 +728,8 @@ type Cluster struct {
 	Features                 string            `json:"features,omitempty"`
 	CircuitBreaker           *CircuitBreaker   `json:"circuit_breakers,omitempty"`
 	OutlierDetection         *OutlierDetection `json:"outlier_detection,omitempty"`
+	HealthCheck              *HealthCheck      `json:"health_check,omitempty"`
+	RingHashLbConfig         *RingHashLbConfig `json:"ring_hash_lb_config,omitempty"`
 
 	// special values used by the post-processing passes for outbound mesh-local clusters
 	outbound bool
@@ -712,6 +738,12 @@ type Cluster struct {
 	tags     model.Labels
 }
 
+// RingHashLbConfig definition
+// See: https://www.envoyproxy.io/docs/envoy/latest/api-v1/cluster_manager/cluster_ring_hash_lb_config.html
+type RingHashLbConfig struct {
+	MinimumRingSize uint64 `json:"minimum_ring_size,omitempty"`
+}
+
 // CircuitBreaker definition
 // See: https://lyft.github.io/envoy/docs/configuration/cluster_manager/cluster_circuit_breakers.html#circuit-breakers
 type CircuitBreaker struct {
@@ -735,6 +767,27 @@ type OutlierDetection struct {
 	MaxEjectionPercent int   `json:"max_ejection_percent,omitempty"`
 }
 
+// HealthCheck definition
+// See: https://www.envoyproxy.io/docs/envoy/latest/api-v1/cluster_manager/cluster_hc.html
+type HealthCheck struct {
+	Type               string `json:"type"`
+	TimeoutMS          int64  `json:"timeout_ms"`
+	IntervalMS         int64  `json:"interval_ms"`
+	UnhealthyThreshold int    `json:"unhealthy_threshold"`
+	HealthyThreshold   int    `json:"healthy_threshold"`
+	Path               string `json:"path,omitempty"`
+
+	// TCP health checks require send and receive payloads, which are empty to only check that
+	// the connection is accepted.
+	Send    *[]HealthCheckPayload `json:"send,omitempty"`
+	Receive *[]HealthCheckPayload `json:"receive,omitempty"`
+}
+
+// HealthCheckPayload definition
+type HealthCheckPayload struct {
+	Binary string `json:"binary"`
+}
+
 // Clusters is a collection of clusters
 type Clusters []*Cluster
 
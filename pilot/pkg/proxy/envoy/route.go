@@ -40,23 +40,27 @@ const (
 )
 
 // buildListenerSSLContext returns an SSLContext struct.
-func buildListenerSSLContext(certsDir string) *SSLContext {
+// The peer certificates are checked against the CRL file if it is not empty.
+func buildListenerSSLContext(certsDir string, crlFile string) *SSLContext {
 	return &SSLContext{
 		CertChainFile:            path.Join(certsDir, model.CertChainFilename),
 		PrivateKeyFile:           path.Join(certsDir, model.KeyFilename),
 		CaCertFile:               path.Join(certsDir, model.RootCertFilename),
 		RequireClientCertificate: true,
+		CrlFile:                  crlFile,
 	}
 }
 
 // buildClusterSSLContext returns an SSLContextWithSAN struct with VerifySubjectAltName.
 // The list of service accounts may be empty but not nil.
-func buildClusterSSLContext(certsDir string, serviceAccounts []string) *SSLContextWithSAN {
+// The peer certificates are checked against the CRL file if it is not empty.
+func buildClusterSSLContext(certsDir string, serviceAccounts []string, crlFile string) *SSLContextWithSAN {
 	return &SSLContextWithSAN{
 		CertChainFile:        path.Join(certsDir, model.CertChainFilename),
 		PrivateKeyFile:       path.Join(certsDir, model.KeyFilename),
 		CaCertFile:           path.Join(certsDir, model.RootCertFilename),
 		VerifySubjectAltName: serviceAccounts,
+		CrlFile:              crlFile,
 	}
 }
 
@@ -338,6 +342,7 @@ func buildHTTPRoutesV2(store model.IstioConfigStore, config model.Config, servic
 		cluster := buildOutboundCluster(service.Hostname, port, nil, service.External())
 		route.Cluster = cluster.Name
 		route.clusters = append(route.clusters, cluster)
+		applyHashPolicy(store, route, domain)
 		routes = append(routes, route)
 	}
 
@@ -399,6 +404,7 @@ func buildHTTPRouteV2(store model.IstioConfigStore, config model.Config, service
 	route.CORSPolicy = buildCORSPolicy(http.CorsPolicy)
 	route.WebsocketUpgrade = http.WebsocketUpgrade
 	route.Decorator = buildDecorator(config)
+	applyHashPolicy(store, route, domain)
 
 	return route
 }
@@ -52,9 +52,20 @@ func TestSharedHost(t *testing.T) {
 
 func TestBuildListenerSSLContext(t *testing.T) {
 	const dir = "/some/testing/dir"
-	context := buildListenerSSLContext(dir)
+	context := buildListenerSSLContext(dir, "")
 	if !context.RequireClientCertificate {
 		t.Errorf("buildListenerSSLContext(%v) => Got RequireClientCertificate: %v, expected true.",
 			dir, context.RequireClientCertificate)
 	}
+	if context.CrlFile != "" {
+		t.Errorf("buildListenerSSLContext(%v) => Got CrlFile: %q, expected none.", dir, context.CrlFile)
+	}
+
+	const crlFile = "/some/testing/dir/crl.pem"
+	if context = buildListenerSSLContext(dir, crlFile); context.CrlFile != crlFile {
+		t.Errorf("buildListenerSSLContext(%v, %v) => Got CrlFile: %q.", dir, crlFile, context.CrlFile)
+	}
+	if cluster := buildClusterSSLContext(dir, []string{}, crlFile); cluster.CrlFile != crlFile {
+		t.Errorf("buildClusterSSLContext(%v, %v) => Got CrlFile: %q.", dir, crlFile, cluster.CrlFile)
+	}
 }
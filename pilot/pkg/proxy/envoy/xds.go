@@ -0,0 +1,583 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	xdsapi "github.com/envoyproxy/go-control-plane/api"
+	"github.com/gogo/protobuf/types"
+)
+
+// Translation of the v1 configuration structures produced by the config
+// generation passes into Envoy v2 xDS resources. Network and HTTP filters
+// keep their v1 JSON configuration and are passed to Envoy via the
+// `deprecated_v1` compatibility shim, except for the HTTP connection manager,
+// which is rewritten to fetch its route configuration over ADS.
+
+const (
+	// deprecatedV1Key is the filter config key that makes Envoy parse the
+	// accompanying value as v1 JSON configuration.
+	deprecatedV1Key = "deprecated_v1"
+)
+
+// v2FilterNames maps v1 filter names to their well-known v2 equivalents.
+var v2FilterNames = map[string]string{
+	HTTPConnectionManager: "envoy.http_connection_manager",
+	TCPProxyFilter:        "envoy.tcp_proxy",
+	MongoProxyFilter:      "envoy.mongo_proxy",
+	RedisProxyFilter:      "envoy.redis_proxy",
+	CORSFilter:            "envoy.cors",
+	router:                "envoy.router",
+	"fault":               "envoy.fault",
+}
+
+func v2FilterName(name string) string {
+	if v2, ok := v2FilterNames[name]; ok {
+		return v2
+	}
+	return name
+}
+
+// adsConfigSource points a dynamic resource at the aggregated discovery stream.
+func adsConfigSource() *xdsapi.ConfigSource {
+	return &xdsapi.ConfigSource{
+		ConfigSourceSpecifier: &xdsapi.ConfigSource_Ads{
+			Ads: &xdsapi.AggregatedConfigSource{},
+		},
+	}
+}
+
+// parseAddress splits a v1 address (e.g. tcp://0.0.0.0:80) into host and port.
+func parseAddress(address string) (string, uint32, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", 0, err
+	}
+	host, portStr, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, err
+	}
+	return host, uint32(port), nil
+}
+
+func buildV2Address(host string, port uint32) *xdsapi.Address {
+	return &xdsapi.Address{
+		Address: &xdsapi.Address_SocketAddress{
+			SocketAddress: &xdsapi.SocketAddress{
+				Protocol:      xdsapi.SocketAddress_TCP,
+				Address:       host,
+				PortSpecifier: &xdsapi.SocketAddress_PortValue{PortValue: port},
+			},
+		},
+	}
+}
+
+func buildV2DataSource(filename string) *xdsapi.DataSource {
+	return &xdsapi.DataSource{
+		Specifier: &xdsapi.DataSource_Filename{Filename: filename},
+	}
+}
+
+func uint32Value(v int) *types.UInt32Value {
+	if v <= 0 {
+		return nil
+	}
+	return &types.UInt32Value{Value: uint32(v)}
+}
+
+func msDuration(ms int64) *time.Duration {
+	if ms <= 0 {
+		return nil
+	}
+	d := time.Duration(ms) * time.Millisecond
+	return &d
+}
+
+// toStruct converts any JSON-serializable value into a protobuf Struct.
+func toStruct(v interface{}) (*types.Struct, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return mapToStruct(fields), nil
+}
+
+func mapToStruct(fields map[string]interface{}) *types.Struct {
+	out := &types.Struct{Fields: make(map[string]*types.Value, len(fields))}
+	for k, v := range fields {
+		out.Fields[k] = toValue(v)
+	}
+	return out
+}
+
+func toValue(v interface{}) *types.Value {
+	switch val := v.(type) {
+	case nil:
+		return &types.Value{Kind: &types.Value_NullValue{}}
+	case bool:
+		return &types.Value{Kind: &types.Value_BoolValue{BoolValue: val}}
+	case float64:
+		return &types.Value{Kind: &types.Value_NumberValue{NumberValue: val}}
+	case string:
+		return &types.Value{Kind: &types.Value_StringValue{StringValue: val}}
+	case []interface{}:
+		list := &types.ListValue{Values: make([]*types.Value, 0, len(val))}
+		for _, item := range val {
+			list.Values = append(list.Values, toValue(item))
+		}
+		return &types.Value{Kind: &types.Value_ListValue{ListValue: list}}
+	case map[string]interface{}:
+		return &types.Value{Kind: &types.Value_StructValue{StructValue: mapToStruct(val)}}
+	default:
+		return &types.Value{Kind: &types.Value_StringValue{StringValue: fmt.Sprint(val)}}
+	}
+}
+
+// deprecatedV1Config wraps a v1 filter configuration for consumption by a v2 Envoy.
+func deprecatedV1Config(config interface{}) (*types.Struct, error) {
+	return toStruct(map[string]interface{}{
+		deprecatedV1Key: true,
+		"value":         config,
+	})
+}
+
+// buildV2Cluster translates a v1 cluster. Clusters of type sds become EDS
+// clusters served over ADS with the v1 service key as the EDS service name.
+func buildV2Cluster(cluster *Cluster) (*xdsapi.Cluster, error) {
+	out := &xdsapi.Cluster{
+		Name:                     cluster.Name,
+		ConnectTimeout:           time.Duration(cluster.ConnectTimeoutMs) * time.Millisecond,
+		MaxRequestsPerConnection: uint32Value(cluster.MaxRequestsPerConnection),
+	}
+
+	switch cluster.Type {
+	case ClusterTypeSDS:
+		out.Type = xdsapi.Cluster_EDS
+		out.EdsClusterConfig = &xdsapi.Cluster_EdsClusterConfig{
+			EdsConfig:   adsConfigSource(),
+			ServiceName: cluster.ServiceName,
+		}
+	case ClusterTypeStrictDNS:
+		out.Type = xdsapi.Cluster_STRICT_DNS
+	case ClusterTypeStatic:
+		out.Type = xdsapi.Cluster_STATIC
+	case ClusterTypeOriginalDST:
+		out.Type = xdsapi.Cluster_ORIGINAL_DST
+	default:
+		return nil, fmt.Errorf("unsupported cluster type %q for cluster %s", cluster.Type, cluster.Name)
+	}
+
+	switch cluster.LbType {
+	case LbTypeRoundRobin, "":
+		out.LbPolicy = xdsapi.Cluster_ROUND_ROBIN
+	case LbTypeLeastRequest:
+		out.LbPolicy = xdsapi.Cluster_LEAST_REQUEST
+	case LbTypeRingHash:
+		out.LbPolicy = xdsapi.Cluster_RING_HASH
+	case LbTypeRandom:
+		out.LbPolicy = xdsapi.Cluster_RANDOM
+	case LbTypeOriginalDST:
+		out.LbPolicy = xdsapi.Cluster_ORIGINAL_DST_LB
+	default:
+		return nil, fmt.Errorf("unsupported load balancer type %q for cluster %s", cluster.LbType, cluster.Name)
+	}
+
+	for _, h := range cluster.Hosts {
+		host, port, err := parseAddress(h.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid host %q for cluster %s: %v", h.URL, cluster.Name, err)
+		}
+		out.Hosts = append(out.Hosts, buildV2Address(host, port))
+	}
+
+	if strings.Contains(cluster.Features, ClusterFeatureHTTP2) {
+		out.Http2ProtocolOptions = &xdsapi.Http2ProtocolOptions{}
+	}
+
+	if cb := cluster.CircuitBreaker; cb != nil {
+		out.CircuitBreakers = &xdsapi.CircuitBreakers{
+			Thresholds: []*xdsapi.CircuitBreakers_Thresholds{{
+				MaxConnections:     uint32Value(cb.Default.MaxConnections),
+				MaxPendingRequests: uint32Value(cb.Default.MaxPendingRequests),
+				MaxRequests:        uint32Value(cb.Default.MaxRequests),
+				MaxRetries:         uint32Value(cb.Default.MaxRetries),
+			}},
+		}
+	}
+
+	if od := cluster.OutlierDetection; od != nil {
+		out.OutlierDetection = &xdsapi.OutlierDetection{
+			Consecutive_5Xx:    uint32Value(od.ConsecutiveErrors),
+			Interval:           msDuration(od.IntervalMS),
+			BaseEjectionTime:   msDuration(od.BaseEjectionTimeMS),
+			MaxEjectionPercent: uint32Value(od.MaxEjectionPercent),
+		}
+	}
+
+	if hc := cluster.HealthCheck; hc != nil {
+		check := &xdsapi.HealthCheck{
+			Timeout:            msDuration(hc.TimeoutMS),
+			Interval:           msDuration(hc.IntervalMS),
+			UnhealthyThreshold: uint32Value(hc.UnhealthyThreshold),
+			HealthyThreshold:   uint32Value(hc.HealthyThreshold),
+		}
+		if hc.Type == HealthCheckTypeHTTP {
+			check.HealthChecker = &xdsapi.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: &xdsapi.HealthCheck_HttpHealthCheck{Path: hc.Path},
+			}
+		} else {
+			// a TCP health check without payloads only checks that the connection is accepted
+			tcp := &xdsapi.HealthCheck_TcpHealthCheck{}
+			if hc.Send != nil && len(*hc.Send) > 0 {
+				tcp.Send = buildV2HealthCheckPayload((*hc.Send)[0])
+			}
+			if hc.Receive != nil {
+				for _, payload := range *hc.Receive {
+					tcp.Receive = append(tcp.Receive, buildV2HealthCheckPayload(payload))
+				}
+			}
+			check.HealthChecker = &xdsapi.HealthCheck_TcpHealthCheck_{TcpHealthCheck: tcp}
+		}
+		out.HealthChecks = []*xdsapi.HealthCheck{check}
+	}
+
+	switch ssl := cluster.SSLContext.(type) {
+	case nil:
+	case *SSLContextWithSAN:
+		out.TlsContext = &xdsapi.UpstreamTlsContext{
+			CommonTlsContext: &xdsapi.CommonTlsContext{
+				TlsCertificates: []*xdsapi.TlsCertificate{{
+					CertificateChain: buildV2DataSource(ssl.CertChainFile),
+					PrivateKey:       buildV2DataSource(ssl.PrivateKeyFile),
+				}},
+				ValidationContext: &xdsapi.CertificateValidationContext{
+					TrustedCa:            buildV2DataSource(ssl.CaCertFile),
+					VerifySubjectAltName: ssl.VerifySubjectAltName,
+				},
+			},
+		}
+		if ssl.CrlFile != "" {
+			out.TlsContext.CommonTlsContext.ValidationContext.Crl = buildV2DataSource(ssl.CrlFile)
+		}
+	case *SSLContextExternal:
+		out.TlsContext = &xdsapi.UpstreamTlsContext{
+			CommonTlsContext: &xdsapi.CommonTlsContext{},
+		}
+		if ssl.CaCertFile != "" {
+			out.TlsContext.CommonTlsContext.ValidationContext = &xdsapi.CertificateValidationContext{
+				TrustedCa: buildV2DataSource(ssl.CaCertFile),
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported SSL context %T for cluster %s", ssl, cluster.Name)
+	}
+
+	return out, nil
+}
+
+// buildV2HealthCheckPayload converts a hex encoded health check payload.
+func buildV2HealthCheckPayload(payload HealthCheckPayload) *xdsapi.HealthCheck_Payload {
+	return &xdsapi.HealthCheck_Payload{
+		Payload: &xdsapi.HealthCheck_Payload_Text{Text: payload.Binary},
+	}
+}
+
+// buildV2Listener translates a v1 listener. It returns the names of the RDS
+// route configurations referenced by the listener's HTTP connection managers.
+func buildV2Listener(listener *Listener) (*xdsapi.Listener, []string, error) {
+	host, port, err := parseAddress(listener.Address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid address %q for listener %s: %v", listener.Address, listener.Name, err)
+	}
+
+	name := listener.Name
+	if name == "" {
+		name = listener.Address
+	}
+
+	chain := xdsapi.FilterChain{}
+	routeNames := make([]string, 0)
+	for _, filter := range listener.Filters {
+		var config *types.Struct
+		if hcm, ok := filter.Config.(*HTTPFilterConfig); ok && hcm.RDS != nil {
+			config, err = buildV2HTTPConnectionManager(hcm)
+			routeNames = append(routeNames, hcm.RDS.RouteConfigName)
+		} else {
+			config, err = deprecatedV1Config(filter.Config)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s filter for listener %s: %v", filter.Name, name, err)
+		}
+		chain.Filters = append(chain.Filters, xdsapi.Filter{
+			Name:   v2FilterName(filter.Name),
+			Config: config,
+		})
+	}
+
+	if ssl := listener.SSLContext; ssl != nil {
+		tls := &xdsapi.DownstreamTlsContext{
+			CommonTlsContext: &xdsapi.CommonTlsContext{
+				TlsCertificates: []*xdsapi.TlsCertificate{{
+					CertificateChain: buildV2DataSource(ssl.CertChainFile),
+					PrivateKey:       buildV2DataSource(ssl.PrivateKeyFile),
+				}},
+			},
+			RequireClientCertificate: &types.BoolValue{Value: ssl.RequireClientCertificate},
+		}
+		if ssl.CaCertFile != "" {
+			tls.CommonTlsContext.ValidationContext = &xdsapi.CertificateValidationContext{
+				TrustedCa: buildV2DataSource(ssl.CaCertFile),
+			}
+			if ssl.CrlFile != "" {
+				tls.CommonTlsContext.ValidationContext.Crl = buildV2DataSource(ssl.CrlFile)
+			}
+		}
+		if ssl.ALPNProtocols != "" {
+			tls.CommonTlsContext.AlpnProtocols = strings.Split(ssl.ALPNProtocols, ",")
+		}
+		chain.TlsContext = tls
+		if len(listener.sniDomains) > 0 {
+			chain.FilterChainMatch = &xdsapi.FilterChainMatch{SniDomains: listener.sniDomains}
+		}
+	}
+
+	out := &xdsapi.Listener{
+		Name:         name,
+		Address:      *buildV2Address(host, port),
+		FilterChains: []xdsapi.FilterChain{chain},
+		DeprecatedV1: &xdsapi.Listener_DeprecatedV1{
+			BindToPort: &types.BoolValue{Value: listener.BindToPort},
+		},
+	}
+	if listener.UseOriginalDst {
+		out.UseOriginalDst = &types.BoolValue{Value: true}
+	}
+
+	return out, routeNames, nil
+}
+
+// buildV2HTTPConnectionManager rewrites an RDS-enabled v1 HTTP connection
+// manager to fetch its routes over ADS. HTTP filters retain their v1 configuration.
+func buildV2HTTPConnectionManager(config *HTTPFilterConfig) (*types.Struct, error) {
+	filters := make([]interface{}, 0, len(config.Filters))
+	for _, filter := range config.Filters {
+		filters = append(filters, map[string]interface{}{
+			"name": v2FilterName(filter.Name),
+			"config": map[string]interface{}{
+				deprecatedV1Key: true,
+				"value":         filter.Config,
+			},
+		})
+	}
+
+	hcm := map[string]interface{}{
+		"codec_type":  strings.ToUpper(config.CodecType),
+		"stat_prefix": config.StatPrefix,
+		"rds": map[string]interface{}{
+			"config_source":     map[string]interface{}{"ads": map[string]interface{}{}},
+			"route_config_name": config.RDS.RouteConfigName,
+		},
+		"http_filters":        filters,
+		"use_remote_address":  config.UseRemoteAddress,
+		"generate_request_id": config.GenerateRequestID,
+	}
+
+	if config.Tracing != nil {
+		hcm["tracing"] = map[string]interface{}{
+			"operation_name": strings.ToUpper(config.Tracing.OperationName),
+		}
+	}
+
+	if len(config.AccessLog) > 0 {
+		logs := make([]interface{}, 0, len(config.AccessLog))
+		for _, accessLog := range config.AccessLog {
+			logs = append(logs, map[string]interface{}{
+				"name": "envoy.file_access_log",
+				"config": map[string]interface{}{
+					"path":   accessLog.Path,
+					"format": accessLog.Format,
+				},
+			})
+		}
+		hcm["access_log"] = logs
+	}
+
+	return toStruct(hcm)
+}
+
+// buildV2RouteConfiguration translates a v1 HTTP route configuration.
+func buildV2RouteConfiguration(name string, config *HTTPRouteConfig) (*xdsapi.RouteConfiguration, error) {
+	out := &xdsapi.RouteConfiguration{Name: name}
+	if config == nil {
+		return out, nil
+	}
+
+	for _, vhost := range config.VirtualHosts {
+		v2vhost := xdsapi.VirtualHost{
+			Name:    vhost.Name,
+			Domains: vhost.Domains,
+		}
+		if vhost.RequireSSL == RequireSSLAll {
+			v2vhost.RequireTls = xdsapi.VirtualHost_ALL
+		}
+		for _, route := range vhost.Routes {
+			v2route, err := buildV2Route(route)
+			if err != nil {
+				return nil, fmt.Errorf("invalid route in virtual host %s: %v", vhost.Name, err)
+			}
+			v2vhost.Routes = append(v2vhost.Routes, *v2route)
+		}
+		out.VirtualHosts = append(out.VirtualHosts, v2vhost)
+	}
+
+	return out, nil
+}
+
+func buildV2Route(route *HTTPRoute) (*xdsapi.Route, error) {
+	out := &xdsapi.Route{}
+
+	switch {
+	case route.Path != "":
+		out.Match.PathSpecifier = &xdsapi.RouteMatch_Path{Path: route.Path}
+	case route.Regex != "":
+		out.Match.PathSpecifier = &xdsapi.RouteMatch_Regex{Regex: route.Regex}
+	default:
+		out.Match.PathSpecifier = &xdsapi.RouteMatch_Prefix{Prefix: route.Prefix}
+	}
+
+	for _, header := range route.Headers {
+		out.Match.Headers = append(out.Match.Headers, &xdsapi.HeaderMatcher{
+			Name:  header.Name,
+			Value: header.Value,
+			Regex: &types.BoolValue{Value: header.Regex},
+		})
+	}
+
+	if route.Runtime != nil {
+		out.Match.Runtime = &xdsapi.RuntimeUInt32{
+			RuntimeKey:   route.Runtime.Key,
+			DefaultValue: uint32(route.Runtime.Default),
+		}
+	}
+
+	if route.Decorator != nil {
+		out.Decorator = &xdsapi.Decorator{Operation: route.Decorator.Operation}
+	}
+
+	for _, header := range route.HeadersToAdd {
+		out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, &xdsapi.HeaderValueOption{
+			Header: &xdsapi.HeaderValue{Key: header.Key, Value: header.Value},
+		})
+	}
+
+	// The router exposes the v1 opaque config to filters through the route metadata.
+	if len(route.OpaqueConfig) > 0 {
+		opaque, err := toStruct(route.OpaqueConfig)
+		if err != nil {
+			return nil, err
+		}
+		out.Metadata = &xdsapi.Metadata{
+			FilterMetadata: map[string]*types.Struct{v2FilterName(router): opaque},
+		}
+	}
+
+	if route.Redirect() {
+		out.Action = &xdsapi.Route_Redirect{
+			Redirect: &xdsapi.RedirectAction{
+				HostRedirect: route.HostRedirect,
+				PathRedirect: route.PathRedirect,
+			},
+		}
+		return out, nil
+	}
+
+	action := &xdsapi.RouteAction{
+		PrefixRewrite: route.PrefixRewrite,
+		Timeout:       msDuration(route.TimeoutMS),
+	}
+
+	switch {
+	case route.WeightedClusters != nil:
+		weighted := &xdsapi.WeightedCluster{RuntimeKeyPrefix: route.WeightedClusters.RuntimeKeyPrefix}
+		for _, cluster := range route.WeightedClusters.Clusters {
+			weighted.Clusters = append(weighted.Clusters, &xdsapi.WeightedCluster_ClusterWeight{
+				Name:   cluster.Name,
+				Weight: &types.UInt32Value{Value: uint32(cluster.Weight)},
+			})
+		}
+		action.ClusterSpecifier = &xdsapi.RouteAction_WeightedClusters{WeightedClusters: weighted}
+	case route.Cluster != "":
+		action.ClusterSpecifier = &xdsapi.RouteAction_Cluster{Cluster: route.Cluster}
+	default:
+		return nil, fmt.Errorf("route %q has neither a cluster nor a redirect", route.Prefix+route.Path)
+	}
+
+	switch {
+	case route.HostRewrite != "":
+		action.HostRewriteSpecifier = &xdsapi.RouteAction_HostRewrite{HostRewrite: route.HostRewrite}
+	case route.AutoHostRewrite:
+		action.HostRewriteSpecifier = &xdsapi.RouteAction_AutoHostRewrite{
+			AutoHostRewrite: &types.BoolValue{Value: true},
+		}
+	}
+
+	if route.WebsocketUpgrade {
+		action.UseWebsocket = &types.BoolValue{Value: true}
+	}
+
+	if route.RetryPolicy != nil {
+		action.RetryPolicy = &xdsapi.RouteAction_RetryPolicy{
+			RetryOn:       route.RetryPolicy.Policy,
+			NumRetries:    uint32Value(route.RetryPolicy.NumRetries),
+			PerTryTimeout: msDuration(route.RetryPolicy.PerTryTimeoutMS),
+		}
+	}
+
+	if route.ShadowCluster != nil {
+		action.RequestMirrorPolicy = &xdsapi.RouteAction_RequestMirrorPolicy{
+			Cluster: route.ShadowCluster.Cluster,
+		}
+	}
+
+	if cors := route.CORSPolicy; cors != nil {
+		action.Cors = &xdsapi.CorsPolicy{
+			AllowOrigin:      cors.AllowOrigin,
+			AllowMethods:     cors.AllowMethods,
+			AllowHeaders:     cors.AllowHeaders,
+			ExposeHeaders:    cors.ExposeHeaders,
+			MaxAge:           cors.MaxAge,
+			AllowCredentials: &types.BoolValue{Value: cors.AllowCredentials},
+			Enabled:          &types.BoolValue{Value: cors.Enabled},
+		}
+	}
+
+	out.Action = &xdsapi.Route_Route{Route: action}
+	return out, nil
+}
@@ -0,0 +1,290 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	xdsapi "github.com/envoyproxy/go-control-plane/api"
+	"github.com/envoyproxy/go-control-plane/pkg/cache"
+
+	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/proxy/envoy/mock"
+)
+
+func TestParseAddress(t *testing.T) {
+	cases := []struct {
+		in   string
+		host string
+		port uint32
+		err  bool
+	}{
+		{in: "tcp://0.0.0.0:15001", host: "0.0.0.0", port: 15001},
+		{in: "tcp://istio-pilot:8080", host: "istio-pilot", port: 8080},
+		{in: "tcp://10.1.1.1", err: true},
+		{in: "tcp://10.1.1.1:http", err: true},
+	}
+	for _, c := range cases {
+		host, port, err := parseAddress(c.in)
+		if c.err {
+			if err == nil {
+				t.Errorf("parseAddress(%q) => got no error", c.in)
+			}
+			continue
+		}
+		if err != nil || host != c.host || port != c.port {
+			t.Errorf("parseAddress(%q) => got (%q, %d, %v), want (%q, %d)", c.in, host, port, err, c.host, c.port)
+		}
+	}
+}
+
+func TestBuildV2Cluster(t *testing.T) {
+	cluster := &Cluster{
+		Name:             "out.hello",
+		ServiceName:      "hello.default.svc.cluster.local|http",
+		ConnectTimeoutMs: 1000,
+		Type:             ClusterTypeSDS,
+		LbType:           LbTypeLeastRequest,
+		Features:         ClusterFeatureHTTP2,
+		CircuitBreaker:   &CircuitBreaker{Default: DefaultCBPriority{MaxConnections: 10}},
+		HealthCheck:      &HealthCheck{Type: HealthCheckTypeHTTP, IntervalMS: 5000, Path: "/health"},
+		SSLContext:       buildClusterSSLContext("/etc/certs", []string{"spiffe://cluster.local/ns/default/sa/hello"}, ""),
+	}
+
+	out, err := buildV2Cluster(cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Type != xdsapi.Cluster_EDS || out.EdsClusterConfig.ServiceName != cluster.ServiceName {
+		t.Errorf("got cluster type %v with EDS config %v", out.Type, out.EdsClusterConfig)
+	}
+	if out.LbPolicy != xdsapi.Cluster_LEAST_REQUEST {
+		t.Errorf("got LB policy %v", out.LbPolicy)
+	}
+	if out.ConnectTimeout != time.Second {
+		t.Errorf("got connect timeout %v", out.ConnectTimeout)
+	}
+	if out.Http2ProtocolOptions == nil {
+		t.Error("expected HTTP/2 protocol options")
+	}
+	if got := out.CircuitBreakers.Thresholds[0].MaxConnections.Value; got != 10 {
+		t.Errorf("got max connections %d", got)
+	}
+	if len(out.HealthChecks) != 1 || *out.HealthChecks[0].Interval != 5*time.Second ||
+		out.HealthChecks[0].GetHttpHealthCheck().GetPath() != "/health" {
+		t.Errorf("got health checks %v", out.HealthChecks)
+	}
+
+	cluster.HealthCheck = &HealthCheck{Type: HealthCheckTypeTCP, IntervalMS: 5000,
+		Send: &[]HealthCheckPayload{{Binary: "0102"}}, Receive: &[]HealthCheckPayload{{Binary: "03"}}}
+	if out, err = buildV2Cluster(cluster); err != nil {
+		t.Fatal(err)
+	}
+	if tcp := out.HealthChecks[0].GetTcpHealthCheck(); tcp.GetSend().GetText() != "0102" ||
+		len(tcp.GetReceive()) != 1 || tcp.GetReceive()[0].GetText() != "03" {
+		t.Errorf("got TCP health check %v", out.HealthChecks[0])
+	}
+	want := []string{"spiffe://cluster.local/ns/default/sa/hello"}
+	if got := out.TlsContext.CommonTlsContext.ValidationContext.VerifySubjectAltName; !reflect.DeepEqual(got, want) {
+		t.Errorf("got SANs %v, want %v", got, want)
+	}
+
+	static := buildCluster("istio-mixer:9091", MixerCluster, nil)
+	out, err = buildV2Cluster(static)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Type != xdsapi.Cluster_STRICT_DNS || len(out.Hosts) != 1 {
+		t.Errorf("got cluster type %v with hosts %v", out.Type, out.Hosts)
+	}
+
+	if _, err = buildV2Cluster(&Cluster{Name: "bad", Type: "unknown"}); err == nil {
+		t.Error("expected error for unsupported cluster type")
+	}
+}
+
+func TestBuildV2Listener(t *testing.T) {
+	mesh := makeMeshConfig()
+	mesh.MixerAddress = ""
+	listener := buildHTTPListener(&mesh, mock.HelloProxyV0, nil, nil, WildcardAddress, 80, "80", false,
+		EgressTraceOperation, true, nil)
+
+	out, routes, err := buildV2Listener(listener)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(routes, []string{"80"}) {
+		t.Errorf("got route names %v", routes)
+	}
+	if len(out.FilterChains) != 1 || len(out.FilterChains[0].Filters) != 1 {
+		t.Fatalf("got filter chains %v", out.FilterChains)
+	}
+	filter := out.FilterChains[0].Filters[0]
+	if filter.Name != "envoy.http_connection_manager" {
+		t.Errorf("got filter name %q", filter.Name)
+	}
+	rds := filter.Config.Fields["rds"].GetStructValue()
+	if rds == nil || rds.Fields["route_config_name"].GetStringValue() != "80" {
+		t.Errorf("got RDS config %v", rds)
+	}
+	if _, ok := rds.Fields["config_source"].GetStructValue().Fields["ads"]; !ok {
+		t.Errorf("expected ADS config source, got %v", rds)
+	}
+
+	tcp := buildTCPListener(&TCPRouteConfig{Routes: []*TCPRoute{{Cluster: "out.tcp"}}}, "10.1.1.1", 9000, "TCP")
+	out, routes, err = buildV2Listener(tcp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 0 {
+		t.Errorf("got route names %v for TCP listener", routes)
+	}
+	config := out.FilterChains[0].Filters[0].Config
+	if !config.Fields[deprecatedV1Key].GetBoolValue() {
+		t.Errorf("expected deprecated v1 TCP proxy config, got %v", config)
+	}
+}
+
+func TestBuildV2RouteConfiguration(t *testing.T) {
+	config := &HTTPRouteConfig{VirtualHosts: []*VirtualHost{{
+		Name:    "hello.default.svc.cluster.local|http",
+		Domains: []string{"hello", "hello:80"},
+		Routes: []*HTTPRoute{
+			{
+				Prefix:       "/",
+				Path:         "/redirect",
+				HostRedirect: "world",
+			},
+			{
+				Prefix: "/",
+				WeightedClusters: &WeightedCluster{Clusters: []*WeightedClusterEntry{
+					{Name: "out.v1", Weight: 75},
+					{Name: "out.v2", Weight: 25},
+				}},
+				TimeoutMS:    15000,
+				RetryPolicy:  &RetryPolicy{Policy: "5xx", NumRetries: 3},
+				OpaqueConfig: map[string]string{"mixer_check": "on"},
+			},
+		},
+	}}}
+
+	out, err := buildV2RouteConfiguration("80", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != "80" || len(out.VirtualHosts) != 1 || len(out.VirtualHosts[0].Routes) != 2 {
+		t.Fatalf("got route configuration %v", out)
+	}
+
+	redirect := out.VirtualHosts[0].Routes[0]
+	if action, ok := redirect.Action.(*xdsapi.Route_Redirect); !ok || action.Redirect.HostRedirect != "world" {
+		t.Errorf("got redirect action %v", redirect.Action)
+	}
+
+	route := out.VirtualHosts[0].Routes[1]
+	action, ok := route.Action.(*xdsapi.Route_Route)
+	if !ok {
+		t.Fatalf("got route action %v", route.Action)
+	}
+	weighted, ok := action.Route.ClusterSpecifier.(*xdsapi.RouteAction_WeightedClusters)
+	if !ok || len(weighted.WeightedClusters.Clusters) != 2 {
+		t.Errorf("got cluster specifier %v", action.Route.ClusterSpecifier)
+	}
+	if action.Route.Timeout == nil || *action.Route.Timeout != 15*time.Second {
+		t.Errorf("got timeout %v", action.Route.Timeout)
+	}
+	if action.Route.RetryPolicy.NumRetries.Value != 3 {
+		t.Errorf("got retry policy %v", action.Route.RetryPolicy)
+	}
+	opaque := route.Metadata.FilterMetadata["envoy.router"]
+	if opaque.Fields["mixer_check"].GetStringValue() != "on" {
+		t.Errorf("got route metadata %v", route.Metadata)
+	}
+
+	if _, err = buildV2RouteConfiguration("80", &HTTPRouteConfig{VirtualHosts: []*VirtualHost{{
+		Name:   "empty",
+		Routes: []*HTTPRoute{{Prefix: "/"}},
+	}}}); err == nil {
+		t.Error("expected error for route without a destination")
+	}
+}
+
+func TestADSNodeHash(t *testing.T) {
+	key, err := adsNodeHash{}.Hash(&xdsapi.Node{Id: mock.HelloProxyV0.ServiceNode()})
+	if err != nil || string(key) != mock.HelloProxyV0.ServiceNode() {
+		t.Errorf("got key %q, error %v", key, err)
+	}
+	if _, err = (adsNodeHash{}).Hash(&xdsapi.Node{Id: "invalid"}); err == nil {
+		t.Error("expected error for invalid node ID")
+	}
+	if _, err = (adsNodeHash{}).Hash(nil); err == nil {
+		t.Error("expected error for missing node")
+	}
+}
+
+func TestADSServerNodes(t *testing.T) {
+	mesh := makeMeshConfig()
+	ads := NewADSServer(model.Environment{
+		ServiceDiscovery: mock.Discovery,
+		ServiceAccounts:  mock.Discovery,
+		IstioConfigStore: model.MakeIstioStore(memory.Make(model.IstioConfigTypes)),
+		Mesh:             &mesh,
+	}, 0)
+	key := cache.Key(mock.HelloProxyV0.ServiceNode())
+
+	// a node without an open stream is ignored
+	ads.addNode(key)
+	if len(ads.nodes) != 0 {
+		t.Fatalf("got nodes %v before the stream of the node opened", ads.nodes)
+	}
+
+	if !ads.openStream(key) {
+		t.Fatal("openStream() => false for a new node")
+	}
+	ads.addNode(key)
+	ads.addNode(key)
+	if _, exists := ads.nodes[key]; !exists || ads.version != 1 {
+		t.Fatalf("got nodes %v at version %d, want the node pushed once", ads.nodes, ads.version)
+	}
+
+	// a second stream of a reconnecting node keeps the node until both streams end
+	if ads.openStream(key) {
+		t.Fatal("openStream() => true for a known node")
+	}
+
+	// pushes are coalesced while a push is pending
+	ads.Push()
+	ads.Push()
+	if len(ads.pushCh) != 1 {
+		t.Fatalf("got %d pending pushes, want 1", len(ads.pushCh))
+	}
+	<-ads.pushCh
+	ads.pushAll()
+	if ads.version != 2 {
+		t.Fatalf("got version %d after a push, want 2", ads.version)
+	}
+
+	ads.closeStream(key)
+	if _, exists := ads.nodes[key]; !exists {
+		t.Fatal("node removed while a stream is still open")
+	}
+	ads.closeStream(key)
+	if len(ads.nodes) != 0 || len(ads.streams) != 0 {
+		t.Fatalf("got nodes %v and streams %v after the streams ended", ads.nodes, ads.streams)
+	}
+}
@@ -15,6 +15,9 @@
 package aggregate
 
 import (
+	"fmt"
+	"sync"
+
 	// TODO(nmittler): Remove this
 	_ "github.com/golang/glog"
 	multierror "github.com/hashicorp/go-multierror"
@@ -27,14 +30,28 @@ import (
 // Registry specifies the collection of service registry related interfaces
 type Registry struct {
 	Name serviceregistry.ServiceRegistry
+	// ClusterID identifies the cluster of the registry when several registries of the same
+	// platform are aggregated, e.g. the Kubernetes API servers of a multi-cluster mesh.
+	ClusterID string
 	model.Controller
 	model.ServiceDiscovery
 	model.ServiceAccounts
+
+	// stop stops the registry when it is deleted from a running controller
+	stop chan struct{}
 }
 
 // Controller aggregates data across different registries and monitors for changes
 type Controller struct {
+	mu         sync.RWMutex
 	registries []Registry
+
+	// handlers are appended to the registries added after them
+	serviceHandlers  []func(*model.Service, model.Event)
+	instanceHandlers []func(*model.ServiceInstance, model.Event)
+
+	// running is set while the registries run, registries added meanwhile are started right away
+	running bool
 }
 
 // NewController creates a new Aggregate controller
@@ -44,21 +61,130 @@ func NewController() *Controller {
 	}
 }
 
-// AddRegistry adds registries into the aggregated controller
-func (c *Controller) AddRegistry(registry Registry) {
+// AddRegistry adds registries into the aggregated controller. The handlers appended to the
+// controller are appended to the registry, which starts right away if the controller runs.
+// A registry is rejected if the registry of its cluster was already added.
+func (c *Controller) AddRegistry(registry Registry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if registry.ClusterID != "" {
+		for _, r := range c.registries {
+			if r.ClusterID == registry.ClusterID {
+				return fmt.Errorf("cluster %s is already registered by adapter %s", registry.ClusterID, r.Name)
+			}
+		}
+	}
+
+	for _, f := range c.serviceHandlers {
+		if err := registry.AppendServiceHandler(f); err != nil {
+			log.Warnf("Fail to append service handler to adapter %s: %v", registry.Name, err)
+		}
+	}
+	for _, f := range c.instanceHandlers {
+		if err := registry.AppendInstanceHandler(f); err != nil {
+			log.Warnf("Fail to append instance handler to adapter %s: %v", registry.Name, err)
+		}
+	}
+
+	registry.stop = make(chan struct{})
 	c.registries = append(c.registries, registry)
+	if c.running {
+		go registry.Run(registry.stop)
+	}
+	return nil
 }
 
-// Services lists services from all platforms
+// DeleteRegistry stops and removes the registries of a cluster from the aggregated controller.
+// The service handlers are notified of the deletion of the services of the cluster which no other
+// registry serves, and of the update of the others, which lost the instances of the cluster.
+func (c *Controller) DeleteRegistry(clusterID string) {
+	c.mu.Lock()
+	var deleted []Registry
+	registries := make([]Registry, 0, len(c.registries))
+	for _, r := range c.registries {
+		if r.ClusterID == clusterID {
+			deleted = append(deleted, r)
+		} else {
+			registries = append(registries, r)
+		}
+	}
+	c.registries = registries
+	handlers := c.serviceHandlers
+	running := c.running
+	c.mu.Unlock()
+
+	// the services are listed before the registries are stopped.
+	services := make(map[string]*model.Service)
+	for _, r := range deleted {
+		svcs, err := r.Services()
+		if err != nil {
+			log.Warnf("Failed to list the services of deleted adapter %s: %v", r.Name, err)
+		}
+		for _, svc := range svcs {
+			services[svc.Hostname] = svc
+		}
+		if running {
+			close(r.stop)
+		}
+	}
+	if len(services) == 0 {
+		return
+	}
+
+	// a service which remains in a registry is updated rather than deleted. If a registry fails to
+	// list its services, they are assumed to remain, the next event of the registry updating them.
+	remaining := make(map[string]*model.Service)
+	for _, r := range registries {
+		svcs, err := r.Services()
+		if err != nil {
+			log.Warnf("Failed to list the services of adapter %s, assuming it serves the services of deleted cluster %s: %v",
+				r.Name, clusterID, err)
+			remaining = services
+			break
+		}
+		for _, svc := range svcs {
+			if _, exists := services[svc.Hostname]; exists {
+				remaining[svc.Hostname] = svc
+			}
+		}
+	}
+
+	for hostname, svc := range services {
+		event := model.EventDelete
+		if remainingSvc, exists := remaining[hostname]; exists {
+			svc, event = remainingSvc, model.EventUpdate
+		}
+		for _, f := range handlers {
+			f(svc, event)
+		}
+	}
+}
+
+// getRegistries returns a snapshot of the registries, which can be used without holding the lock
+func (c *Controller) getRegistries() []Registry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.registries
+}
+
+// Services lists services from all platforms. A service found in several registries, e.g. in
+// several clusters, is listed once.
 func (c *Controller) Services() ([]*model.Service, error) {
 	services := make([]*model.Service, 0)
+	seen := make(map[string]bool)
 	var errs error
-	for _, r := range c.registries {
+	for _, r := range c.getRegistries() {
 		svcs, err := r.Services()
 		if err != nil {
 			errs = multierror.Append(errs, err)
-		} else {
-			services = append(services, svcs...)
+			continue
+		}
+		for _, svc := range svcs {
+			if !seen[svc.Hostname] {
+				seen[svc.Hostname] = true
+				services = append(services, svc)
+			}
 		}
 	}
 	return services, errs
@@ -67,7 +193,7 @@ func (c *Controller) Services() ([]*model.Service, error) {
 // GetService retrieves a service by hostname if exists
 func (c *Controller) GetService(hostname string) (*model.Service, error) {
 	var errs error
-	for _, r := range c.registries {
+	for _, r := range c.getRegistries() {
 		service, err := r.GetService(hostname)
 		if err != nil {
 			errs = multierror.Append(errs, err)
@@ -85,7 +211,7 @@ func (c *Controller) GetService(hostname string) (*model.Service, error) {
 // ManagementPorts retrieves set of health check ports by instance IP
 // Return on the first hit.
 func (c *Controller) ManagementPorts(addr string) model.PortList {
-	for _, r := range c.registries {
+	for _, r := range c.getRegistries() {
 		if portList := r.ManagementPorts(addr); portList != nil {
 			return portList
 		}
@@ -95,21 +221,26 @@ func (c *Controller) ManagementPorts(addr string) model.PortList {
 
 // Instances retrieves instances for a service and its ports that match
 // any of the supplied labels. All instances match an empty label list.
+// The instances of a service found in several registries, e.g. in several
+// clusters, are merged.
 func (c *Controller) Instances(hostname string, ports []string,
 	labels model.LabelsCollection) ([]*model.ServiceInstance, error) {
 	var instances []*model.ServiceInstance
 	var errs error
-	for _, r := range c.registries {
-		var err error
-		instances, err = r.Instances(hostname, ports, labels)
+	for _, r := range c.getRegistries() {
+		inst, err := r.Instances(hostname, ports, labels)
 		if err != nil {
 			errs = multierror.Append(errs, err)
-		} else if len(instances) > 0 {
-			if errs != nil {
-				log.Warnf("Instances() found match but encountered an error: %v", errs)
-			}
-			return instances, nil
+		} else {
+			instances = append(instances, inst...)
+		}
+	}
+
+	if len(instances) > 0 {
+		if errs != nil {
+			log.Warnf("Instances() found match but encountered an error: %v", errs)
 		}
+		return instances, nil
 	}
 	return instances, errs
 }
@@ -118,7 +249,7 @@ func (c *Controller) Instances(hostname string, ports []string,
 func (c *Controller) HostInstances(addrs map[string]*model.Node) ([]*model.ServiceInstance, error) {
 	out := make([]*model.ServiceInstance, 0)
 	var errs error
-	for _, r := range c.registries {
+	for _, r := range c.getRegistries() {
 		instances, err := r.HostInstances(addrs)
 		if err != nil {
 			errs = multierror.Append(errs, err)
@@ -137,45 +268,69 @@ func (c *Controller) HostInstances(addrs map[string]*model.Node) ([]*model.Servi
 	return out, errs
 }
 
-// Run starts all the controllers
+// Run starts all the controllers, including the ones added while running
 func (c *Controller) Run(stop <-chan struct{}) {
-
+	c.mu.Lock()
+	c.running = true
 	for _, r := range c.registries {
-		go r.Run(stop)
+		go r.Run(r.stop)
 	}
+	c.mu.Unlock()
 
 	<-stop
+
+	c.mu.Lock()
+	c.running = false
+	for _, r := range c.registries {
+		close(r.stop)
+	}
+	c.mu.Unlock()
 	log.Info("Registry Aggregator terminated")
 }
 
 // AppendServiceHandler implements a service catalog operation
 func (c *Controller) AppendServiceHandler(f func(*model.Service, model.Event)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	for _, r := range c.registries {
 		if err := r.AppendServiceHandler(f); err != nil {
 			log.Infof("Fail to append service handler to adapter %s", r.Name)
 			return err
 		}
 	}
+	c.serviceHandlers = append(c.serviceHandlers, f)
 	return nil
 }
 
 // AppendInstanceHandler implements a service instance catalog operation
 func (c *Controller) AppendInstanceHandler(f func(*model.ServiceInstance, model.Event)) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	for _, r := range c.registries {
 		if err := r.AppendInstanceHandler(f); err != nil {
 			log.Infof("Fail to append instance handler to adapter %s", r.Name)
 			return err
 		}
 	}
+	c.instanceHandlers = append(c.instanceHandlers, f)
 	return nil
 }
 
-// GetIstioServiceAccounts implements model.ServiceAccounts operation
+// GetIstioServiceAccounts implements model.ServiceAccounts operation.
+// The service accounts of a service found in several registries, e.g. in
+// several clusters, are merged.
 func (c *Controller) GetIstioServiceAccounts(hostname string, ports []string) []string {
-	for _, r := range c.registries {
-		if svcAccounts := r.GetIstioServiceAccounts(hostname, ports); svcAccounts != nil {
-			return svcAccounts
+	var out []string
+	seen := make(map[string]bool)
+	for _, r := range c.getRegistries() {
+		for _, account := range r.GetIstioServiceAccounts(hostname, ports) {
+			if !seen[account] {
+				seen[account] = true
+				out = append(out, account)
+			}
 		}
 	}
-	return nil
+	return out
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/proxy/envoy/mock"
@@ -37,6 +38,28 @@ func (c *MockController) AppendInstanceHandler(f func(*model.ServiceInstance, mo
 
 func (c *MockController) Run(<-chan struct{}) {}
 
+// runningController records the handlers appended to it, and signals when it runs and stops
+type runningController struct {
+	serviceHandlers []func(*model.Service, model.Event)
+	running         chan struct{}
+	stopped         chan struct{}
+}
+
+func (c *runningController) AppendServiceHandler(f func(*model.Service, model.Event)) error {
+	c.serviceHandlers = append(c.serviceHandlers, f)
+	return nil
+}
+
+func (c *runningController) AppendInstanceHandler(f func(*model.ServiceInstance, model.Event)) error {
+	return nil
+}
+
+func (c *runningController) Run(stop <-chan struct{}) {
+	close(c.running)
+	<-stop
+	close(c.stopped)
+}
+
 var discovery1 *mock.ServiceDiscovery
 var discovery2 *mock.ServiceDiscovery
 
@@ -68,8 +91,11 @@ func buildMockController() *Controller {
 	}
 
 	ctls := NewController()
-	ctls.AddRegistry(registry1)
-	ctls.AddRegistry(registry2)
+	for _, registry := range []Registry{registry1, registry2} {
+		if err := ctls.AddRegistry(registry); err != nil {
+			panic(err)
+		}
+	}
 
 	return ctls
 }
@@ -388,3 +414,88 @@ func TestManagementPorts(t *testing.T) {
 		}
 	}
 }
+
+func TestAddDeleteRegistry(t *testing.T) {
+	aggregateCtl := buildMockController()
+	events := make(map[string]model.Event)
+	if err := aggregateCtl.AppendServiceHandler(func(svc *model.Service, event model.Event) {
+		events[svc.Hostname] = event
+	}); err != nil {
+		t.Fatalf("AppendServiceHandler() encountered unexpected error: %v", err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go aggregateCtl.Run(stop)
+
+	// Add a second cluster running the hello service, and a service of its own
+	cluster3Service := mock.MakeService("cluster3.default.svc.cluster.local", "10.3.0.1")
+	discovery3 := mock.NewDiscovery(
+		map[string]*model.Service{
+			mock.HelloService.Hostname: mock.HelloService,
+			cluster3Service.Hostname:   cluster3Service,
+		}, 1)
+	ctl := &runningController{running: make(chan struct{}), stopped: make(chan struct{})}
+	registry3 := Registry{
+		Name:             serviceregistry.ServiceRegistry("mockAdapter3"),
+		ClusterID:        "cluster3",
+		ServiceDiscovery: discovery3,
+		ServiceAccounts:  discovery3,
+		Controller:       ctl,
+	}
+	if err := aggregateCtl.AddRegistry(registry3); err != nil {
+		t.Fatalf("AddRegistry() encountered unexpected error: %v", err)
+	}
+	if len(ctl.serviceHandlers) != 1 {
+		t.Fatal("Service handler was not appended to the added registry")
+	}
+	select {
+	case <-ctl.running:
+	case <-time.After(time.Second):
+		t.Fatal("Registry added to a running controller was not started")
+	}
+	if err := aggregateCtl.AddRegistry(registry3); err == nil {
+		t.Fatal("AddRegistry() accepted a second registry of the same cluster")
+	}
+
+	services, err := aggregateCtl.Services()
+	if err != nil {
+		t.Fatalf("Services() encountered unexpected error: %v", err)
+	}
+	if len(services) != 5 {
+		t.Fatalf("Services() returned %d services, want the 5 distinct services", len(services))
+	}
+	instances, err := aggregateCtl.Instances(mock.HelloService.Hostname,
+		[]string{mock.PortHTTP.Name},
+		model.LabelsCollection{})
+	if err != nil {
+		t.Fatalf("Instances() encountered unexpected error: %v", err)
+	}
+	if len(instances) != 3 {
+		t.Fatalf("Instances() returned %d instances, want the 3 instances of both clusters", len(instances))
+	}
+
+	aggregateCtl.DeleteRegistry("cluster3")
+	select {
+	case <-ctl.stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Deleted registry was not stopped")
+	}
+	if event, exists := events[cluster3Service.Hostname]; !exists || event != model.EventDelete {
+		t.Fatalf("Service handler was notified of %v for the service of the cluster, want %v", event, model.EventDelete)
+	}
+	// the hello service is still served by the other cluster
+	if event, exists := events[mock.HelloService.Hostname]; !exists || event != model.EventUpdate {
+		t.Fatalf("Service handler was notified of %v for the service served by the other cluster, want %v",
+			event, model.EventUpdate)
+	}
+	instances, err = aggregateCtl.Instances(mock.HelloService.Hostname,
+		[]string{mock.PortHTTP.Name},
+		model.LabelsCollection{})
+	if err != nil {
+		t.Fatalf("Instances() encountered unexpected error: %v", err)
+	}
+	if len(instances) != 2 {
+		t.Fatalf("Instances() returned %d instances after deleting the cluster, want 2", len(instances))
+	}
+}
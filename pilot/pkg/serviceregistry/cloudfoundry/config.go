@@ -24,6 +24,8 @@ import (
 
 	validator "gopkg.in/validator.v2"
 	yaml "gopkg.in/yaml.v2"
+
+	"istio.io/istio/pilot/pkg/model"
 )
 
 // CopilotConfig describes how the Cloud Foundry platform adapter can connect to the Cloud Foundry Copilot
@@ -42,6 +44,10 @@ type Config struct {
 	// Cloud Foundry currently only supports applications exposing a single HTTP or TCP port
 	// It is typically set to 8080.
 	ServicePort int `yaml:"service_port" validate:"nonzero"`
+
+	// HealthChecks declares the active health checks of the applications, by hostname. The settings
+	// are named after the health check metadata of the other registries, e.g. path or interval.
+	HealthChecks map[string]map[string]string `yaml:"health_checks,omitempty"`
 }
 
 // LoadConfig reads configuration data from a YAML file
@@ -62,6 +68,21 @@ func LoadConfig(path string) (*Config, error) {
 	return cfg, nil
 }
 
+// ParseHealthChecks parses the health checks of the applications, by hostname
+func (c *Config) ParseHealthChecks() (map[string]*model.HealthCheck, error) {
+	out := make(map[string]*model.HealthCheck, len(c.HealthChecks))
+	for hostname, settings := range c.HealthChecks {
+		healthCheck, err := model.ParseHealthCheck(settings, "", c.ServicePort)
+		if err != nil {
+			return nil, fmt.Errorf("invalid health check of %s: %s", hostname, err)
+		}
+		if healthCheck != nil {
+			out[hostname] = healthCheck
+		}
+	}
+	return out, nil
+}
+
 // Save writes configuration data to a YAML file
 func (c *Config) Save(path string) error {
 	configBytes, err := yaml.Marshal(c)
@@ -27,6 +27,7 @@ import (
 	"code.cloudfoundry.org/copilot/testhelpers"
 	"github.com/onsi/gomega"
 
+	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/serviceregistry/cloudfoundry"
 )
 
@@ -80,6 +81,39 @@ func TestConfig_SaveAndLoad(t *testing.T) {
 	g.Expect(loadedConfig).To(gomega.Equal(state.config))
 }
 
+func TestConfig_ParseHealthChecks(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	state := newTestState()
+	defer state.cleanup()
+
+	state.config.HealthChecks = map[string]map[string]string{
+		"process-guid-a.cfapps.internal": {model.HealthCheckPath: "/health", model.HealthCheckInterval: "5s"},
+	}
+	err := state.config.Save(state.configFilePath)
+	g.Expect(err).To(gomega.BeNil())
+
+	loadedConfig, err := cloudfoundry.LoadConfig(state.configFilePath)
+	g.Expect(err).To(gomega.BeNil())
+
+	healthChecks, err := loadedConfig.ParseHealthChecks()
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(healthChecks).To(gomega.Equal(map[string]*model.HealthCheck{
+		"process-guid-a.cfapps.internal": {
+			Path:               "/health",
+			Interval:           5 * time.Second,
+			Timeout:            model.DefaultHealthCheckTimeout,
+			HealthyThreshold:   model.DefaultHealthCheckHealthyThreshold,
+			UnhealthyThreshold: model.DefaultHealthCheckUnhealthyThreshold,
+		},
+	}))
+
+	state.config.HealthChecks["process-guid-a.cfapps.internal"][model.HealthCheckInterval] = "often"
+	_, err = state.config.ParseHealthChecks()
+	g.Expect(err).NotTo(gomega.BeNil())
+	g.Expect(err.Error()).To(gomega.HavePrefix("invalid health check of process-guid-a.cfapps.internal"))
+}
+
 func TestConfig_Load_FileNotFound(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -35,6 +35,9 @@ type ServiceDiscovery struct {
 	// Cloud Foundry currently only supports applications exposing a single HTTP or TCP port
 	// It is typically 8080
 	ServicePort int
+
+	// HealthChecks are the active health checks of the applications, by hostname
+	HealthChecks map[string]*model.HealthCheck
 }
 
 // Services implements a service catalog operation
@@ -45,11 +48,10 @@ func (sd *ServiceDiscovery) Services() ([]*model.Service, error) {
 	}
 	services := make([]*model.Service, 0, len(resp.GetBackends()))
 
-	port := sd.servicePort()
 	for hostname := range resp.Backends {
 		services = append(services, &model.Service{
 			Hostname: hostname,
-			Ports:    []*model.Port{port},
+			Ports:    []*model.Port{sd.servicePort(hostname)},
 		})
 	}
 
@@ -82,7 +84,7 @@ func (sd *ServiceDiscovery) Instances(hostname string, ports []string, tagsList
 		return nil, nil
 	}
 	for _, backend := range backendSet.GetBackends() {
-		port := sd.servicePort()
+		port := sd.servicePort(hostname)
 
 		instances = append(instances, &model.ServiceInstance{
 			Endpoint: model.NetworkEndpoint{
@@ -111,7 +113,7 @@ func (sd *ServiceDiscovery) HostInstances(addrs map[string]*model.Node) ([]*mode
 
 	for hostname, backendSet := range resp.GetBackends() {
 		for _, backend := range backendSet.GetBackends() {
-			port := sd.servicePort()
+			port := sd.servicePort(hostname)
 
 			instances = append(instances, &model.ServiceInstance{
 				Endpoint: model.NetworkEndpoint{
@@ -136,6 +138,6 @@ func (sd *ServiceDiscovery) ManagementPorts(addr string) model.PortList {
 }
 
 // all CF apps listen on the same port (for now)
-func (sd *ServiceDiscovery) servicePort() *model.Port {
-	return &model.Port{Port: sd.ServicePort, Protocol: model.ProtocolHTTP}
+func (sd *ServiceDiscovery) servicePort(hostname string) *model.Port {
+	return &model.Port{Port: sd.ServicePort, Protocol: model.ProtocolHTTP, HealthCheck: sd.HealthChecks[hostname]}
 }
@@ -132,6 +132,24 @@ func TestServiceDiscovery_GetService_Success(t *testing.T) {
 	}))
 }
 
+func TestServiceDiscovery_GetService_HealthCheck(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	state := newSDTestState()
+	healthCheck := &model.HealthCheck{Path: "/health"}
+	state.serviceDiscovery.HealthChecks = map[string]*model.HealthCheck{"process-guid-b.cfapps.internal": healthCheck}
+
+	state.mockClient.RoutesOutput.Ret0 <- makeSampleClientResponse()
+	state.mockClient.RoutesOutput.Ret1 <- nil
+
+	serviceModel, err := state.serviceDiscovery.GetService("process-guid-b.cfapps.internal")
+
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(serviceModel).To(gomega.Equal(&model.Service{
+		Hostname: "process-guid-b.cfapps.internal",
+		Ports:    []*model.Port{{Port: defaultServicePort, Protocol: model.ProtocolHTTP, HealthCheck: healthCheck}},
+	}))
+}
+
 func TestServiceDiscovery_GetService_NotFound(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	state := newSDTestState()
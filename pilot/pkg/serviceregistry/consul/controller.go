@@ -15,7 +15,6 @@
 package consul
 
 import (
-	"time"
 	// TODO(nmittler): Remove this
 	_ "github.com/golang/glog"
 	"github.com/hashicorp/consul/api"
@@ -31,13 +30,13 @@ type Controller struct {
 }
 
 // NewController creates a new Consul controller
-func NewController(addr string, interval time.Duration) (*Controller, error) {
+func NewController(addr string) (*Controller, error) {
 	conf := api.DefaultConfig()
 	conf.Address = addr
 
 	client, err := api.NewClient(conf)
 	return &Controller{
-		monitor: NewConsulMonitor(client, interval),
+		monitor: NewConsulMonitor(client),
 		client:  client,
 	}, err
 }
@@ -98,6 +97,21 @@ func (c *Controller) getCatalogService(name string, q *api.QueryOptions) ([]*api
 	return endpoints, nil
 }
 
+// getPassingInstances returns the instances of the service which pass their health checks.
+func (c *Controller) getPassingInstances(name string) ([]*api.CatalogService, error) {
+	entries, _, err := c.client.Health().Service(name, "", true, nil)
+	if err != nil {
+		log.Warnf("Could not retrieve the healthy instances of service %s from consul: %v", name, err)
+		return nil, err
+	}
+
+	endpoints := make([]*api.CatalogService, 0, len(entries))
+	for _, entry := range entries {
+		endpoints = append(endpoints, convertServiceEntry(entry))
+	}
+	return endpoints, nil
+}
+
 // ManagementPorts retries set of health check ports by instance IP.
 // This does not apply to Consul service registry, as Consul does not
 // manage the service instances. In future, when we integrate Nomad, we
@@ -108,6 +122,7 @@ func (c *Controller) ManagementPorts(addr string) model.PortList {
 
 // Instances retrieves instances for a service and its ports that match
 // any of the supplied labels. All instances match an empty tag list.
+// Only the instances passing their health checks are returned.
 func (c *Controller) Instances(hostname string, ports []string,
 	labels model.LabelsCollection) ([]*model.ServiceInstance, error) {
 	// Get actual service by name
@@ -122,7 +137,7 @@ func (c *Controller) Instances(hostname string, ports []string,
 		portMap[port] = true
 	}
 
-	endpoints, err := c.getCatalogService(name, nil)
+	endpoints, err := c.getPassingInstances(name)
 	if err != nil {
 		return nil, err
 	}
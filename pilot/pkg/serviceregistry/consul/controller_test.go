@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +40,7 @@ var (
 			Node:           "istio",
 			Address:        "172.19.0.5",
 			ID:             "111-111-111",
+			ServiceID:      "productpage-v1",
 			ServiceName:    "productpage",
 			ServiceTags:    []string{"version|v1"},
 			ServiceAddress: "172.19.0.11",
@@ -49,6 +52,7 @@ var (
 			Node:           "istio",
 			Address:        "172.19.0.5",
 			ID:             "222-222-222",
+			ServiceID:      "reviews-v1",
 			ServiceName:    "reviews",
 			ServiceTags:    []string{"version|v1"},
 			ServiceAddress: "172.19.0.6",
@@ -58,6 +62,7 @@ var (
 			Node:           "istio",
 			Address:        "172.19.0.5",
 			ID:             "333-333-333",
+			ServiceID:      "reviews-v2",
 			ServiceName:    "reviews",
 			ServiceTags:    []string{"version|v2"},
 			ServiceAddress: "172.19.0.7",
@@ -67,6 +72,7 @@ var (
 			Node:           "istio",
 			Address:        "172.19.0.5",
 			ID:             "444-444-444",
+			ServiceID:      "reviews-v3",
 			ServiceName:    "reviews",
 			ServiceTags:    []string{"version|v3"},
 			ServiceAddress: "172.19.0.8",
@@ -81,7 +87,16 @@ type mockServer struct {
 	Services    map[string][]string
 	Productpage []*api.CatalogService
 	Reviews     []*api.CatalogService
-	Lock        sync.Mutex
+	// Health holds the status of the health check of the instances by service ID.
+	// Instances without a status are passing.
+	Health map[string]string
+	// Failures is the number of queries to fail before serving the data.
+	Failures int
+	Lock     sync.Mutex
+
+	// index of the data, which the blocking queries wait to change.
+	index   uint64
+	changed chan struct{}
 }
 
 func newServer() *mockServer {
@@ -89,6 +104,9 @@ func newServer() *mockServer {
 		Productpage: make([]*api.CatalogService, len(productpage)),
 		Reviews:     make([]*api.CatalogService, len(reviews)),
 		Services:    make(map[string][]string),
+		Health:      make(map[string]string),
+		index:       1,
+		changed:     make(chan struct{}),
 	}
 
 	copy(m.Reviews, reviews)
@@ -98,39 +116,119 @@ func newServer() *mockServer {
 	}
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/v1/catalog/services" {
-			m.Lock.Lock()
-			data, _ := json.Marshal(&m.Services)
-			m.Lock.Unlock()
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintln(w, string(data))
-		} else if r.URL.Path == "/v1/catalog/service/reviews" {
-			m.Lock.Lock()
-			data, _ := json.Marshal(&m.Reviews)
-			m.Lock.Unlock()
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintln(w, string(data))
-		} else if r.URL.Path == "/v1/catalog/service/productpage" {
-			m.Lock.Lock()
-			data, _ := json.Marshal(&m.Productpage)
-			m.Lock.Unlock()
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintln(w, string(data))
-		} else {
-			data, _ := json.Marshal(&[]*api.CatalogService{})
-			w.Header().Set("Content-Type", "application/json")
-			fmt.Fprintln(w, string(data))
+		m.Lock.Lock()
+		defer m.Lock.Unlock()
+		m.wait(r)
+		if m.Failures > 0 {
+			m.Failures--
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
 		}
+
+		var data []byte
+		switch {
+		case r.URL.Path == "/v1/catalog/services":
+			data, _ = json.Marshal(&m.Services)
+		case strings.HasPrefix(r.URL.Path, "/v1/catalog/service/"):
+			data, _ = json.Marshal(m.instances(strings.TrimPrefix(r.URL.Path, "/v1/catalog/service/")))
+		case strings.HasPrefix(r.URL.Path, "/v1/health/service/"):
+			_, passingOnly := r.URL.Query()["passing"]
+			data, _ = json.Marshal(m.entries(strings.TrimPrefix(r.URL.Path, "/v1/health/service/"), passingOnly))
+		default:
+			data, _ = json.Marshal(&[]*api.CatalogService{})
+		}
+		w.Header().Set("X-Consul-Index", strconv.FormatUint(m.index, 10))
+		w.Header().Set("X-Consul-LastContact", "0")
+		w.Header().Set("X-Consul-KnownLeader", "true")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, string(data))
 	}))
 
 	m.Server = server
 	return &m
 }
 
+// wait blocks a query with an index until the data changes past the index, or the
+// query times out. It must be called with the lock held.
+func (m *mockServer) wait(r *http.Request) {
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+	timeout := time.After(wait)
+	for expired := false; index != 0 && index == m.index && !expired; {
+		changed := m.changed
+		m.Lock.Unlock()
+		select {
+		case <-changed:
+		case <-timeout:
+			expired = true
+		case <-r.Context().Done():
+			expired = true
+		}
+		m.Lock.Lock()
+	}
+}
+
+// update applies f to the data, and wakes up the blocking queries.
+func (m *mockServer) update(f func()) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	f()
+	m.index++
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+func (m *mockServer) instances(name string) []*api.CatalogService {
+	switch name {
+	case "productpage":
+		return m.Productpage
+	case "reviews":
+		return m.Reviews
+	}
+	return []*api.CatalogService{}
+}
+
+func (m *mockServer) entries(name string, passingOnly bool) []*api.ServiceEntry {
+	entries := []*api.ServiceEntry{}
+	for _, instance := range m.instances(name) {
+		status, exists := m.Health[instance.ServiceID]
+		if !exists {
+			status = api.HealthPassing
+		}
+		if passingOnly && status != api.HealthPassing {
+			continue
+		}
+		entries = append(entries, &api.ServiceEntry{
+			Node: &api.Node{
+				ID:         instance.ID,
+				Node:       instance.Node,
+				Address:    instance.Address,
+				Datacenter: instance.Datacenter,
+				Meta:       instance.NodeMeta,
+			},
+			Service: &api.AgentService{
+				ID:          instance.ServiceID,
+				Service:     instance.ServiceName,
+				Tags:        instance.ServiceTags,
+				Port:        instance.ServicePort,
+				Address:     instance.ServiceAddress,
+				ModifyIndex: instance.ModifyIndex,
+			},
+			Checks: api.HealthChecks{{
+				Node:      instance.Node,
+				CheckID:   "service:" + instance.ServiceID,
+				Status:    status,
+				ServiceID: instance.ServiceID,
+			}},
+		})
+	}
+	return entries
+}
+
 func TestInstances(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -189,10 +287,32 @@ func TestInstances(t *testing.T) {
 	}
 }
 
+func TestInstancesHealth(t *testing.T) {
+	ts := newServer()
+	defer ts.Server.Close()
+	controller, err := NewController(ts.Server.URL)
+	if err != nil {
+		t.Errorf("could not create Consul Controller: %v", err)
+	}
+
+	ts.Health["reviews-v2"] = api.HealthCritical
+	ts.Health["reviews-v3"] = api.HealthWarning
+	instances, err := controller.Instances(serviceHostname("reviews"), []string{}, model.LabelsCollection{})
+	if err != nil {
+		t.Errorf("client encountered error during Instances(): %v", err)
+	}
+	if len(instances) != 1 {
+		t.Fatalf("Instances() returned wrong # of service instances => %d, want 1", len(instances))
+	}
+	if instances[0].Labels["version"] != "v1" {
+		t.Errorf("Instances() returned an instance failing its health checks => %v", instances[0].Labels)
+	}
+}
+
 func TestInstancesBadHostname(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -208,7 +328,7 @@ func TestInstancesBadHostname(t *testing.T) {
 
 func TestInstancesError(t *testing.T) {
 	ts := newServer()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		ts.Server.Close()
 		t.Errorf("could not create Consul Controller: %v", err)
@@ -227,7 +347,7 @@ func TestInstancesError(t *testing.T) {
 func TestGetService(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -248,7 +368,7 @@ func TestGetService(t *testing.T) {
 
 func TestGetServiceError(t *testing.T) {
 	ts := newServer()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		ts.Server.Close()
 		t.Errorf("could not create Consul Controller: %v", err)
@@ -267,7 +387,7 @@ func TestGetServiceError(t *testing.T) {
 func TestGetServiceBadHostname(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -284,7 +404,7 @@ func TestGetServiceBadHostname(t *testing.T) {
 func TestGetServiceNoInstances(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -303,7 +423,7 @@ func TestGetServiceNoInstances(t *testing.T) {
 func TestServices(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -333,7 +453,7 @@ func TestServices(t *testing.T) {
 
 func TestServicesError(t *testing.T) {
 	ts := newServer()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		ts.Server.Close()
 		t.Errorf("could not create Consul Controller: %v", err)
@@ -352,7 +472,7 @@ func TestServicesError(t *testing.T) {
 func TestHostInstances(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
@@ -374,7 +494,7 @@ func TestHostInstances(t *testing.T) {
 
 func TestHostInstancesError(t *testing.T) {
 	ts := newServer()
-	controller, err := NewController(ts.Server.URL, 3*time.Second)
+	controller, err := NewController(ts.Server.URL)
 	if err != nil {
 		ts.Server.Close()
 		t.Errorf("could not create Consul Controller: %v", err)
@@ -28,13 +28,27 @@ import (
 const (
 	protocolTagName = "protocol"
 	externalTagName = "external"
+
+	// healthCheckTagPrefix is the prefix of the keys of the service tags declaring the health check
+	// of the service, e.g. istio.healthcheck.path|/health. They are not converted to labels.
+	healthCheckTagPrefix = "istio.healthcheck."
 )
 
 func convertLabels(labels []string) model.Labels {
 	out := make(model.Labels, len(labels))
-	for _, tag := range labels {
+	for key, value := range convertTags(labels) {
+		if !strings.HasPrefix(key, healthCheckTagPrefix) {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func convertTags(tags []string) map[string]string {
+	out := make(map[string]string, len(tags))
+	for _, tag := range tags {
 		vals := strings.Split(tag, "|")
-		// Labels not of form "key|value" are ignored to avoid possible collisions
+		// Tags not of form "key|value" are ignored to avoid possible collisions
 		if len(vals) > 1 {
 			out[vals[0]] = vals[1]
 		} else {
@@ -64,6 +78,7 @@ func convertService(endpoints []*api.CatalogService) *model.Service {
 		name = endpoint.ServiceName
 
 		port := convertPort(endpoint.ServicePort, endpoint.NodeMeta[protocolTagName])
+		port.HealthCheck = convertHealthCheck(endpoint.ServiceTags, port.Port)
 
 		if svcPort, exists := ports[port.Port]; exists && svcPort.Protocol != port.Protocol {
 			log.Warnf("Service %v has two instances on same port %v but different protocols (%v, %v)",
@@ -121,6 +136,47 @@ func convertInstance(instance *api.CatalogService) *model.ServiceInstance {
 	}
 }
 
+func convertHealthCheck(tags []string, port int) *model.HealthCheck {
+	healthCheck, err := model.ParseHealthCheck(convertTags(tags), healthCheckTagPrefix, port)
+	if err != nil {
+		log.Warnf("Health check ignored: %v", err)
+	}
+	return healthCheck
+}
+
+// convertServiceEntry converts an entry of the health endpoints into the catalog
+// service of the instance.
+func convertServiceEntry(entry *api.ServiceEntry) *api.CatalogService {
+	return &api.CatalogService{
+		ID:                       entry.Node.ID,
+		Node:                     entry.Node.Node,
+		Address:                  entry.Node.Address,
+		Datacenter:               entry.Node.Datacenter,
+		TaggedAddresses:          entry.Node.TaggedAddresses,
+		NodeMeta:                 entry.Node.Meta,
+		ServiceID:                entry.Service.ID,
+		ServiceName:              entry.Service.Service,
+		ServiceAddress:           entry.Service.Address,
+		ServiceTags:              entry.Service.Tags,
+		ServicePort:              entry.Service.Port,
+		ServiceEnableTagOverride: entry.Service.EnableTagOverride,
+		CreateIndex:              entry.Service.CreateIndex,
+		ModifyIndex:              entry.Service.ModifyIndex,
+	}
+}
+
+// isPassing returns true if all the health checks of an instance, including the ones
+// of its node, are passing. Instances in warning, critical or maintenance state don't
+// receive traffic.
+func isPassing(checks api.HealthChecks) bool {
+	for _, check := range checks {
+		if check.Status != api.HealthPassing {
+			return false
+		}
+	}
+	return true
+}
+
 // serviceHostname produces FQDN for a consul service
 func serviceHostname(name string) string {
 	// TODO include datacenter in Hostname?
@@ -16,6 +16,7 @@ package consul
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/consul/api"
@@ -201,3 +202,25 @@ func TestConvertService(t *testing.T) {
 			len(out.Ports), 1)
 	}
 }
+
+func TestConvertServiceHealthCheck(t *testing.T) {
+	out := convertService([]*api.CatalogService{{
+		ServiceName: "productpage",
+		ServiceTags: []string{
+			"version|v1",
+			healthCheckTagPrefix + model.HealthCheckPath + "|/health",
+			healthCheckTagPrefix + "9080." + model.HealthCheckExpectedStatuses + "|204",
+		},
+		ServicePort: 9080,
+	}})
+
+	healthCheck := out.Ports[0].HealthCheck
+	if healthCheck == nil || healthCheck.Path != "/health" || !reflect.DeepEqual(healthCheck.ExpectedStatuses, []int{204}) {
+		t.Errorf("convertService() => health check %v, want the health check declared in the service tags", healthCheck)
+	}
+
+	labels := convertLabels([]string{"version|v1", healthCheckTagPrefix + model.HealthCheckPath + "|/health"})
+	if !reflect.DeepEqual(labels, model.Labels{"version": "v1"}) {
+		t.Errorf("convertLabels() => %v, want the health check tags to be filtered out", labels)
+	}
+}
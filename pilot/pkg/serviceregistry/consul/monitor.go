@@ -15,6 +15,7 @@
 package consul
 
 import (
+	"context"
 	"reflect"
 	"sort"
 	"time"
@@ -26,8 +27,15 @@ import (
 	"istio.io/istio/pkg/log"
 )
 
-type consulServices map[string][]string
-type consulServiceInstances []*api.CatalogService
+// watchWaitTime is the maximum duration of the blocking queries watching the catalog.
+const watchWaitTime = 5 * time.Minute
+
+// The initial and maximum delays before retrying a failed query. These are not const
+// to allow changing the values for unittests.
+var (
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Minute
+)
 
 // Monitor handles service and instance changes
 type Monitor interface {
@@ -43,108 +51,176 @@ type InstanceHandler func(instance *api.CatalogService, event model.Event) error
 type ServiceHandler func(instances []*api.CatalogService, event model.Event) error
 
 type consulMonitor struct {
-	discovery            *api.Client
-	instanceCachedRecord consulServiceInstances
-	serviceCachedRecord  consulServices
-	instanceHandlers     []InstanceHandler
-	serviceHandlers      []ServiceHandler
-	period               time.Duration
+	discovery        *api.Client
+	instanceHandlers []InstanceHandler
+	serviceHandlers  []ServiceHandler
 }
 
-// NewConsulMonitor polls for changes in Consul Services and CatalogServices
-func NewConsulMonitor(client *api.Client, period time.Duration) Monitor {
+// serviceWatch is the state of the watch of a single service.
+type serviceWatch struct {
+	name   string
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// all the instances of the service, and the ones which pass their health checks,
+	// keyed by node and service ID. They are owned by the goroutine of the watch until
+	// it is done.
+	instances map[string]*api.CatalogService
+	passing   map[string]*api.CatalogService
+}
+
+// NewConsulMonitor watches for changes in Consul Services and CatalogServices
+// through blocking queries.
+func NewConsulMonitor(client *api.Client) Monitor {
 	return &consulMonitor{
-		discovery:            client,
-		period:               period,
-		instanceCachedRecord: make(consulServiceInstances, 0),
-		serviceCachedRecord:  make(consulServices),
-		instanceHandlers:     make([]InstanceHandler, 0),
-		serviceHandlers:      make([]ServiceHandler, 0),
+		discovery:        client,
+		instanceHandlers: make([]InstanceHandler, 0),
+		serviceHandlers:  make([]ServiceHandler, 0),
 	}
 }
 
 func (m *consulMonitor) Start(stop <-chan struct{}) {
-	m.run(stop)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stop
+		cancel()
+	}()
+	m.watchCatalog(ctx)
 }
 
-func (m *consulMonitor) run(stop <-chan struct{}) {
-	ticker := time.NewTicker(m.period)
+// watchCatalog watches the list of services in the catalog until ctx is done, and
+// runs a watch for each of them.
+func (m *consulMonitor) watchCatalog(ctx context.Context) {
+	watches := make(map[string]*serviceWatch)
+	defer func() {
+		for _, w := range watches {
+			w.cancel()
+			<-w.done
+		}
+	}()
+
+	var index uint64
+	retry := backoff{}
 	for {
-		select {
-		case <-stop:
-			ticker.Stop()
+		svcs, meta, err := m.discovery.Catalog().Services(queryOptions(ctx, index))
+		if ctx.Err() != nil {
 			return
-		case <-ticker.C:
-			m.updateServiceRecord()
-			m.updateInstanceRecord()
+		}
+		if err != nil {
+			log.Warnf("Could not fetch services: %v", err)
+			if !retry.wait(ctx) {
+				return
+			}
+			continue
+		}
+		retry.reset()
+		index = nextIndex(index, meta.LastIndex)
+
+		for name := range svcs {
+			if _, exists := watches[name]; !exists {
+				wctx, cancel := context.WithCancel(ctx)
+				w := &serviceWatch{
+					name:      name,
+					cancel:    cancel,
+					done:      make(chan struct{}),
+					instances: make(map[string]*api.CatalogService),
+					passing:   make(map[string]*api.CatalogService),
+				}
+				watches[name] = w
+				go m.watchService(wctx, w)
+			}
+		}
+		for name, w := range watches {
+			if _, exists := svcs[name]; !exists {
+				w.cancel()
+				<-w.done
+				delete(watches, name)
+				m.update(w, nil)
+			}
 		}
 	}
 }
 
-func (m *consulMonitor) updateServiceRecord() {
-	svcs, _, err := m.discovery.Catalog().Services(nil)
-	if err != nil {
-		log.Warnf("Could not fetch services: %v", err)
-		return
-	}
-	newRecord := consulServices(svcs)
-	if !reflect.DeepEqual(newRecord, m.serviceCachedRecord) {
-		// This is only a work-around solution currently
-		// Since Handler functions generally act as a refresher
-		// regardless of the input, thus passing in meaningless
-		// input should make functionalities work
-		//TODO
-		obj := []*api.CatalogService{}
-		var event model.Event
-		for _, f := range m.serviceHandlers {
-			go func(handler ServiceHandler) {
-				if err := handler(obj, event); err != nil {
-					log.Warnf("Error executing service handler function: %v", err)
-				}
-			}(f)
+// watchService watches the instances of a service and their health until ctx is done.
+func (m *consulMonitor) watchService(ctx context.Context, w *serviceWatch) {
+	defer close(w.done)
+
+	var index uint64
+	retry := backoff{}
+	for {
+		entries, meta, err := m.discovery.Health().Service(w.name, "", false, queryOptions(ctx, index))
+		if ctx.Err() != nil {
+			return
 		}
-		m.serviceCachedRecord = newRecord
+		if err != nil {
+			log.Warnf("Could not retrieve the instances of service %s from consul: %v", w.name, err)
+			if !retry.wait(ctx) {
+				return
+			}
+			continue
+		}
+		retry.reset()
+		index = nextIndex(index, meta.LastIndex)
+		m.update(w, entries)
 	}
 }
 
-func (m *consulMonitor) updateInstanceRecord() {
-	svcs, _, err := m.discovery.Catalog().Services(nil)
-	if err != nil {
-		log.Warnf("Could not fetch instances: %v", err)
-		return
+// update replaces the instances of the watched service with the entries, and notifies
+// the handlers of the changes.
+func (m *consulMonitor) update(w *serviceWatch, entries []*api.ServiceEntry) {
+	instances := make(map[string]*api.CatalogService, len(entries))
+	passing := make(map[string]*api.CatalogService, len(entries))
+	for _, entry := range entries {
+		instance := convertServiceEntry(entry)
+		key := instance.Node + "/" + instance.ServiceID
+		instances[key] = instance
+		if isPassing(entry.Checks) {
+			passing[key] = instance
+		}
 	}
-	for _, tags := range svcs {
-		sort.Strings(tags)
+
+	oldService, newService := serviceOf(w.instances), serviceOf(instances)
+	switch {
+	case oldService == nil && newService != nil:
+		m.notifyService(sortedInstances(instances), model.EventAdd)
+	case oldService != nil && newService != nil && !reflect.DeepEqual(oldService, newService):
+		m.notifyService(sortedInstances(instances), model.EventUpdate)
 	}
 
-	instances := make([]*api.CatalogService, 0)
-	for name := range svcs {
-		endpoints, _, err := m.discovery.Catalog().Service(name, "", nil)
-		if err != nil {
-			log.Warnf("Could not retrieve service catalogue from consul: %v", err)
-			continue
+	for key, instance := range passing {
+		old, exists := w.passing[key]
+		switch {
+		case !exists:
+			m.notifyInstance(instance, model.EventAdd)
+		case !reflect.DeepEqual(old, instance):
+			m.notifyInstance(instance, model.EventUpdate)
+		}
+	}
+	for key, instance := range w.passing {
+		if _, exists := passing[key]; !exists {
+			m.notifyInstance(instance, model.EventDelete)
 		}
-		instances = append(instances, endpoints...)
 	}
 
-	newRecord := consulServiceInstances(instances)
-	sort.Sort(newRecord)
-	if !reflect.DeepEqual(newRecord, m.instanceCachedRecord) {
-		// This is only a work-around solution currently
-		// Since Handler functions generally act as a refresher
-		// regardless of the input, thus passing in meaningless
-		// input should make functionalities work
-		// TODO
-		obj := &api.CatalogService{}
-		var event model.Event
-		for _, f := range m.instanceHandlers {
-			go func(handler InstanceHandler) {
-				if err := handler(obj, event); err != nil {
-					log.Warnf("Error executing instance handler function: %v", err)
-				}
-			}(f)
+	if oldService != nil && newService == nil {
+		m.notifyService(sortedInstances(w.instances), model.EventDelete)
+	}
+	w.instances, w.passing = instances, passing
+}
+
+func (m *consulMonitor) notifyService(instances []*api.CatalogService, event model.Event) {
+	for _, f := range m.serviceHandlers {
+		if err := f(instances, event); err != nil {
+			log.Warnf("Error executing service handler function: %v", err)
+		}
+	}
+}
+
+func (m *consulMonitor) notifyInstance(instance *api.CatalogService, event model.Event) {
+	for _, f := range m.instanceHandlers {
+		if err := f(instance, event); err != nil {
+			log.Warnf("Error executing instance handler function: %v", err)
 		}
-		m.instanceCachedRecord = newRecord
 	}
 }
 
@@ -156,17 +232,67 @@ func (m *consulMonitor) AppendInstanceHandler(h InstanceHandler) {
 	m.instanceHandlers = append(m.instanceHandlers, h)
 }
 
-// Len of the array
-func (a consulServiceInstances) Len() int {
-	return len(a)
+// serviceOf returns the service of the instances, or nil if there are none.
+func serviceOf(instances map[string]*api.CatalogService) *model.Service {
+	if len(instances) == 0 {
+		return nil
+	}
+	svc := convertService(sortedInstances(instances))
+	sort.Slice(svc.Ports, func(i, j int) bool { return svc.Ports[i].Port < svc.Ports[j].Port })
+	return svc
 }
 
-// Swap i and j
-func (a consulServiceInstances) Swap(i, j int) {
-	a[i], a[j] = a[j], a[i]
+func sortedInstances(instances map[string]*api.CatalogService) []*api.CatalogService {
+	out := make([]*api.CatalogService, 0, len(instances))
+	for _, instance := range instances {
+		out = append(out, instance)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Node != out[j].Node {
+			return out[i].Node < out[j].Node
+		}
+		return out[i].ServiceID < out[j].ServiceID
+	})
+	return out
+}
+
+// queryOptions returns the options of a query which blocks until the index of the
+// result is past index, unless index is 0.
+func queryOptions(ctx context.Context, index uint64) *api.QueryOptions {
+	q := &api.QueryOptions{WaitIndex: index, WaitTime: watchWaitTime}
+	return q.WithContext(ctx)
+}
+
+// nextIndex returns the index to wait for in the next blocking query.
+func nextIndex(index, lastIndex uint64) uint64 {
+	// the index can go backwards, e.g. after the data of the servers was restored.
+	// The query then needs to start over.
+	if lastIndex < index {
+		return 0
+	}
+	return lastIndex
 }
 
-// Less i and j
-func (a consulServiceInstances) Less(i, j int) bool {
-	return a[i].ID < a[j].ID
+// backoff computes the delays before retrying failed queries.
+type backoff struct {
+	delay time.Duration
+}
+
+func (b *backoff) reset() {
+	b.delay = 0
+}
+
+// wait waits before the next retry, and returns false if ctx is done first.
+func (b *backoff) wait(ctx context.Context) bool {
+	if b.delay == 0 {
+		b.delay = minRetryDelay
+	} else if b.delay *= 2; b.delay > maxRetryDelay {
+		b.delay = maxRetryDelay
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(b.delay):
+		return true
+	}
 }
@@ -15,7 +15,9 @@
 package consul
 
 import (
-	"sync"
+	"fmt"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -24,15 +26,48 @@ import (
 	"istio.io/istio/pilot/pkg/model"
 )
 
-const (
-	resync          = 5 * time.Millisecond
-	notifyThreshold = resync * 10
-)
+const notifyThreshold = 50 * time.Millisecond
 
-func TestController(t *testing.T) {
-	// https://github.com/istio/istio/issues/2318
-	t.SkipNow()
+type event struct {
+	kind  string
+	name  string
+	event model.Event
+}
+
+func (e event) String() string {
+	return fmt.Sprintf("%s %s %s", e.event, e.kind, e.name)
+}
+
+// expectEvents waits for the events, in any order, and checks that no other event follows.
+func expectEvents(t *testing.T, events <-chan event, want ...event) {
+	t.Helper()
+	got := make([]string, 0, len(want))
+	for len(got) < len(want) {
+		select {
+		case e := <-events:
+			got = append(got, e.String())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("got events %v, want %v", got, want)
+		}
+	}
+	select {
+	case e := <-events:
+		t.Errorf("got unexpected event %v", e)
+	case <-time.After(notifyThreshold):
+	}
+
+	wantStrings := make([]string, 0, len(want))
+	for _, e := range want {
+		wantStrings = append(wantStrings, e.String())
+	}
+	sort.Strings(got)
+	sort.Strings(wantStrings)
+	if !reflect.DeepEqual(got, wantStrings) {
+		t.Errorf("got events %v, want %v", got, wantStrings)
+	}
+}
 
+func TestController(t *testing.T) {
 	ts := newServer()
 	defer ts.Server.Close()
 	conf := api.DefaultConfig()
@@ -43,30 +78,15 @@ func TestController(t *testing.T) {
 		t.Errorf("could not create Consul Controller: %v", err)
 	}
 
-	countMutex := sync.Mutex{}
-	count := 0
-
-	incrementCount := func() {
-		countMutex.Lock()
-		defer countMutex.Unlock()
-		count++
-	}
-	getCountAndReset := func() int {
-		countMutex.Lock()
-		defer countMutex.Unlock()
-		i := count
-		count = 0
-		return i
-	}
-
-	ctl := NewConsulMonitor(cl, resync)
-	ctl.AppendInstanceHandler(func(instance *api.CatalogService, event model.Event) error {
-		incrementCount()
+	events := make(chan event, 100)
+	ctl := NewConsulMonitor(cl)
+	ctl.AppendInstanceHandler(func(instance *api.CatalogService, e model.Event) error {
+		events <- event{"instance", instance.ServiceID, e}
 		return nil
 	})
 
-	ctl.AppendServiceHandler(func(instances []*api.CatalogService, event model.Event) error {
-		incrementCount()
+	ctl.AppendServiceHandler(func(instances []*api.CatalogService, e model.Event) error {
+		events <- event{"service", instances[0].ServiceName, e}
 		return nil
 	})
 
@@ -74,50 +94,94 @@ func TestController(t *testing.T) {
 	go ctl.Start(stop)
 	defer close(stop)
 
-	time.Sleep(notifyThreshold)
-	getCountAndReset()
-
-	time.Sleep(notifyThreshold)
-	if i := getCountAndReset(); i != 0 {
-		t.Errorf("got %d notifications from controller, want %d", i, 0)
-	}
+	expectEvents(t, events,
+		event{"service", "productpage", model.EventAdd},
+		event{"service", "reviews", model.EventAdd},
+		event{"instance", "productpage-v1", model.EventAdd},
+		event{"instance", "reviews-v1", model.EventAdd},
+		event{"instance", "reviews-v2", model.EventAdd},
+		event{"instance", "reviews-v3", model.EventAdd})
 
 	// re-ordering of service instances -> does not trigger update
-	ts.Lock.Lock()
-	tmpReview := reviews[0]
-	reviews[0] = reviews[len(reviews)-1]
-	reviews[len(reviews)-1] = tmpReview
-	ts.Lock.Unlock()
-
-	time.Sleep(notifyThreshold)
-	if i := getCountAndReset(); i != 0 {
-		t.Errorf("got %d notifications from controller, want %d", i, 0)
-	}
+	ts.update(func() {
+		ts.Reviews[0], ts.Reviews[2] = ts.Reviews[2], ts.Reviews[0]
+	})
+	expectEvents(t, events)
 
 	// same service, new tag -> triggers instance update
-	ts.Lock.Lock()
-	ts.Productpage[0].ServiceTags = append(ts.Productpage[0].ServiceTags, "new|tag")
-	ts.Lock.Unlock()
-	time.Sleep(notifyThreshold)
-	if i := getCountAndReset(); i != 1 {
-		t.Errorf("got %d notifications from controller, want %d", i, 2)
-	}
+	ts.update(func() {
+		instance := *ts.Productpage[0]
+		instance.ServiceTags = []string{"version|v1", "new|tag"}
+		ts.Productpage[0] = &instance
+	})
+	expectEvents(t, events, event{"instance", "productpage-v1", model.EventUpdate})
 
-	// delete a service instance -> trigger instance update
-	ts.Lock.Lock()
-	ts.Reviews = reviews[0:1]
-	ts.Lock.Unlock()
-	time.Sleep(notifyThreshold)
-	if i := getCountAndReset(); i != 1 {
-		t.Errorf("got %d notifications from controller, want %d", i, 1)
-	}
+	// failing health check -> removes the instance until it passes again
+	ts.update(func() {
+		ts.Health["reviews-v2"] = api.HealthCritical
+	})
+	expectEvents(t, events, event{"instance", "reviews-v2", model.EventDelete})
+	ts.update(func() {
+		ts.Health["reviews-v2"] = api.HealthPassing
+	})
+	expectEvents(t, events, event{"instance", "reviews-v2", model.EventAdd})
+
+	// new port -> triggers service and instance update
+	ts.update(func() {
+		instance := *ts.Reviews[1]
+		instance.ServicePort = 9081
+		ts.Reviews[1] = &instance
+	})
+	expectEvents(t, events,
+		event{"service", "reviews", model.EventUpdate},
+		event{"instance", "reviews-v2", model.EventUpdate})
+
+	// delete a service instance -> trigger instance delete
+	ts.update(func() {
+		ts.Reviews = ts.Reviews[1:]
+	})
+	expectEvents(t, events, event{"instance", "reviews-v3", model.EventDelete})
+
+	// delete a service -> trigger service and instance delete
+	ts.update(func() {
+		delete(ts.Services, "productpage")
+	})
+	expectEvents(t, events,
+		event{"service", "productpage", model.EventDelete},
+		event{"instance", "productpage-v1", model.EventDelete})
+}
 
-	// delete a service -> trigger service and instance update
-	ts.Lock.Lock()
-	delete(ts.Services, "productpage")
-	ts.Lock.Unlock()
-	time.Sleep(notifyThreshold)
-	if i := getCountAndReset(); i != 2 {
-		t.Errorf("got %d notifications from controller, want %d", i, 2)
+func TestControllerRetry(t *testing.T) {
+	minRetryDelay = time.Millisecond
+	defer func() { minRetryDelay = time.Second }()
+
+	ts := newServer()
+	defer ts.Server.Close()
+	conf := api.DefaultConfig()
+	conf.Address = ts.Server.URL
+	cl, err := api.NewClient(conf)
+	if err != nil {
+		t.Errorf("could not create Consul Controller: %v", err)
 	}
+	ts.Failures = 5
+
+	events := make(chan event, 100)
+	ctl := NewConsulMonitor(cl)
+	ctl.AppendServiceHandler(func(instances []*api.CatalogService, e model.Event) error {
+		events <- event{"service", instances[0].ServiceName, e}
+		return nil
+	})
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		ctl.Start(stop)
+		close(done)
+	}()
+	defer func() {
+		close(stop)
+		<-done
+	}()
+
+	expectEvents(t, events,
+		event{"service", "productpage", model.EventAdd},
+		event{"service", "reviews", model.EventAdd})
 }
@@ -16,6 +16,8 @@ package eureka
 
 import (
 	"fmt"
+	"strings"
+
 	// TODO(nmittler): Remove this
 	_ "github.com/golang/glog"
 
@@ -112,15 +114,27 @@ func convertPorts(instance *instance) model.PortList {
 		}
 
 		out = append(out, &model.Port{
-			Name:     fmt.Sprint(port.Port),
-			Port:     port.Port,
-			Protocol: protocol,
+			Name:        fmt.Sprint(port.Port),
+			Port:        port.Port,
+			Protocol:    protocol,
+			HealthCheck: convertHealthCheck(instance.Metadata, port.Port),
 		})
 	}
 	return out
 }
 
-const protocolMetadata = "istio.protocol" // metadata key for port protocol
+const (
+	protocolMetadata          = "istio.protocol"     // metadata key for port protocol
+	healthCheckMetadataPrefix = "istio.healthcheck." // metadata key prefix for the health check of the ports
+)
+
+func convertHealthCheck(md metadata, port int) *model.HealthCheck {
+	healthCheck, err := model.ParseHealthCheck(md, healthCheckMetadataPrefix, port)
+	if err != nil {
+		log.Warnf("invalid health check, ignored: %v", err)
+	}
+	return healthCheck
+}
 
 func convertProtocol(md metadata) model.Protocol {
 	name := md[protocolMetadata]
@@ -139,6 +153,9 @@ func convertProtocol(md metadata) model.Protocol {
 func convertLabels(metadata metadata) model.Labels {
 	labels := make(model.Labels)
 	for k, v := range metadata {
+		if strings.HasPrefix(k, healthCheckMetadataPrefix) {
+			continue
+		}
 		labels[k] = v
 	}
 
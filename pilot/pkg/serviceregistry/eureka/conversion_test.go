@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/test/util"
@@ -208,16 +209,40 @@ func TestConvertProtocol(t *testing.T) {
 	}
 }
 
+func TestConvertHealthCheck(t *testing.T) {
+	if healthCheck := convertHealthCheck(metadata{protocolMetadata: "http"}, 8080); healthCheck != nil {
+		t.Errorf("convertHealthCheck() => %v, want no health check", healthCheck)
+	}
+	if healthCheck := convertHealthCheck(metadata{healthCheckMetadataPrefix + model.HealthCheckInterval: "0s"}, 8080); healthCheck != nil {
+		t.Errorf("convertHealthCheck() => %v, want an invalid health check to be ignored", healthCheck)
+	}
+
+	md := metadata{
+		healthCheckMetadataPrefix + model.HealthCheckPath:           "/health",
+		healthCheckMetadataPrefix + model.HealthCheckInterval:       "5s",
+		healthCheckMetadataPrefix + "8443." + model.HealthCheckPath: "/secure/health",
+	}
+	healthCheck := convertHealthCheck(md, 8080)
+	if healthCheck == nil || healthCheck.Path != "/health" || healthCheck.Interval != 5*time.Second {
+		t.Errorf("convertHealthCheck() => %v, want the declared health check", healthCheck)
+	}
+	healthCheck = convertHealthCheck(md, 8443)
+	if healthCheck == nil || healthCheck.Path != "/secure/health" || healthCheck.Interval != 5*time.Second {
+		t.Errorf("convertHealthCheck() => %v, want the health check of the port", healthCheck)
+	}
+}
+
 func TestConvertLabels(t *testing.T) {
 	md := metadata{
 		"@class":         "java.util.Collections$EmptyMap",
 		protocolMetadata: "http2",
-		"kit":            "kat",
-		"spam":           "coolaid",
+		healthCheckMetadataPrefix + model.HealthCheckPath: "/health",
+		"kit":  "kat",
+		"spam": "coolaid",
 	}
 	labels := convertLabels(md)
 
-	for _, special := range []string{protocolMetadata, "@class"} {
+	for _, special := range []string{protocolMetadata, "@class", healthCheckMetadataPrefix + model.HealthCheckPath} {
 		if _, exists := labels[special]; exists {
 			t.Errorf("convertLabels did not filter out special tag %q", special)
 		}
@@ -0,0 +1,129 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"istio.io/istio/pkg/log"
+)
+
+const (
+	defaultClusterCheckDuration = 5 * time.Second
+)
+
+// ClusterMonitor monitors a directory of kubeconfig files giving access to the API servers of the
+// remote clusters of a multi-cluster mesh, e.g. a mounted secret with a key per cluster. The clusters
+// are named after their file, and are added and removed as the files are created, updated and deleted.
+type ClusterMonitor struct {
+	root          string
+	checkDuration time.Duration
+
+	// add is called with the ID and the kubeconfig path of a new cluster
+	add func(clusterID, kubeconfig string) error
+	// remove is called with the ID of a deleted cluster
+	remove func(clusterID string)
+
+	// clusters holds the kubeconfig of the added clusters, by cluster ID
+	clusters map[string][]byte
+}
+
+// NewClusterMonitor creates a monitor of the kubeconfig files under the given root directory.
+func NewClusterMonitor(rootDirectory string, add func(clusterID, kubeconfig string) error,
+	remove func(clusterID string)) *ClusterMonitor {
+	return &ClusterMonitor{
+		root:          rootDirectory,
+		checkDuration: defaultClusterCheckDuration,
+		add:           add,
+		remove:        remove,
+		clusters:      make(map[string][]byte),
+	}
+}
+
+// Start adds the clusters of the kubeconfig files currently present, and kicks off an asynchronous
+// event loop that periodically looks for changes to the root dir until the stop channel is closed.
+func (m *ClusterMonitor) Start(stop chan struct{}) {
+	m.checkAndUpdate()
+	tick := time.NewTicker(m.checkDuration)
+
+	go func() {
+		for {
+			select {
+			case <-stop:
+				tick.Stop()
+				return
+			case <-tick.C:
+				m.checkAndUpdate()
+			}
+		}
+	}()
+}
+
+func (m *ClusterMonitor) checkAndUpdate() {
+	files, err := ioutil.ReadDir(m.root)
+	if err != nil {
+		log.Warnf("Failed to read the kubeconfigs of the remote clusters in %s: %v", m.root, err)
+		return
+	}
+
+	kubeconfigs := make(map[string][]byte)
+	for _, f := range files {
+		// skip the hidden entries of secret volumes, e.g. ..data
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(m.root, f.Name()))
+		if err != nil {
+			log.Warnf("Failed to read the kubeconfig of cluster %s: %v", f.Name(), err)
+			continue
+		}
+		// an empty kubeconfig would resolve to the in-cluster configuration
+		if len(data) == 0 {
+			continue
+		}
+		kubeconfigs[f.Name()] = data
+	}
+
+	// Remove the deleted clusters, and the updated ones which are added again below
+	for clusterID, data := range m.clusters {
+		if current, exists := kubeconfigs[clusterID]; !exists || !bytes.Equal(current, data) {
+			log.Infof("Removing remote cluster %s", clusterID)
+			m.remove(clusterID)
+			delete(m.clusters, clusterID)
+		}
+	}
+
+	clusterIDs := make([]string, 0, len(kubeconfigs))
+	for clusterID := range kubeconfigs {
+		if _, exists := m.clusters[clusterID]; !exists {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	sort.Strings(clusterIDs)
+	for _, clusterID := range clusterIDs {
+		log.Infof("Adding remote cluster %s", clusterID)
+		if err := m.add(clusterID, filepath.Join(m.root, clusterID)); err != nil {
+			// the cluster is added again on the next check
+			log.Warnf("Failed to add remote cluster %s: %v", clusterID, err)
+			continue
+		}
+		m.clusters[clusterID] = kubeconfigs[clusterID]
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestClusterMonitor(t *testing.T) {
+	root, err := ioutil.TempDir("", "clusters")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(root) }()
+
+	writeFile := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var events []string
+	var addErr error
+	m := NewClusterMonitor(root,
+		func(clusterID, kubeconfig string) error {
+			if kubeconfig != filepath.Join(root, clusterID) {
+				t.Errorf("add(%s) got kubeconfig %s", clusterID, kubeconfig)
+			}
+			if addErr != nil {
+				return addErr
+			}
+			events = append(events, "add "+clusterID)
+			return nil
+		},
+		func(clusterID string) {
+			events = append(events, "remove "+clusterID)
+		})
+
+	cases := []struct {
+		name   string
+		update func()
+		want   []string
+	}{
+		{
+			name: "add clusters",
+			update: func() {
+				writeFile("east", "east")
+				writeFile("west", "west")
+				writeFile(".hidden", "hidden")
+				writeFile("empty", "")
+			},
+			want: []string{"add east", "add west"},
+		},
+		{
+			name:   "no change",
+			update: func() {},
+			want:   nil,
+		},
+		{
+			name:   "update a cluster",
+			update: func() { writeFile("east", "east2") },
+			want:   []string{"remove east", "add east"},
+		},
+		{
+			name: "remove a cluster",
+			update: func() {
+				if err := os.Remove(filepath.Join(root, "west")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []string{"remove west"},
+		},
+		{
+			name: "failed addition is retried",
+			update: func() {
+				writeFile("north", "north")
+				addErr = errors.New("unreachable")
+				m.checkAndUpdate()
+				addErr = nil
+			},
+			want: []string{"add north"},
+		},
+	}
+
+	for _, c := range cases {
+		events = nil
+		c.update()
+		m.checkAndUpdate()
+		if !reflect.DeepEqual(events, c.want) {
+			t.Errorf("%s: got events %v, want %v", c.name, events, c.want)
+		}
+	}
+}
@@ -47,12 +47,16 @@ type ControllerOptions struct {
 	WatchedNamespace string
 	ResyncPeriod     time.Duration
 	DomainSuffix     string
+	// ClusterID identifies the cluster of the API server in a multi-cluster mesh, it is
+	// set on the service instances of the controller
+	ClusterID string
 }
 
 // Controller is a collection of synchronized resource watchers
 // Caches are thread-safe
 type Controller struct {
 	domainSuffix string
+	clusterID    string
 
 	client    kubernetes.Interface
 	queue     Queue
@@ -75,6 +79,7 @@ func NewController(client kubernetes.Interface, options ControllerOptions) *Cont
 	// Queue requires a time duration for a retry delay after a handler error
 	out := &Controller{
 		domainSuffix: options.DomainSuffix,
+		clusterID:    options.ClusterID,
 		client:       client,
 		queue:        NewQueue(1 * time.Second),
 	}
@@ -325,6 +330,7 @@ func (c *Controller) Instances(hostname string, ports []string,
 								Labels:           labels,
 								AvailabilityZone: az,
 								ServiceAccount:   sa,
+								ClusterID:        c.clusterID,
 							})
 						}
 					}
@@ -388,6 +394,7 @@ func (c *Controller) HostInstances(svcNodes map[string]*model.Node) ([]*model.Se
 							Labels:           labels,
 							AvailabilityZone: az,
 							ServiceAccount:   sa,
+							ClusterID:        c.clusterID,
 						})
 					}
 				}
@@ -27,6 +27,7 @@ import (
 
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/log"
 )
 
 type kubeServiceNode struct {
@@ -59,6 +60,11 @@ const (
 	// PortAuthenticationAnnotationKeyPrefix is the annotation key prefix that used to define
 	// authentication policy.
 	PortAuthenticationAnnotationKeyPrefix = "auth.istio.io"
+
+	// HealthCheckAnnotationKeyPrefix is the annotation key prefix that used to define the active
+	// health check of the ports of a service, e.g. healthcheck.istio.io/path for all the ports, or
+	// healthcheck.istio.io/9080.path for a single port.
+	HealthCheckAnnotationKeyPrefix = "healthcheck.istio.io"
 )
 
 func convertLabels(obj meta_v1.ObjectMeta) model.Labels {
@@ -82,12 +88,22 @@ func extractAuthenticationPolicy(port v1.ServicePort, obj meta_v1.ObjectMeta) me
 	return meshconfig.AuthenticationPolicy_INHERIT
 }
 
+// Extracts the health check of the given port from the annotations. Invalid health checks are ignored.
+func extractHealthCheck(port v1.ServicePort, obj meta_v1.ObjectMeta) *model.HealthCheck {
+	healthCheck, err := model.ParseHealthCheck(obj.Annotations, HealthCheckAnnotationKeyPrefix+"/", int(port.Port))
+	if err != nil {
+		log.Warnf("Health check of service %s.%s ignored: %v", obj.Name, obj.Namespace, err)
+	}
+	return healthCheck
+}
+
 func convertPort(port v1.ServicePort, obj meta_v1.ObjectMeta) *model.Port {
 	return &model.Port{
 		Name:                 port.Name,
 		Port:                 int(port.Port),
 		Protocol:             ConvertProtocol(port.Name, port.Protocol),
 		AuthenticationPolicy: extractAuthenticationPolicy(port, obj),
+		HealthCheck:          extractHealthCheck(port, obj),
 	}
 }
 
@@ -17,6 +17,7 @@ package kube
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -240,6 +241,44 @@ func TestServiceSecurityAnnotation(t *testing.T) {
 
 }
 
+func TestServiceHealthCheckAnnotation(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "service1",
+			Namespace: "default",
+			Annotations: map[string]string{
+				HealthCheckAnnotationKeyPrefix + "/" + model.HealthCheckPath:                  "/health",
+				HealthCheckAnnotationKeyPrefix + "/" + model.HealthCheckInterval:              "5s",
+				HealthCheckAnnotationKeyPrefix + "/9090." + model.HealthCheckPath:             "/ready",
+				HealthCheckAnnotationKeyPrefix + "/9090." + model.HealthCheckExpectedStatuses: "204",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 8080, Protocol: v1.ProtocolTCP},
+				{Name: "http-admin", Port: 9090, Protocol: v1.ProtocolTCP},
+			},
+		},
+	}
+
+	service := convertService(svc, domainSuffix)
+	if hc := service.Ports[0].HealthCheck; hc == nil || hc.Path != "/health" || hc.Interval != 5*time.Second ||
+		hc.ExpectedStatuses != nil {
+		t.Errorf("got health check %#v for port 8080", hc)
+	}
+	if hc := service.Ports[1].HealthCheck; hc == nil || hc.Path != "/ready" || hc.Interval != 5*time.Second ||
+		!reflect.DeepEqual(hc.ExpectedStatuses, []int{204}) {
+		t.Errorf("got health check %#v for port 9090", hc)
+	}
+
+	// invalid health checks are ignored.
+	svc.Annotations = map[string]string{HealthCheckAnnotationKeyPrefix + "/" + model.HealthCheckInterval: "often"}
+	if hc := convertService(svc, domainSuffix).Ports[0].HealthCheck; hc != nil {
+		t.Errorf("got health check %#v, want the invalid health check to be ignored", hc)
+	}
+}
+
 func TestExternalServiceConversion(t *testing.T) {
 	serviceName := "service1"
 	namespace := "default"
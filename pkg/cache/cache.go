@@ -45,6 +45,12 @@ type Stats struct {
 	Removals uint64
 }
 
+// EvictionCallback is called with the key and value of an entry evicted from a cache,
+// because it expired or was displaced by a new entry. It is not called for the entries
+// which are explicitly removed. It is called with the cache locked, and must not call
+// back into the cache.
+type EvictionCallback func(key interface{}, value interface{})
+
 // Cache defines the standard behavior of in-memory thread-safe caches.
 //
 // Different caches can have different eviction policies which determine
@@ -65,7 +71,6 @@ type Stats struct {
 type Cache interface {
 	// Ideas for the future:
 	//   - Return the number of entries in the cache in stats.
-	//   - Provide an eviction callback to know when entries are evicted.
 	//   - Have Set and Remove return the previous value for the key, if any.
 	//   - Have Get return the expiration time for entries.
 
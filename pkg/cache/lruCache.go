@@ -89,6 +89,7 @@ type lruCache struct {
 	defaultExpiration time.Duration
 	stopEvicter       chan bool
 	baseTimeNanos     int64
+	onEvict           EvictionCallback
 	evicterTerminated bool // used by unit tests to verify the finalizer ran
 }
 
@@ -121,10 +122,18 @@ const sentinelIndex = 0
 // evictionInterval specifies the frequency at which eviction activities take
 // place. This should likely be >= 1 second.
 func NewLRU(defaultExpiration time.Duration, evictionInterval time.Duration, maxEntries int32) ExpiringCache {
+	return NewLRUWithEvictionCallback(defaultExpiration, evictionInterval, maxEntries, nil)
+}
+
+// NewLRUWithEvictionCallback creates a new cache like NewLRU, which calls onEvict
+// with the entries that expire or are displaced by new entries.
+func NewLRUWithEvictionCallback(defaultExpiration time.Duration, evictionInterval time.Duration, maxEntries int32,
+	onEvict EvictionCallback) ExpiringCache {
 	c := &lruCache{
 		entries:           make([]lruEntry, maxEntries+1),
 		lookup:            make(map[interface{}]int32, maxEntries),
 		defaultExpiration: defaultExpiration,
+		onEvict:           onEvict,
 	}
 
 	// create the linked list of entries
@@ -186,6 +195,9 @@ func (c *lruCache) evictExpired(t time.Time) {
 			c.Lock()
 
 			if ent.expiration <= n {
+				if c.onEvict != nil {
+					c.onEvict(ent.key, ent.value)
+				}
 				c.remove(i)
 				c.stats.Evictions++
 			}
@@ -237,6 +249,9 @@ func (c *lruCache) SetWithExpiration(key interface{}, value interface{}, expirat
 	if !ok {
 		// reclaim the tail entry
 		index = c.sentinel.prev
+		if tail := &c.entries[index]; tail.key != nil && c.onEvict != nil {
+			c.onEvict(tail.key, tail.value)
+		}
 		delete(c.lookup, c.entries[index].key)
 		c.lookup[key] = index
 	}
@@ -53,6 +53,36 @@ func TestLRUFinalizer(t *testing.T) {
 	testCacheFinalizer(gate, t)
 }
 
+func TestLRUEvictionCallback(t *testing.T) {
+	var evicted []interface{}
+	lru := NewLRUWithEvictionCallback(5*time.Second, 0, 2, func(key, value interface{}) {
+		if key != value {
+			t.Errorf("Got evicted entry %v:%v, expected the value of the key", key, value)
+		}
+		evicted = append(evicted, key)
+	}).(*lruCache)
+
+	lru.Set("1", "1")
+	lru.Set("2", "2")
+	lru.Remove("2")
+	lru.Set("3", "3")
+	if len(evicted) != 0 {
+		t.Errorf("Got evicted entries %v, expected none", evicted)
+	}
+
+	// displace the least recently used entry
+	lru.Set("4", "4")
+	if len(evicted) != 1 || evicted[0] != "1" {
+		t.Errorf("Got evicted entries %v, expected [1]", evicted)
+	}
+
+	// expire the remaining entries
+	lru.evictExpired(time.Now().Add(10 * time.Second))
+	if len(evicted) != 3 {
+		t.Errorf("Got evicted entries %v, expected [1 3 4] in any order", evicted)
+	}
+}
+
 func TestLRUBehavior(t *testing.T) {
 	lru := NewLRU(5*time.Minute, 1*time.Millisecond, 3)
 
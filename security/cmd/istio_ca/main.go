@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -35,6 +36,7 @@ import (
 	"istio.io/istio/security/pkg/registry"
 	"istio.io/istio/security/pkg/registry/kube"
 	"istio.io/istio/security/pkg/server/grpc"
+	"istio.io/istio/security/pkg/server/revocation"
 )
 
 const (
@@ -66,6 +68,13 @@ type cliOptions struct {
 	selfSignedCA    bool
 	selfSignedCAOrg string
 
+	keyAlgorithm string
+
+	vaultAddress   string
+	vaultTokenFile string
+	vaultMount     string
+	vaultRole      string
+
 	caCertTTL          time.Duration
 	workloadCertTTL    time.Duration
 	maxWorkloadCertTTL time.Duration
@@ -73,6 +82,10 @@ type cliOptions struct {
 	grpcHostname string
 	grpcPort     int
 
+	issuanceLogFile        string
+	revocationPort         int
+	revocationAdminAddress string
+
 	loggingOptions *log.Options
 
 	// The path to the file which indicates the liveness of the server by its existence.
@@ -97,7 +110,7 @@ var (
 
 func fatalf(template string, args ...interface{}) {
 	if len(args) > 0 {
-		log.Errorf(template, args)
+		log.Errorf(template, args...)
 	} else {
 		log.Errorf(template)
 	}
@@ -128,6 +141,16 @@ func init() {
 		fmt.Sprintf("The issuer organization used in self-signed CA certificate (default to %s)",
 			selfSignedCAOrgDefault))
 
+	flags.StringVar(&opts.keyAlgorithm, "key-algorithm", string(ca.RSA),
+		fmt.Sprintf("The algorithm of the self-signed CA key and of the workload keys: %v", ca.KeyAlgorithms))
+
+	flags.StringVar(&opts.vaultAddress, "vault-address", "",
+		"Address of an external Vault PKI that signs the certificates. When set, the signing cert and key options "+
+			"and '--self-signed-ca' are ignored.")
+	flags.StringVar(&opts.vaultTokenFile, "vault-token-file", "", "Specifies path to the Vault token file")
+	flags.StringVar(&opts.vaultMount, "vault-pki-mount", "pki", "Mount path of the Vault PKI secrets engine")
+	flags.StringVar(&opts.vaultRole, "vault-role", "", "The Vault PKI role used to sign workload certificates")
+
 	flags.DurationVar(&opts.caCertTTL, "ca-cert-ttl", defaultCACertTTL,
 		"The TTL of self-signed CA root certificate")
 	flags.DurationVar(&opts.workloadCertTTL, "workload-cert-ttl", defaultWorkloadCertTTL, "The TTL of issued workload certificates")
@@ -137,6 +160,13 @@ func init() {
 	flags.IntVar(&opts.grpcPort, "grpc-port", 0, "Specifies the port number for GRPC server. "+
 		"If unspecified, Istio CA will not server GRPC request.")
 
+	flags.StringVar(&opts.issuanceLogFile, "issuance-log", "", "Specifies path to the file recording the issued "+
+		"certificates. Certificates can only be revoked if it is set. Expired certificates are dropped from it.")
+	flags.IntVar(&opts.revocationPort, "revocation-port", 0, "Specifies the port number serving the CRL and OCSP "+
+		"responses. If unspecified, Istio CA will not serve them.")
+	flags.StringVar(&opts.revocationAdminAddress, "revocation-admin-address", "localhost:8061",
+		"Specifies the address accepting certificate revocations when '--revocation-port' is set.")
+
 	rootCmd.AddCommand(version.CobraCommand())
 
 	opts.loggingOptions.AttachCobraFlags(rootCmd)
@@ -166,10 +196,11 @@ func runCA() {
 
 	verifyCommandLineOptions()
 
+	keyAlgorithm := ca.KeyAlgorithm(opts.keyAlgorithm)
 	cs := createClientset()
 	ca := createCA(cs.CoreV1())
 	// For workloads in K8s, we apply the configured workload cert TTL.
-	sc := controller.NewSecretController(ca, opts.workloadCertTTL, cs.CoreV1(), opts.namespace)
+	sc := controller.NewSecretController(ca, opts.workloadCertTTL, keyAlgorithm, cs.CoreV1(), opts.namespace)
 
 	stopCh := make(chan struct{})
 	sc.Run(stopCh)
@@ -197,6 +228,10 @@ func runCA() {
 		}
 	}
 
+	if opts.revocationPort > 0 {
+		runRevocationServer(ca)
+	}
+
 	log.Info("Istio CA has started")
 	select {} // wait forever
 }
@@ -214,12 +249,17 @@ func createCA(core corev1.SecretsGetter) ca.CertificateAuthority {
 	var caOpts *ca.IstioCAOptions
 	var err error
 
+	if opts.vaultAddress != "" {
+		return createVaultCA()
+	}
+
 	if opts.selfSignedCA {
 		log.Info("Use self-signed certificate as the CA certificate")
 
 		// TODO(wattli): Refactor this and combine it with NewIstioCA().
 		caOpts, err = ca.NewSelfSignedIstioCAOptions(opts.caCertTTL, opts.workloadCertTTL,
-			opts.maxWorkloadCertTTL, opts.selfSignedCAOrg, opts.istioCaStorageNamespace, core)
+			opts.maxWorkloadCertTTL, opts.selfSignedCAOrg, opts.istioCaStorageNamespace,
+			ca.KeyAlgorithm(opts.keyAlgorithm), core)
 		if err != nil {
 			fatalf("Failed to create a self-signed Istio CA (error: %v)", err)
 		}
@@ -239,6 +279,11 @@ func createCA(core corev1.SecretsGetter) ca.CertificateAuthority {
 	}
 
 	caOpts.LivenessProbeOptions = opts.LivenessProbeOptions
+	if opts.issuanceLogFile != "" {
+		if caOpts.IssuanceLog, err = ca.NewFileIssuanceLog(opts.issuanceLogFile); err != nil {
+			fatalf("Failed to load the issuance log (error: %v)", err)
+		}
+	}
 
 	istioCA, err := ca.NewIstioCA(caOpts)
 	if err != nil {
@@ -247,6 +292,33 @@ func createCA(core corev1.SecretsGetter) ca.CertificateAuthority {
 	return istioCA
 }
 
+func createVaultCA() ca.CertificateAuthority {
+	log.Infof("Use the Vault PKI at %s to sign certificates", opts.vaultAddress)
+
+	vaultCA, err := ca.NewVaultCA(ca.VaultCAOptions{
+		Address:    opts.vaultAddress,
+		Token:      strings.TrimSpace(string(readFile(opts.vaultTokenFile))),
+		Mount:      opts.vaultMount,
+		Role:       opts.vaultRole,
+		MaxCertTTL: opts.maxWorkloadCertTTL,
+	})
+	if err != nil {
+		fatalf("Failed to create a Vault CA (error: %v)", err)
+	}
+	return vaultCA
+}
+
+func runRevocationServer(authority ca.CertificateAuthority) {
+	ra, ok := authority.(ca.RevocationAuthority)
+	if !ok || opts.issuanceLogFile == "" {
+		fatalf("Revocation requires the issuance log of a self-signed or file-based CA")
+	}
+	revocationServer := revocation.New(ra, opts.revocationPort, opts.revocationAdminAddress)
+	if err := revocationServer.Run(); err != nil {
+		log.Warnf("Failed to start revocation server with error: %v", err)
+	}
+}
+
 func generateConfig() *rest.Config {
 	if opts.kubeConfigFile != "" {
 		c, err := clientcmd.BuildConfigFromFlags("", opts.kubeConfigFile)
@@ -273,6 +345,17 @@ func readFile(filename string) []byte {
 }
 
 func verifyCommandLineOptions() {
+	if !isKeyAlgorithmSupported(ca.KeyAlgorithm(opts.keyAlgorithm)) {
+		fatalf("Unsupported key algorithm %q, expecting one of %v", opts.keyAlgorithm, ca.KeyAlgorithms)
+	}
+
+	if opts.vaultAddress != "" {
+		if opts.vaultTokenFile == "" || opts.vaultRole == "" {
+			fatalf("Both '-vault-token-file' and '-vault-role' are required with '-vault-address'")
+		}
+		return
+	}
+
 	if opts.selfSignedCA {
 		return
 	}
@@ -295,3 +378,12 @@ func verifyCommandLineOptions() {
 				"or use '-self-signed-ca'")
 	}
 }
+
+func isKeyAlgorithmSupported(alg ca.KeyAlgorithm) bool {
+	for _, supported := range ca.KeyAlgorithms {
+		if alg == supported {
+			return true
+		}
+	}
+	return false
+}
@@ -46,7 +46,9 @@ func init() {
 	flags.StringVar(&naConfig.ServiceIdentityOrg, "org", "", "Organization for the cert")
 	flags.DurationVar(&naConfig.WorkloadCertTTL, "workload-cert-ttl", time.Hour,
 		"The requested TTL for the workload")
-	flags.IntVar(&naConfig.RSAKeySize, "key-size", 2048, "Size of generated private key")
+	flags.StringVar(&naConfig.KeyAlgorithm, "key-algorithm", "RSA",
+		"Algorithm of generated private key: RSA | ECDSA-P256 | ECDSA-P384")
+	flags.IntVar(&naConfig.RSAKeySize, "key-size", 2048, "Size of generated RSA private key")
 	flags.StringVar(&naConfig.IstioCAAddress,
 		"ca-address", "istio-ca:8060", "Istio CA address")
 	flags.StringVar(&naConfig.Env, "env", "onprem", "Node Environment : onprem | gcp | aws")
@@ -66,6 +68,12 @@ func init() {
 	flags.StringVar(&naConfig.PlatformConfig.AwsConfig.RootCACertFile, "aws-root-cert",
 		defaultRoot, "Root Certificate file in AWS environment")
 
+	flags.StringVar(&naConfig.WorkloadAPISocket, "workload-api-socket", "",
+		"Unix domain socket serving the workload secrets; secrets are written to files if unset")
+	flags.IntSliceVar(&naConfig.WorkloadAPIAllowedUIDs, "workload-api-allowed-uids", nil,
+		"Users allowed to fetch secrets from the workload API; required with --workload-api-socket. "+
+			"The socket is only accessible to the user and group of the node agent")
+
 	naConfig.LoggingOptions.AttachCobraFlags(rootCmd)
 	cmd.InitializeFlags(rootCmd)
 }
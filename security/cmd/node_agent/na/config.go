@@ -41,6 +41,9 @@ type Config struct {
 	// Requested TTL of the workload certificates
 	WorkloadCertTTL time.Duration
 
+	// The algorithm of the generated private keys: RSA, ECDSA-P256 or ECDSA-P384.
+	KeyAlgorithm string
+
 	RSAKeySize int
 
 	// The environment this node agent is running on
@@ -56,6 +59,14 @@ type Config struct {
 	// percentage of the entire certificate TTL.
 	CSRGracePeriodPercentage int
 
+	// WorkloadAPISocket is the Unix domain socket on which the workload secrets are served.
+	// When empty, the secrets are written to files instead.
+	WorkloadAPISocket string
+
+	// WorkloadAPIAllowedUIDs are the users allowed to fetch secrets from the workload API.
+	// It is required when WorkloadAPISocket is set, see the --workload-api-allowed-uids flag.
+	WorkloadAPIAllowedUIDs []int
+
 	// The Configuration for talking to the platform metadata server.
 	PlatformConfig platform.ClientConfig
 
@@ -49,9 +49,7 @@ func NewNodeAgent(cfg *Config) (NodeAgent, error) {
 	cAClient := &grpc.CAGrpcClientImpl{}
 	na.cAClient = cAClient
 
-	// TODO: Specify files for service identity cert/key instead of node agent files.
-	secretServer, err := workload.NewSecretServer(
-		workload.NewSecretFileServerConfig(cfg.PlatformConfig.OnPremConfig.CertChainFile, cfg.PlatformConfig.OnPremConfig.KeyFile))
+	secretServer, err := workload.NewSecretServer(secretServerConfig(cfg))
 	if err != nil {
 		log.Errorf("Workload IO creation error: %v", err)
 		os.Exit(-1)
@@ -59,3 +57,26 @@ func NewNodeAgent(cfg *Config) (NodeAgent, error) {
 	na.secretServer = secretServer
 	return na, nil
 }
+
+// secretServerConfig returns the configuration of the server that delivers the secrets to the workloads.
+func secretServerConfig(cfg *Config) workload.Config {
+	if cfg.WorkloadAPISocket == "" {
+		// TODO: Specify files for service identity cert/key instead of node agent files.
+		return workload.NewSecretFileServerConfig(cfg.PlatformConfig.OnPremConfig.CertChainFile, cfg.PlatformConfig.OnPremConfig.KeyFile)
+	}
+
+	var rootCertFile string
+	switch cfg.Env {
+	case "gcp":
+		rootCertFile = cfg.PlatformConfig.GcpConfig.RootCACertFile
+	case "aws":
+		rootCertFile = cfg.PlatformConfig.AwsConfig.RootCACertFile
+	default:
+		rootCertFile = cfg.PlatformConfig.OnPremConfig.RootCACertFile
+	}
+	uids := make([]uint32, 0, len(cfg.WorkloadAPIAllowedUIDs))
+	for _, uid := range cfg.WorkloadAPIAllowedUIDs {
+		uids = append(uids, uint32(uid))
+	}
+	return workload.NewSecretWorkloadAPIServerConfig(cfg.WorkloadAPISocket, rootCertFile, uids)
+}
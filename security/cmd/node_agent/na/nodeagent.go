@@ -116,9 +116,10 @@ func (na *nodeAgentInternal) Start() error {
 
 func (na *nodeAgentInternal) createRequest() ([]byte, *pb.CsrRequest, error) {
 	csr, privKey, err := ca.GenCSR(ca.CertOptions{
-		Host:       na.identity,
-		Org:        na.config.ServiceIdentityOrg,
-		RSAKeySize: na.config.RSAKeySize,
+		Host:         na.identity,
+		Org:          na.config.ServiceIdentityOrg,
+		KeyAlgorithm: ca.KeyAlgorithm(na.config.KeyAlgorithm),
+		RSAKeySize:   na.config.RSAKeySize,
 	})
 	if err != nil {
 		return nil, nil, err
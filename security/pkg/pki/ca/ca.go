@@ -16,10 +16,14 @@ package ca
 
 import (
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -49,7 +53,8 @@ const (
 // CertificateAuthority contains methods to be supported by a CA.
 type CertificateAuthority interface {
 	// Sign generates a certificate for a workload or CA, from the given CSR and TTL.
-	Sign(csrPEM []byte, ttl time.Duration, forCA bool) ([]byte, error)
+	// The requester identifies the caller in the issuance log, if the CA keeps one.
+	Sign(csrPEM []byte, ttl time.Duration, forCA bool, requester string) ([]byte, error)
 	// GetRootCertificate retrieves the root certificate from CA.
 	GetRootCertificate() []byte
 }
@@ -63,6 +68,12 @@ type IstioCAOptions struct {
 	SigningKeyBytes  []byte
 	RootCertBytes    []byte
 
+	// KeyAlgorithm is the expected algorithm of the signing key. It is not checked if unspecified.
+	KeyAlgorithm KeyAlgorithm
+
+	// IssuanceLog records the issued certificates. Certificates can only be revoked if it is set.
+	IssuanceLog IssuanceLog
+
 	LivenessProbeOptions *probe.Options
 }
 
@@ -76,18 +87,25 @@ type IstioCA struct {
 	certChainBytes []byte
 	rootCertBytes  []byte
 	livenessProbe  *probe.Probe
+
+	issuanceLog IssuanceLog
+	// crl caches the last generated CRL until crlRefreshTime or the next revocation.
+	crlMutex       sync.Mutex
+	crl            []byte
+	crlRefreshTime time.Time
 }
 
 // NewSelfSignedIstioCAOptions returns a new IstioCAOptions instance using self-signed certificate.
 func NewSelfSignedIstioCAOptions(caCertTTL, certTTL, maxCertTTL time.Duration, org string, namespace string,
-	core corev1.SecretsGetter) (*IstioCAOptions, error) {
+	keyAlgorithm KeyAlgorithm, core corev1.SecretsGetter) (*IstioCAOptions, error) {
 
 	// For the first time the CA is up, it generates a self-signed key/cert pair and write it to
 	// cASecret. For subsequent restart, CA will reads key/cert from cASecret.
 	caSecret, err := core.Secrets(namespace).Get(cASecret, metav1.GetOptions{})
 	opts := &IstioCAOptions{
-		CertTTL:    certTTL,
-		MaxCertTTL: maxCertTTL,
+		CertTTL:      certTTL,
+		MaxCertTTL:   maxCertTTL,
+		KeyAlgorithm: keyAlgorithm,
 	}
 	if err != nil {
 		log.Infof("Failed to get secret (error: %s), will create one", err)
@@ -97,6 +115,7 @@ func NewSelfSignedIstioCAOptions(caCertTTL, certTTL, maxCertTTL time.Duration, o
 			Org:          org,
 			IsCA:         true,
 			IsSelfSigned: true,
+			KeyAlgorithm: keyAlgorithm,
 			RSAKeySize:   caKeySize,
 		}
 		pemCert, pemKey, err := GenCertKeyFromOptions(options)
@@ -142,6 +161,7 @@ func NewIstioCA(opts *IstioCAOptions) (*IstioCA, error) {
 		maxCertTTL: opts.MaxCertTTL,
 
 		livenessProbe: probe.NewProbe(),
+		issuanceLog:   opts.IssuanceLog,
 	}
 
 	ca.certChainBytes = copyBytes(opts.CertChainBytes)
@@ -162,6 +182,11 @@ func NewIstioCA(opts *IstioCAOptions) (*IstioCA, error) {
 		return nil, err
 	}
 
+	if alg := keyAlgorithmOf(ca.signingKey); opts.KeyAlgorithm != "" && alg != opts.KeyAlgorithm {
+		// The key may have been persisted before the algorithm was changed; keep signing with it.
+		log.Warnf("The CA signing key is %s instead of the configured %s", alg, opts.KeyAlgorithm)
+	}
+
 	if opts.LivenessProbeOptions.IsValid() {
 		livenessProbeController := probe.NewFileController(opts.LivenessProbeOptions)
 		ca.livenessProbe.RegisterProbe(livenessProbeController, "liveness")
@@ -179,7 +204,7 @@ func (ca *IstioCA) GetRootCertificate() []byte {
 
 // Sign takes a PEM-encoded certificate signing request and returns a signed
 // certificate.
-func (ca *IstioCA) Sign(csrPEM []byte, ttl time.Duration, forCA bool) ([]byte, error) {
+func (ca *IstioCA) Sign(csrPEM []byte, ttl time.Duration, forCA bool, requester string) ([]byte, error) {
 	csr, err := pki.ParsePemEncodedCSR(csrPEM)
 	if err != nil {
 		return nil, err
@@ -202,6 +227,11 @@ func (ca *IstioCA) Sign(csrPEM []byte, ttl time.Duration, forCA bool) ([]byte, e
 	}
 	cert := pem.EncodeToMemory(block)
 
+	if err = ca.recordIssuance(cert, requester); err != nil {
+		// A certificate missing from the issuance log could not be revoked.
+		return nil, fmt.Errorf("failed to record the issued certificate (%v)", err)
+	}
+
 	// Also append intermediate certs into the chain.
 	chain := append(cert, ca.certChainBytes...)
 
@@ -230,6 +260,22 @@ func (ca *IstioCA) verify() error {
 	return nil
 }
 
+// keyAlgorithmOf returns the algorithm of the given key, or an empty algorithm if it is not supported.
+func keyAlgorithmOf(key crypto.PrivateKey) KeyAlgorithm {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return RSA
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return ECDSAP256
+		case elliptic.P384():
+			return ECDSAP384
+		}
+	}
+	return ""
+}
+
 func copyBytes(src []byte) []byte {
 	bs := make([]byte, len(src))
 	copy(bs, src)
@@ -139,7 +139,7 @@ func TestSelfSignedIstioCAWithoutSecret(t *testing.T) {
 	caNamespace := "default"
 	client := fake.NewSimpleClientset()
 
-	caopts, err := NewSelfSignedIstioCAOptions(caCertTTL, defaultCertTTL, maxCertTTL, org, caNamespace, client.CoreV1())
+	caopts, err := NewSelfSignedIstioCAOptions(caCertTTL, defaultCertTTL, maxCertTTL, org, caNamespace, RSA, client.CoreV1())
 	if err != nil {
 		t.Fatalf("Failed to create a self-signed CA Options: %v", err)
 	}
@@ -209,7 +209,7 @@ func TestSelfSignedIstioCAWithSecret(t *testing.T) {
 	org := "test.ca.org"
 	caNamespace := "default"
 
-	caopts, err := NewSelfSignedIstioCAOptions(caCertTTL, certTTL, maxCertTTL, org, caNamespace, client.CoreV1())
+	caopts, err := NewSelfSignedIstioCAOptions(caCertTTL, certTTL, maxCertTTL, org, caNamespace, RSA, client.CoreV1())
 	if err != nil {
 		t.Fatalf("Failed to create a self-signed CA Options: %v", err)
 	}
@@ -281,7 +281,7 @@ func TestSignCSRForWorkload(t *testing.T) {
 	}
 
 	requestedTTL := 30 * time.Minute
-	certPEM, err := ca.Sign(csrPEM, requestedTTL, false)
+	certPEM, err := ca.Sign(csrPEM, requestedTTL, false, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -316,6 +316,47 @@ func TestSignCSRForWorkload(t *testing.T) {
 	}
 }
 
+func TestSelfSignedECDSAIstioCA(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	caopts, err := NewSelfSignedIstioCAOptions(time.Hour, 30*time.Minute, time.Hour, "test.ca.org", "default",
+		ECDSAP384, client.CoreV1())
+	if err != nil {
+		t.Fatalf("Failed to create a self-signed CA Options: %v", err)
+	}
+	ca, err := NewIstioCA(caopts)
+	if err != nil {
+		t.Fatalf("Failed to create a self-signed CA: %v", err)
+	}
+	if alg := keyAlgorithmOf(ca.signingKey); alg != ECDSAP384 {
+		t.Errorf("Unexpected CA key algorithm (expecting %v, actual %v)", ECDSAP384, alg)
+	}
+
+	// The CA signs CSRs regardless of the algorithm of their keys.
+	for _, alg := range KeyAlgorithms {
+		host := "spiffe://example.com/ns/foo/sa/bar"
+		csrPEM, keyPEM, err := GenCSR(CertOptions{Host: host, KeyAlgorithm: alg, RSAKeySize: 2048})
+		if err != nil {
+			t.Fatalf("%v: %v", alg, err)
+		}
+		certPEM, err := ca.Sign(csrPEM, 30*time.Minute, false, "")
+		if err != nil {
+			t.Fatalf("%v: %v", alg, err)
+		}
+		// Only RSA keys can encipher keys.
+		keyUsage := x509.KeyUsageDigitalSignature
+		if alg == RSA {
+			keyUsage |= x509.KeyUsageKeyEncipherment
+		}
+		fields := &testutil.VerifyFields{
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+			KeyUsage:    keyUsage,
+		}
+		if err = testutil.VerifyCertificate(keyPEM, certPEM, ca.GetRootCertificate(), host, fields); err != nil {
+			t.Errorf("%v: %v", alg, err)
+		}
+	}
+}
+
 func TestSignCSRForCA(t *testing.T) {
 	host := "spiffe://example.com/ns/foo/sa/baz"
 	opts := CertOptions{
@@ -335,7 +376,7 @@ func TestSignCSRForCA(t *testing.T) {
 	}
 
 	requestedTTL := 30 * 24 * time.Hour
-	certPEM, err := ca.Sign(csrPEM, requestedTTL, true)
+	certPEM, err := ca.Sign(csrPEM, requestedTTL, true, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -388,7 +429,7 @@ func TestSignCSRTTLError(t *testing.T) {
 
 	ttl := 3 * time.Hour
 
-	cert, err := ca.Sign(csrPEM, ttl, false)
+	cert, err := ca.Sign(csrPEM, ttl, false, "")
 	if cert != nil {
 		t.Errorf("Expected null cert be obtained a non-null cert.")
 	}
@@ -16,6 +16,8 @@ package controller
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"reflect"
 	"time"
@@ -45,6 +47,8 @@ const (
 	PrivateKeyID = "key.pem"
 	// The ID/name for the CA root certificate file.
 	RootCertID = "root-cert.pem"
+	// The ID/name for the certificate revocation list file, present if the CA can revoke certificates.
+	CRLID = "crl.pem"
 
 	secretNamePrefix   = "istio."
 	secretResyncPeriod = time.Minute
@@ -53,6 +57,9 @@ const (
 
 	// The size of a private key for a leaf certificate.
 	keySize = 2048
+
+	// The requester of the certificates in the CA issuance log.
+	requester = "secret-controller"
 )
 
 // SecretController manages the service accounts' secrets that contains Istio keys and certificates.
@@ -61,6 +68,9 @@ type SecretController struct {
 	certTTL time.Duration
 	core    corev1.CoreV1Interface
 
+	// The algorithm of the generated private keys.
+	keyAlgorithm ca.KeyAlgorithm
+
 	// Controller and store for service account objects.
 	saController cache.Controller
 	saStore      cache.Store
@@ -71,13 +81,14 @@ type SecretController struct {
 }
 
 // NewSecretController returns a pointer to a newly constructed SecretController instance.
-func NewSecretController(ca ca.CertificateAuthority, certTTL time.Duration, core corev1.CoreV1Interface,
-	namespace string) *SecretController {
+func NewSecretController(ca ca.CertificateAuthority, certTTL time.Duration, keyAlgorithm ca.KeyAlgorithm,
+	core corev1.CoreV1Interface, namespace string) *SecretController {
 
 	c := &SecretController{
-		ca:      ca,
-		certTTL: certTTL,
-		core:    core,
+		ca:           ca,
+		certTTL:      certTTL,
+		core:         core,
+		keyAlgorithm: keyAlgorithm,
 	}
 
 	saLW := &cache.ListWatch{
@@ -191,6 +202,9 @@ func (sc *SecretController) upsertSecret(saName, saNamespace string) {
 		PrivateKeyID: key,
 		RootCertID:   rootCert,
 	}
+	if crl := sc.getCRL(); crl != nil {
+		secret.Data[CRLID] = crl
+	}
 	_, err = sc.core.Secrets(saNamespace).Create(secret)
 	if err != nil {
 		log.Errorf("Failed to create secret (error: %s)", err)
@@ -228,8 +242,9 @@ func (sc *SecretController) scrtDeleted(obj interface{}) {
 func (sc *SecretController) generateKeyAndCert(saName string, saNamespace string) ([]byte, []byte, error) {
 	id := fmt.Sprintf("%s://cluster.local/ns/%s/sa/%s", ca.URIScheme, saNamespace, saName)
 	options := ca.CertOptions{
-		Host:       id,
-		RSAKeySize: keySize,
+		Host:         id,
+		KeyAlgorithm: sc.keyAlgorithm,
+		RSAKeySize:   keySize,
 	}
 
 	csrPEM, keyPEM, err := ca.GenCSR(options)
@@ -237,7 +252,7 @@ func (sc *SecretController) generateKeyAndCert(saName string, saNamespace string
 		return nil, nil, err
 	}
 
-	certPEM, err := sc.ca.Sign(csrPEM, sc.certTTL, false)
+	certPEM, err := sc.ca.Sign(csrPEM, sc.certTTL, false, requester)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -263,16 +278,17 @@ func (sc *SecretController) scrtUpdated(oldObj, newObj interface{}) {
 
 	ttl := time.Until(cert.NotAfter)
 	rootCertificate := sc.ca.GetRootCertificate()
+	crl := sc.getCRL()
+	namespace := scrt.GetNamespace()
+	name := scrt.GetName()
 
 	// Refresh the secret if 1) the certificate contained in the secret is about
 	// to expire, or 2) the root certificate in the secret is different than the
 	// one held by the ca (this may happen when the CA is restarted and
-	// a new self-signed CA cert is generated).
-	if ttl.Seconds() < secretResyncPeriod.Seconds() || !bytes.Equal(rootCertificate, scrt.Data[RootCertID]) {
-		namespace := scrt.GetNamespace()
-		name := scrt.GetName()
-
-		log.Infof("Refreshing secret %s/%s, either the leaf certificate is about to expire "+
+	// a new self-signed CA cert is generated), or 3) the certificate has been revoked.
+	if ttl.Seconds() < secretResyncPeriod.Seconds() || !bytes.Equal(rootCertificate, scrt.Data[RootCertID]) ||
+		sc.isRevoked(cert) {
+		log.Infof("Refreshing secret %s/%s, either the leaf certificate is about to expire or revoked, "+
 			"or the root certificate is outdated", namespace, name)
 
 		saName := scrt.Annotations[serviceAccountNameAnnotationKey]
@@ -288,11 +304,42 @@ func (sc *SecretController) scrtUpdated(oldObj, newObj interface{}) {
 		scrt.Data[CertChainID] = chain
 		scrt.Data[PrivateKeyID] = key
 		scrt.Data[RootCertID] = rootCertificate
+	} else if crl == nil || bytes.Equal(crl, scrt.Data[CRLID]) {
+		return
+	}
 
-		if _, err = sc.core.Secrets(namespace).Update(scrt); err != nil {
-			log.Errorf("Failed to update secret %s/%s (error: %s)", namespace, name, err)
-		}
+	if crl != nil {
+		scrt.Data[CRLID] = crl
+	}
+	if _, err = sc.core.Secrets(namespace).Update(scrt); err != nil {
+		log.Errorf("Failed to update secret %s/%s (error: %s)", namespace, name, err)
+	}
+}
+
+// getCRL returns the PEM encoded revocation list of the CA, or nil if the CA cannot revoke certificates.
+func (sc *SecretController) getCRL() []byte {
+	ra, ok := sc.ca.(ca.RevocationAuthority)
+	if !ok {
+		return nil
+	}
+	crl, err := ra.GetCRL()
+	if err != nil {
+		log.Errorf("Failed to get the certificate revocation list (error: %v)", err)
+		return nil
+	}
+	if crl == nil {
+		return nil
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl})
+}
+
+// isRevoked returns whether the certificate has been revoked by the CA.
+func (sc *SecretController) isRevoked(cert *x509.Certificate) bool {
+	ra, ok := sc.ca.(ca.RevocationAuthority)
+	if !ok {
+		return false
 	}
+	return ra.IsRevoked(cert.SerialNumber)
 }
 
 func getSecretName(saName string) string {
@@ -20,6 +20,8 @@ package ca
 
 import (
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -69,23 +71,41 @@ type CertOptions struct {
 	// Whether this certificate is for a server.
 	IsServer bool
 
+	// The algorithm of the private key to be generated. RSA is used if unspecified.
+	KeyAlgorithm KeyAlgorithm
+
 	// The size of RSA private key to be generated.
 	RSAKeySize int
 }
 
+// KeyAlgorithm is the algorithm of a generated private key.
+type KeyAlgorithm string
+
+const (
+	// RSA keys are generated with the size given by CertOptions.RSAKeySize.
+	RSA KeyAlgorithm = "RSA"
+	// ECDSAP256 keys use the NIST P-256 curve.
+	ECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// ECDSAP384 keys use the NIST P-384 curve.
+	ECDSAP384 KeyAlgorithm = "ECDSA-P384"
+)
+
+// KeyAlgorithms lists the supported key algorithms.
+var KeyAlgorithms = []KeyAlgorithm{RSA, ECDSAP256, ECDSAP384}
+
 // URIScheme is the URI scheme for Istio identities.
 const URIScheme = "spiffe"
 
 // GenCertKeyFromOptions generates a X.509 certificate and a private key with the given options.
 func GenCertKeyFromOptions(options CertOptions) (pemCert []byte, pemKey []byte, err error) {
-	// Generate a RSA private&public key pair.
+	// Generate a private&public key pair.
 	// The public key will be bound to the certificate generated below. The
 	// private key will be used to sign this certificate in the self-signed
 	// case, otherwise the certificate is signed by the signer private key
 	// as specified in the CertOptions.
-	priv, err := rsa.GenerateKey(rand.Reader, options.RSAKeySize)
+	priv, err := genKey(options)
 	if err != nil {
-		return nil, nil, fmt.Errorf("cert generation fails at RSA key generation (%v)", err)
+		return nil, nil, fmt.Errorf("cert generation fails at key generation (%v)", err)
 	}
 	template, err := genCertTemplateFromOptions(options)
 	if err != nil {
@@ -95,14 +115,12 @@ func GenCertKeyFromOptions(options CertOptions) (pemCert []byte, pemKey []byte,
 	if !options.IsSelfSigned {
 		signerCert, signerKey = options.SignerCert, options.SignerPriv
 	}
-	certBytes, err := x509.CreateCertificate(rand.Reader, template, signerCert, &priv.PublicKey, signerKey)
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, signerCert, priv.Public(), signerKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cert generation fails at X509 cert creation (%v)", err)
 	}
 
-	pemCert, pemKey = encodePem(false, certBytes, priv)
-	err = nil
-	return
+	return encodePem(false, certBytes, priv)
 }
 
 // GenCertFromCSR generates a X.509 certificate with the given CSR.
@@ -151,8 +169,11 @@ func genCertTemplateFromCSR(csr *x509.CertificateRequest, ttl time.Duration, isC
 		// If the cert is a CA cert, the private key is allowed to sign other certificates.
 		keyUsage = x509.KeyUsageCertSign
 	} else {
-		// Otherwise the private key is allowed for digital signature and key encipherment.
-		keyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+		// Otherwise the private key is allowed for digital signature, and RSA keys for key encipherment.
+		keyUsage = x509.KeyUsageDigitalSignature
+		if _, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+			keyUsage |= x509.KeyUsageKeyEncipherment
+		}
 		// For now, we do not differentiate non-CA certs to be used on client auth or server auth.
 		extKeyUsages = append(extKeyUsages, x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth)
 	}
@@ -166,6 +187,7 @@ func genCertTemplateFromCSR(csr *x509.CertificateRequest, ttl time.Duration, isC
 		return nil, err
 	}
 
+	// The signature algorithm is left to the signing key, whose type may differ from the CSR key.
 	return &x509.Certificate{
 		SerialNumber: serialNum,
 		Subject:      csr.Subject,
@@ -178,8 +200,7 @@ func genCertTemplateFromCSR(csr *x509.CertificateRequest, ttl time.Duration, isC
 		ExtraExtensions:       exts,
 		DNSNames:              csr.DNSNames,
 		EmailAddresses:        csr.EmailAddresses,
-		IPAddresses:           csr.IPAddresses,
-		SignatureAlgorithm:    csr.SignatureAlgorithm}, nil
+		IPAddresses:           csr.IPAddresses}, nil
 }
 
 // genCertTemplateFromoptions generates a certificate template with the given options.
@@ -189,8 +210,11 @@ func genCertTemplateFromOptions(options CertOptions) (*x509.Certificate, error)
 		// If the cert is a CA cert, the private key is allowed to sign other certificates.
 		keyUsage = x509.KeyUsageCertSign
 	} else {
-		// Otherwise the private key is allowed for digital signature and key encipherment.
-		keyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+		// Otherwise the private key is allowed for digital signature, and RSA keys for key encipherment.
+		keyUsage = x509.KeyUsageDigitalSignature
+		if options.KeyAlgorithm == "" || options.KeyAlgorithm == RSA {
+			keyUsage |= x509.KeyUsageKeyEncipherment
+		}
 	}
 
 	extKeyUsages := []x509.ExtKeyUsage{}
@@ -243,16 +267,41 @@ func genSerialNum() (*big.Int, error) {
 	return serialNum, nil
 }
 
-func encodePem(isCSR bool, csrOrCert []byte, priv *rsa.PrivateKey) ([]byte, []byte) {
+// genKey generates a private key of the algorithm specified in the options.
+func genKey(options CertOptions) (crypto.Signer, error) {
+	switch options.KeyAlgorithm {
+	case "", RSA:
+		return rsa.GenerateKey(rand.Reader, options.RSAKeySize)
+	case ECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case ECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", options.KeyAlgorithm)
+	}
+}
+
+func encodePem(isCSR bool, csrOrCert []byte, priv crypto.Signer) ([]byte, []byte, error) {
 	encodeMsg := "CERTIFICATE"
 	if isCSR {
 		encodeMsg = "CERTIFICATE REQUEST"
 	}
 	csrOrCertPem := pem.EncodeToMemory(&pem.Block{Type: encodeMsg, Bytes: csrOrCert})
 
-	privDer := x509.MarshalPKCS1PrivateKey(priv)
-	privPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: privDer})
-	return csrOrCertPem, privPem
+	var privPem []byte
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		privPem = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)})
+	case *ecdsa.PrivateKey:
+		privDer, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, nil, fmt.Errorf("private key encoding failure (%v)", err)
+		}
+		privPem = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privDer})
+	default:
+		return nil, nil, fmt.Errorf("unsupported private key type %T", priv)
+	}
+	return csrOrCertPem, privPem, nil
 }
 
 func buildSubjectAltNameExtension(hosts string) (*pkix.Extension, error) {
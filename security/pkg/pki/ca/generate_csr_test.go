@@ -19,6 +19,8 @@ import (
 	"encoding/pem"
 	"strings"
 	"testing"
+
+	"istio.io/istio/security/pkg/pki"
 )
 
 func TestGenCSR(t *testing.T) {
@@ -68,3 +70,41 @@ func TestGenCSRWithInvalidOption(t *testing.T) {
 		t.Errorf("Should have failed")
 	}
 }
+
+func TestGenCSRWithKeyAlgorithm(t *testing.T) {
+	cases := map[KeyAlgorithm]string{
+		RSA:       "RSA PRIVATE KEY",
+		ECDSAP256: "EC PRIVATE KEY",
+		ECDSAP384: "EC PRIVATE KEY",
+		"DSA":     "",
+	}
+	for alg, keyType := range cases {
+		csrPem, keyPem, err := GenCSR(CertOptions{Host: "test_ca.com", KeyAlgorithm: alg, RSAKeySize: 512})
+		if keyType == "" {
+			if err == nil {
+				t.Errorf("%v: should have failed", alg)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: failed to gen CSR (%v)", alg, err)
+			continue
+		}
+
+		block, _ := pem.Decode(keyPem)
+		if block == nil || block.Type != keyType {
+			t.Errorf("%v: expecting a %s", alg, keyType)
+		}
+		if _, err = pki.ParsePemEncodedKey(keyPem); err != nil {
+			t.Errorf("%v: %v", alg, err)
+		}
+
+		block, _ = pem.Decode(csrPem)
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			t.Errorf("%v: failed to parse csr", alg)
+		} else if err = csr.CheckSignature(); err != nil {
+			t.Errorf("%v: csr signature is invalid", alg)
+		}
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/security/pkg/pki"
+)
+
+const (
+	// crlValidity is the validity period of a generated CRL. The CRL is regenerated when half of it has passed.
+	crlValidity = 24 * time.Hour
+	// ocspValidity is the validity period of an OCSP response.
+	ocspValidity = time.Hour
+	// issuanceLogPruneInterval is the period at which the expired certificates are dropped from the issuance log.
+	issuanceLogPruneInterval = time.Hour
+	// issuanceLogSyncDelay is the delay after which the issuances written to a file are synced to disk.
+	// Revocations are synced immediately.
+	issuanceLogSyncDelay = time.Second
+)
+
+// IssuedCert is an entry of the issuance log.
+type IssuedCert struct {
+	// SerialNumber is the hex encoded serial number of the certificate.
+	SerialNumber string    `json:"serial_number"`
+	SANs         []string  `json:"sans,omitempty"`
+	NotBefore    time.Time `json:"not_before"`
+	NotAfter     time.Time `json:"not_after"`
+	Requester    string    `json:"requester,omitempty"`
+
+	Revoked          bool      `json:"revoked,omitempty"`
+	RevokedAt        time.Time `json:"revoked_at,omitempty"`
+	RevocationReason int       `json:"revocation_reason,omitempty"`
+}
+
+// IssuanceLog records the certificates issued by a CA and their revocations.
+type IssuanceLog interface {
+	// Add records an issued certificate.
+	Add(cert IssuedCert) error
+	// Revoke marks the certificate with the given serial number as revoked.
+	Revoke(serialNumber string, reason int, at time.Time) error
+	// Get returns the certificate with the given serial number.
+	Get(serialNumber string) (IssuedCert, bool)
+	// List returns the recorded certificates that have not expired yet, ordered by issuance time.
+	List() []IssuedCert
+}
+
+// RevocationAuthority is implemented by the CAs which can revoke the certificates they issue.
+type RevocationAuthority interface {
+	// Revoke revokes the certificate with the given serial number.
+	Revoke(serialNumber *big.Int, reason int) error
+	// IsRevoked returns whether the certificate with the given serial number has been revoked.
+	IsRevoked(serialNumber *big.Int) bool
+	// GetCRL returns the DER encoded certificate revocation list signed by the CA, or nil
+	// if the CA does not support revocation.
+	GetCRL() ([]byte, error)
+	// RespondOCSP answers the DER encoded OCSP request with a DER encoded OCSP response.
+	RespondOCSP(request []byte) ([]byte, error)
+	// IssuedCertificates returns the issuance log.
+	IssuedCertificates() []IssuedCert
+}
+
+// issuanceLogEntry is a line of the file of a fileIssuanceLog. It records either an
+// issuance or a revocation.
+type issuanceLogEntry struct {
+	Issued  *IssuedCert `json:"issued,omitempty"`
+	Revoked *IssuedCert `json:"revoked,omitempty"`
+}
+
+// memoryIssuanceLog keeps the issuance log in memory. The expired certificates are dropped
+// periodically, as they no longer need to be revoked.
+type memoryIssuanceLog struct {
+	mu    sync.RWMutex
+	certs map[string]*IssuedCert
+	order []string
+	// nextPrune is the time after which the expired certificates are dropped on the next addition.
+	nextPrune time.Time
+}
+
+// NewMemoryIssuanceLog returns an IssuanceLog that is lost when the CA restarts.
+func NewMemoryIssuanceLog() IssuanceLog {
+	return newMemoryIssuanceLog()
+}
+
+func newMemoryIssuanceLog() *memoryIssuanceLog {
+	return &memoryIssuanceLog{certs: map[string]*IssuedCert{}}
+}
+
+func (l *memoryIssuanceLog) Add(cert IssuedCert) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.add(cert)
+	l.prune(time.Now())
+	return nil
+}
+
+func (l *memoryIssuanceLog) add(cert IssuedCert) {
+	if _, exists := l.certs[cert.SerialNumber]; !exists {
+		l.order = append(l.order, cert.SerialNumber)
+	}
+	l.certs[cert.SerialNumber] = &cert
+}
+
+// prune drops the certificates which expired before now, if the prune interval has passed since
+// the last time. It returns whether any certificate was dropped.
+func (l *memoryIssuanceLog) prune(now time.Time) bool {
+	if now.Before(l.nextPrune) {
+		return false
+	}
+	l.nextPrune = now.Add(issuanceLogPruneInterval)
+
+	order := l.order[:0]
+	for _, serial := range l.order {
+		if l.certs[serial].NotAfter.Before(now) {
+			delete(l.certs, serial)
+			continue
+		}
+		order = append(order, serial)
+	}
+	pruned := len(order) != len(l.order)
+	l.order = order
+	return pruned
+}
+
+func (l *memoryIssuanceLog) Revoke(serialNumber string, reason int, at time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.revoke(serialNumber, reason, at)
+	return err
+}
+
+func (l *memoryIssuanceLog) revoke(serialNumber string, reason int, at time.Time) (*IssuedCert, error) {
+	cert, ok := l.certs[serialNumber]
+	if !ok {
+		return nil, fmt.Errorf("certificate %s was not issued by this CA", serialNumber)
+	}
+	if !cert.Revoked {
+		cert.Revoked, cert.RevokedAt, cert.RevocationReason = true, at, reason
+	}
+	return cert, nil
+}
+
+func (l *memoryIssuanceLog) Get(serialNumber string) (IssuedCert, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if cert, ok := l.certs[serialNumber]; ok {
+		return *cert, true
+	}
+	return IssuedCert{}, false
+}
+
+func (l *memoryIssuanceLog) List() []IssuedCert {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	certs := make([]IssuedCert, 0, len(l.order))
+	for _, serial := range l.order {
+		certs = append(certs, *l.certs[serial])
+	}
+	return certs
+}
+
+// fileIssuanceLog appends the issuances and revocations to a file, which is replayed when the CA restarts.
+// The file is compacted when it is loaded and whenever expired certificates are dropped, so that it only
+// holds a line per certificate that has not expired.
+type fileIssuanceLog struct {
+	*memoryIssuanceLog
+	path string
+	file *os.File
+	// syncPending is set when issuances have been written but not synced to disk yet.
+	syncPending bool
+}
+
+// NewFileIssuanceLog returns an IssuanceLog persisted in the given file.
+func NewFileIssuanceLog(path string) (IssuanceLog, error) {
+	l := &fileIssuanceLog{memoryIssuanceLog: newMemoryIssuanceLog(), path: path}
+
+	lines := 0
+	if f, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			lines++
+			var entry issuanceLogEntry
+			if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				_ = f.Close()
+				return nil, fmt.Errorf("corrupted issuance log %s (%v)", path, err)
+			}
+			if entry.Issued != nil {
+				l.add(*entry.Issued)
+			}
+			if r := entry.Revoked; r != nil {
+				if _, err = l.revoke(r.SerialNumber, r.RevocationReason, r.RevokedAt); err != nil {
+					_ = f.Close()
+					return nil, fmt.Errorf("corrupted issuance log %s (%v)", path, err)
+				}
+			}
+		}
+		err = scanner.Err()
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read issuance log %s (%v)", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to open issuance log %s (%v)", path, err)
+	}
+
+	if l.prune(time.Now()) || lines != len(l.order) {
+		if err := l.compact(); err != nil {
+			return nil, err
+		}
+		return l, nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open issuance log %s (%v)", path, err)
+	}
+	l.file = f
+	return l, nil
+}
+
+func (l *fileIssuanceLog) Add(cert IssuedCert) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.write(issuanceLogEntry{Issued: &cert}); err != nil {
+		return err
+	}
+	l.add(cert)
+	if l.prune(time.Now()) {
+		return l.compact()
+	}
+
+	// Issuances are synced in batches, as a lost issuance only prevents the revocation of a certificate.
+	if !l.syncPending {
+		l.syncPending = true
+		time.AfterFunc(issuanceLogSyncDelay, l.syncPendingWrites)
+	}
+	return nil
+}
+
+func (l *fileIssuanceLog) Revoke(serialNumber string, reason int, at time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cert, err := l.revoke(serialNumber, reason, at)
+	if err != nil {
+		return err
+	}
+	revoked := *cert
+	if err = l.write(issuanceLogEntry{Revoked: &revoked}); err != nil {
+		return err
+	}
+	l.syncPending = false
+	return l.file.Sync()
+}
+
+func (l *fileIssuanceLog) write(entry issuanceLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err = l.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write issuance log (%v)", err)
+	}
+	return nil
+}
+
+func (l *fileIssuanceLog) syncPendingWrites() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.syncPending {
+		return
+	}
+	l.syncPending = false
+	if err := l.file.Sync(); err != nil {
+		log.Errorf("Failed to sync issuance log %s: %v", l.path, err)
+	}
+}
+
+// compact replaces the file with a line per certificate of the log, and reopens it for appending.
+func (l *fileIssuanceLog) compact() error {
+	tmpPath := l.path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_APPEND|os.O_TRUNC|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to compact issuance log %s (%v)", l.path, err)
+	}
+	w := bufio.NewWriter(f)
+	for _, serial := range l.order {
+		line, err := json.Marshal(issuanceLogEntry{Issued: l.certs[serial]})
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		_, _ = w.Write(append(line, '\n'))
+	}
+	if err = w.Flush(); err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, l.path)
+	}
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to compact issuance log %s (%v)", l.path, err)
+	}
+
+	if l.file != nil {
+		_ = l.file.Close()
+	}
+	l.file, l.syncPending = f, false
+	return nil
+}
+
+// serialNumberKey is the representation of a serial number in the issuance log.
+func serialNumberKey(serialNumber *big.Int) string {
+	return serialNumber.Text(16)
+}
+
+// recordIssuance adds the PEM encoded certificate to the issuance log of the CA, if any.
+func (ca *IstioCA) recordIssuance(certPEM []byte, requester string) error {
+	if ca.issuanceLog == nil {
+		return nil
+	}
+	cert, err := pki.ParsePemEncodedCertificate(certPEM)
+	if err != nil {
+		return err
+	}
+	sans, err := pki.ExtractIDs(cert.Extensions)
+	if err != nil {
+		return err
+	}
+	return ca.issuanceLog.Add(IssuedCert{
+		SerialNumber: serialNumberKey(cert.SerialNumber),
+		SANs:         sans,
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		Requester:    requester,
+	})
+}
+
+// Revoke revokes the certificate with the given serial number. Only the certificates in
+// the issuance log can be revoked.
+func (ca *IstioCA) Revoke(serialNumber *big.Int, reason int) error {
+	if ca.issuanceLog == nil {
+		return fmt.Errorf("revocation requires an issuance log")
+	}
+	if err := ca.issuanceLog.Revoke(serialNumberKey(serialNumber), reason, time.Now()); err != nil {
+		return err
+	}
+
+	ca.crlMutex.Lock()
+	ca.crl = nil
+	ca.crlMutex.Unlock()
+	return nil
+}
+
+// IsRevoked returns whether the certificate with the given serial number has been revoked.
+func (ca *IstioCA) IsRevoked(serialNumber *big.Int) bool {
+	if ca.issuanceLog == nil {
+		return false
+	}
+	cert, ok := ca.issuanceLog.Get(serialNumberKey(serialNumber))
+	return ok && cert.Revoked
+}
+
+// IssuedCertificates returns the issuance log of the CA.
+func (ca *IstioCA) IssuedCertificates() []IssuedCert {
+	if ca.issuanceLog == nil {
+		return nil
+	}
+	return ca.issuanceLog.List()
+}
+
+// GetCRL returns the DER encoded certificate revocation list of the CA. The list omits the
+// revoked certificates that have expired. It is regenerated when half of its validity has passed.
+func (ca *IstioCA) GetCRL() ([]byte, error) {
+	if ca.issuanceLog == nil {
+		return nil, nil
+	}
+
+	ca.crlMutex.Lock()
+	defer ca.crlMutex.Unlock()
+
+	now := time.Now()
+	if ca.crl != nil && now.Before(ca.crlRefreshTime) {
+		return ca.crl, nil
+	}
+
+	revoked := []pkix.RevokedCertificate{}
+	for _, cert := range ca.IssuedCertificates() {
+		if !cert.Revoked || cert.NotAfter.Before(now) {
+			continue
+		}
+		serialNumber, ok := new(big.Int).SetString(cert.SerialNumber, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number %q in the issuance log", cert.SerialNumber)
+		}
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serialNumber,
+			RevocationTime: cert.RevokedAt,
+		})
+	}
+	sort.Slice(revoked, func(i, j int) bool { return revoked[i].SerialNumber.Cmp(revoked[j].SerialNumber) < 0 })
+
+	crl, err := ca.signingCert.CreateCRL(rand.Reader, ca.signingKey, revoked, now, now.Add(crlValidity))
+	if err != nil {
+		return nil, fmt.Errorf("CRL creation failure (%v)", err)
+	}
+	ca.crl, ca.crlRefreshTime = crl, now.Add(crlValidity/2)
+	return crl, nil
+}
+
+// RespondOCSP answers an OCSP request about a certificate issued by the CA.
+func (ca *IstioCA) RespondOCSP(request []byte) ([]byte, error) {
+	req, err := ocsp.ParseRequest(request)
+	if err != nil {
+		return ocsp.MalformedRequestErrorResponse, nil
+	}
+	if !ca.isIssuerOf(req) {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	signer, ok := ca.signingKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("the CA signing key cannot sign OCSP responses")
+	}
+
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(ocspValidity),
+	}
+	if ca.issuanceLog != nil {
+		if cert, ok := ca.issuanceLog.Get(serialNumberKey(req.SerialNumber)); ok {
+			template.Status = ocsp.Good
+			if cert.Revoked {
+				template.Status = ocsp.Revoked
+				template.RevokedAt = cert.RevokedAt
+				template.RevocationReason = cert.RevocationReason
+			}
+		}
+	}
+	return ocsp.CreateResponse(ca.signingCert, ca.signingCert, template, signer)
+}
+
+// isIssuerOf returns whether the OCSP request is about a certificate signed by the CA.
+func (ca *IstioCA) isIssuerOf(req *ocsp.Request) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+
+	// The issuer key hash is computed over the subject public key bit string.
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(ca.signingCert.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false
+	}
+
+	h := req.HashAlgorithm.New()
+	_, _ = h.Write(ca.signingCert.RawSubject)
+	nameHash := h.Sum(nil)
+	h.Reset()
+	_, _ = h.Write(spki.PublicKey.RightAlign())
+	keyHash := h.Sum(nil)
+
+	return bytes.Equal(nameHash, req.IssuerNameHash) && bytes.Equal(keyHash, req.IssuerKeyHash)
+}
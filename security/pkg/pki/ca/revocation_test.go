@@ -0,0 +1,253 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"istio.io/istio/security/pkg/pki"
+)
+
+func createRevocableCA(t *testing.T, issuanceLog IssuanceLog) *IstioCA {
+	ca, err := createCA(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	istioCA := ca.(*IstioCA)
+	istioCA.issuanceLog = issuanceLog
+	return istioCA
+}
+
+func signWorkloadCert(t *testing.T, ca CertificateAuthority, host string) *x509.Certificate {
+	csrPEM, _, err := GenCSR(CertOptions{Host: host, RSAKeySize: 2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := ca.Sign(csrPEM, 30*time.Minute, false, "node-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := pki.ParsePemEncodedCertificate(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestIssuanceLogRecordsAndRevokes(t *testing.T) {
+	ca := createRevocableCA(t, NewMemoryIssuanceLog())
+	host := "spiffe://cluster.local/ns/foo/sa/bar"
+	cert := signWorkloadCert(t, ca, host)
+
+	issued := ca.IssuedCertificates()
+	if len(issued) != 1 {
+		t.Fatalf("Unexpected issuance log %v", issued)
+	}
+	if issued[0].SerialNumber != cert.SerialNumber.Text(16) || !reflect.DeepEqual(issued[0].SANs, []string{host}) ||
+		issued[0].Requester != "node-agent" || !issued[0].NotAfter.Equal(cert.NotAfter) || issued[0].Revoked {
+		t.Errorf("Unexpected issuance log entry %+v", issued[0])
+	}
+
+	crl, err := ca.GetCRL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := x509.ParseCRL(crl)
+	if err != nil || len(list.TBSCertList.RevokedCertificates) != 0 {
+		t.Errorf("Expecting an empty CRL, got %v (error %v)", list, err)
+	}
+
+	if err = ca.Revoke(cert.SerialNumber, ocsp.KeyCompromise); err != nil {
+		t.Fatal(err)
+	}
+	if !ca.IsRevoked(cert.SerialNumber) {
+		t.Error("The certificate should be revoked")
+	}
+
+	// The cached CRL is regenerated after a revocation.
+	crl, err = ca.GetCRL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list, err = x509.ParseCRL(crl); err != nil {
+		t.Fatal(err)
+	}
+	if err = ca.signingCert.CheckCRLSignature(list); err != nil {
+		t.Errorf("Invalid CRL signature: %v", err)
+	}
+	revoked := list.TBSCertList.RevokedCertificates
+	if len(revoked) != 1 || revoked[0].SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		t.Errorf("Unexpected revoked certificates %v", revoked)
+	}
+
+	if err = ca.Revoke(new(big.Int).Lsh(cert.SerialNumber, 1), ocsp.KeyCompromise); err == nil {
+		t.Error("Revoking an unknown certificate should fail")
+	}
+}
+
+func TestOCSPResponse(t *testing.T) {
+	ca := createRevocableCA(t, NewMemoryIssuanceLog())
+	good := signWorkloadCert(t, ca, "spiffe://cluster.local/ns/foo/sa/good")
+	revoked := signWorkloadCert(t, ca, "spiffe://cluster.local/ns/foo/sa/revoked")
+	if err := ca.Revoke(revoked.SerialNumber, ocsp.Superseded); err != nil {
+		t.Fatal(err)
+	}
+	unknown := signWorkloadCert(t, createRevocableCA(t, nil), "spiffe://cluster.local/ns/foo/sa/unknown")
+
+	cases := []struct {
+		name   string
+		cert   *x509.Certificate
+		issuer *x509.Certificate
+		status int
+	}{
+		{"good", good, ca.signingCert, ocsp.Good},
+		{"revoked", revoked, ca.signingCert, ocsp.Revoked},
+		// A serial number missing from the issuance log.
+		{"unknown", unknown, ca.signingCert, ocsp.Unknown},
+	}
+	for _, c := range cases {
+		req, err := ocsp.CreateRequest(c.cert, c.issuer, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		resp, err := ca.RespondOCSP(req)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		parsed, err := ocsp.ParseResponse(resp, ca.signingCert)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if parsed.Status != c.status || parsed.SerialNumber.Cmp(c.cert.SerialNumber) != 0 {
+			t.Errorf("%s: unexpected OCSP response %+v", c.name, parsed)
+		}
+		if c.status == ocsp.Revoked && parsed.RevocationReason != ocsp.Superseded {
+			t.Errorf("%s: unexpected revocation reason %d", c.name, parsed.RevocationReason)
+		}
+	}
+
+	resp, err := ca.RespondOCSP([]byte("garbage"))
+	if err != nil || string(resp) != string(ocsp.MalformedRequestErrorResponse) {
+		t.Errorf("Expecting a malformed request response, got %v (error %v)", resp, err)
+	}
+}
+
+func TestFileIssuanceLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "issuancelog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "issued.log")
+
+	issuanceLog, err := NewFileIssuanceLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := createRevocableCA(t, issuanceLog)
+	first := signWorkloadCert(t, ca, "spiffe://cluster.local/ns/foo/sa/first")
+	signWorkloadCert(t, ca, "spiffe://cluster.local/ns/foo/sa/second")
+	if err = ca.Revoke(first.SerialNumber, ocsp.CessationOfOperation); err != nil {
+		t.Fatal(err)
+	}
+
+	// The log is replayed from the file.
+	reloaded, err := NewFileIssuanceLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, want := reloaded.List(), issuanceLog.List()
+	if len(got) != len(want) {
+		t.Fatalf("Unexpected reloaded issuance log %+v, expecting %+v", got, want)
+	}
+	for i := range want {
+		if got[i].SerialNumber != want[i].SerialNumber || !reflect.DeepEqual(got[i].SANs, want[i].SANs) ||
+			!got[i].NotAfter.Equal(want[i].NotAfter) || got[i].Revoked != want[i].Revoked ||
+			!got[i].RevokedAt.Equal(want[i].RevokedAt) || got[i].RevocationReason != want[i].RevocationReason {
+			t.Errorf("Unexpected reloaded entry %+v, expecting %+v", got[i], want[i])
+		}
+	}
+	if !got[0].Revoked || got[1].Revoked {
+		t.Errorf("Only the first certificate should be revoked: %+v", got)
+	}
+
+	if err = ioutil.WriteFile(path, []byte("not json\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewFileIssuanceLog(path); err == nil {
+		t.Error("Loading a corrupted issuance log should fail")
+	}
+}
+
+func TestFileIssuanceLogCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "issuancelog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "issued.log")
+
+	issuanceLog, err := NewFileIssuanceLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if err = issuanceLog.Add(IssuedCert{SerialNumber: "1", NotBefore: now.Add(-2 * time.Hour), NotAfter: now.Add(-time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if err = issuanceLog.Add(IssuedCert{SerialNumber: "2", NotBefore: now, NotAfter: now.Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if err = issuanceLog.Revoke("2", ocsp.KeyCompromise, now); err != nil {
+		t.Fatal(err)
+	}
+
+	// The expired certificate is dropped and the revocation merged into the issuance.
+	reloaded, err := NewFileIssuanceLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.List(); len(got) != 1 || got[0].SerialNumber != "2" || !got[0].Revoked {
+		t.Errorf("Unexpected compacted issuance log %+v", got)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := bytes.Count(content, []byte("\n")); lines != 1 {
+		t.Errorf("Unexpected compacted issuance log file with %d lines:\n%s", lines, content)
+	}
+
+	// New entries are appended to the compacted file.
+	if err = reloaded.Add(IssuedCert{SerialNumber: "3", NotBefore: now, NotAfter: now.Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err = NewFileIssuanceLog(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.List(); len(got) != 2 || got[1].SerialNumber != "3" {
+		t.Errorf("Unexpected issuance log %+v", got)
+	}
+}
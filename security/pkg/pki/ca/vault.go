@@ -0,0 +1,272 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"istio.io/istio/pkg/log"
+)
+
+const (
+	// vaultTokenHeader is the header carrying the Vault token of a request.
+	vaultTokenHeader = "X-Vault-Token"
+
+	// defaultVaultMount is the default mount path of the Vault PKI secrets engine.
+	defaultVaultMount = "pki"
+	// defaultVaultChainRefreshInterval is the default interval after which the cached chain is fetched again.
+	defaultVaultChainRefreshInterval = time.Hour
+	// defaultVaultRequestTimeout is the default timeout of the requests to Vault.
+	defaultVaultRequestTimeout = 10 * time.Second
+)
+
+// VaultCAOptions holds the configurations for creating a Vault CA.
+type VaultCAOptions struct {
+	// Address of the Vault server, e.g. https://vault:8200.
+	Address string
+	// Token authenticating the requests to Vault.
+	Token string
+	// Mount path of the PKI secrets engine. Defaults to "pki".
+	Mount string
+	// Role used to sign workload certificates.
+	Role string
+
+	// MaxCertTTL is the max allowed TTL of the signed certificates.
+	MaxCertTTL time.Duration
+	// ChainRefreshInterval is the interval after which the cached CA chain is fetched again.
+	ChainRefreshInterval time.Duration
+
+	// Client sends the requests to Vault. A client with a default timeout is used if nil.
+	Client *http.Client
+}
+
+// VaultCA is a CertificateAuthority that delegates signing to an external PKI implementing
+// the Vault PKI secrets engine API. The signing key never leaves the external PKI.
+type VaultCA struct {
+	opts   VaultCAOptions
+	client *http.Client
+
+	mu sync.Mutex
+	// certChainBytes holds the intermediate certificates appended to the signed certificates.
+	certChainBytes []byte
+	rootCertBytes  []byte
+	fetched        time.Time
+}
+
+// vaultResponse is the envelope of the responses of the Vault API.
+type vaultResponse struct {
+	Data   vaultSignData `json:"data"`
+	Errors []string      `json:"errors"`
+}
+
+type vaultSignData struct {
+	Certificate string   `json:"certificate"`
+	IssuingCA   string   `json:"issuing_ca"`
+	CAChain     []string `json:"ca_chain"`
+}
+
+// NewVaultCA returns a new VaultCA instance. The CA chain is fetched to verify that Vault is reachable.
+func NewVaultCA(opts VaultCAOptions) (*VaultCA, error) {
+	if opts.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	if opts.Role == "" {
+		return nil, fmt.Errorf("vault role is required")
+	}
+	if opts.Mount == "" {
+		opts.Mount = defaultVaultMount
+	}
+	if opts.ChainRefreshInterval <= 0 {
+		opts.ChainRefreshInterval = defaultVaultChainRefreshInterval
+	}
+	opts.Address = strings.TrimSuffix(opts.Address, "/")
+	opts.Mount = strings.Trim(opts.Mount, "/")
+
+	ca := &VaultCA{
+		opts:   opts,
+		client: opts.Client,
+	}
+	if ca.client == nil {
+		ca.client = &http.Client{Timeout: defaultVaultRequestTimeout}
+	}
+
+	if _, _, err := ca.chain(); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// GetRootCertificate returns the PEM-encoded root certificate of the Vault PKI.
+func (ca *VaultCA) GetRootCertificate() []byte {
+	_, root, err := ca.chain()
+	if err != nil {
+		log.Errorf("Failed to refresh the root certificate from Vault: %v", err)
+	}
+	return copyBytes(root)
+}
+
+// Sign sends the PEM-encoded certificate signing request to Vault and returns the signed
+// certificate followed by the intermediate certificates. Vault keeps its own audit log,
+// so the requester is only logged.
+func (ca *VaultCA) Sign(csrPEM []byte, ttl time.Duration, forCA bool, requester string) ([]byte, error) {
+	if ca.opts.MaxCertTTL > 0 && ttl > ca.opts.MaxCertTTL {
+		return nil, fmt.Errorf(
+			"requested TTL %s is greater than the max allowed TTL %s", ttl, ca.opts.MaxCertTTL)
+	}
+
+	// Workload certificates are signed by the role; CA certificates are signed as intermediates,
+	// which Vault only allows for a root PKI mount.
+	path := "sign/" + ca.opts.Role
+	if forCA {
+		path = "root/sign-intermediate"
+	}
+	body, err := json.Marshal(map[string]string{
+		"csr":    string(csrPEM),
+		"ttl":    ttl.String(),
+		"format": "pem",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ca.do(http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+	var resp vaultResponse
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode the Vault response (%v)", err)
+	}
+	if resp.Data.Certificate == "" {
+		return nil, fmt.Errorf("vault returned no certificate")
+	}
+
+	chain, _, err := ca.chain()
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Vault signed a certificate requested by %q", requester)
+	cert := []byte(strings.TrimSpace(resp.Data.Certificate) + "\n")
+	return append(cert, chain...), nil
+}
+
+// chain returns the cached intermediate certificates and root certificate, fetching them
+// from Vault when they are stale. The stale values are returned if the fetch fails.
+func (ca *VaultCA) chain() ([]byte, []byte, error) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+
+	if ca.rootCertBytes != nil && time.Since(ca.fetched) < ca.opts.ChainRefreshInterval {
+		return ca.certChainBytes, ca.rootCertBytes, nil
+	}
+
+	chainPEM, err := ca.do(http.MethodGet, "ca_chain", nil)
+	if err == nil && len(bytes.TrimSpace(chainPEM)) == 0 {
+		// The mount holds a root CA, which has no chain.
+		chainPEM, err = ca.do(http.MethodGet, "ca/pem", nil)
+	}
+	if err != nil {
+		return ca.certChainBytes, ca.rootCertBytes, err
+	}
+	chain, root, err := splitChain(chainPEM)
+	if err != nil {
+		return ca.certChainBytes, ca.rootCertBytes, err
+	}
+
+	ca.certChainBytes, ca.rootCertBytes, ca.fetched = chain, root, time.Now()
+	return chain, root, nil
+}
+
+// do sends an authenticated request to the PKI mount and returns the response body.
+func (ca *VaultCA) do(method, path string, body []byte) ([]byte, error) {
+	url := fmt.Sprintf("%s/v1/%s/%s", ca.opts.Address, ca.opts.Mount, path)
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if ca.opts.Token != "" {
+		req.Header.Set(vaultTokenHeader, ca.opts.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := ca.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault request %s %s failed (%v)", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Vault response (%v)", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp vaultResponse
+		if json.Unmarshal(respBody, &errResp) == nil && len(errResp.Errors) > 0 {
+			return nil, fmt.Errorf("vault request %s %s failed with status %d: %s",
+				method, path, resp.StatusCode, strings.Join(errResp.Errors, "; "))
+		}
+		return nil, fmt.Errorf("vault request %s %s failed with status %d", method, path, resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// splitChain splits a PEM-encoded CA chain into its intermediate certificates and its root
+// certificate. The root is the self-signed certificate, or the last one if none is self-signed.
+func splitChain(chainPEM []byte) ([]byte, []byte, error) {
+	var chain, root []byte
+	var last []byte
+	for rest := chainPEM; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse the CA chain (%v)", err)
+		}
+		certPEM := pem.EncodeToMemory(block)
+		if bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil {
+			root = certPEM
+			continue
+		}
+		chain = append(chain, certPEM...)
+		last = certPEM
+	}
+
+	if root == nil {
+		if last == nil {
+			return nil, nil, fmt.Errorf("no certificate in the CA chain")
+		}
+		// The chain does not reach a self-signed root, so its last certificate is trusted as the root.
+		root = last
+		chain = chain[:len(chain)-len(last)]
+	}
+	return chain, root, nil
+}
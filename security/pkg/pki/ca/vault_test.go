@@ -0,0 +1,200 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"istio.io/istio/security/pkg/pki/testutil"
+)
+
+const testVaultToken = "s.test-token"
+
+// fakeVault is a local stand-in for the Vault PKI secrets engine, backed by an IstioCA.
+type fakeVault struct {
+	signer *IstioCA
+
+	mu           sync.Mutex
+	chainFetches int
+	signed       []string
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *httptest.Server) {
+	signer, err := createCA(2 * time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fv := &fakeVault{signer: signer.(*IstioCA)}
+	return fv, httptest.NewServer(fv)
+}
+
+func (fv *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(vaultTokenHeader) != testVaultToken {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/v1/pki/ca_chain":
+		fv.chainFetches++
+		_, _ = w.Write(append(fv.signer.certChainBytes, fv.signer.rootCertBytes...))
+	case "/v1/pki/sign/istio", "/v1/pki/root/sign-intermediate":
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ttl, err := time.ParseDuration(req["ttl"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		forCA := strings.HasSuffix(r.URL.Path, "sign-intermediate")
+		chain, err := fv.signer.Sign([]byte(req["csr"]), ttl, forCA, "vault")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(vaultResponse{Errors: []string{err.Error()}})
+			return
+		}
+		// Vault returns the leaf certificate alone.
+		block, _ := pem.Decode(chain)
+		fv.signed = append(fv.signed, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(vaultResponse{Data: vaultSignData{
+			Certificate: string(pem.EncodeToMemory(block)),
+			IssuingCA:   string(fv.signer.certChainBytes),
+		}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVaultCASign(t *testing.T) {
+	fv, server := newFakeVault(t)
+	defer server.Close()
+
+	ca, err := NewVaultCA(VaultCAOptions{
+		Address:    server.URL,
+		Token:      testVaultToken,
+		Role:       "istio",
+		MaxCertTTL: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if root := ca.GetRootCertificate(); !bytes.Equal(root, fv.signer.rootCertBytes) {
+		t.Errorf("Unexpected root certificate %s", root)
+	}
+
+	host := "spiffe://example.com/ns/foo/sa/bar"
+	csrPEM, keyPEM, err := GenCSR(CertOptions{Host: host, Org: "istio.io", RSAKeySize: 2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := ca.Sign(csrPEM, 30*time.Minute, false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := &testutil.VerifyFields{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		IsCA:        false,
+	}
+	if err = testutil.VerifyCertificate(keyPEM, certPEM, ca.GetRootCertificate(), host, fields); err != nil {
+		t.Error(err)
+	}
+
+	if _, err = ca.Sign(csrPEM, time.Hour, true, ""); err != nil {
+		t.Error(err)
+	}
+	want := []string{"/v1/pki/sign/istio", "/v1/pki/root/sign-intermediate"}
+	if strings.Join(fv.signed, ",") != strings.Join(want, ",") {
+		t.Errorf("Unexpected signing requests %v, expecting %v", fv.signed, want)
+	}
+
+	// The chain is cached.
+	if fv.chainFetches != 1 {
+		t.Errorf("Unexpected number of chain fetches %d", fv.chainFetches)
+	}
+}
+
+func TestVaultCAChainRefresh(t *testing.T) {
+	fv, server := newFakeVault(t)
+	defer server.Close()
+
+	ca, err := NewVaultCA(VaultCAOptions{Address: server.URL + "/", Token: testVaultToken, Role: "istio",
+		ChainRefreshInterval: time.Nanosecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := ca.GetRootCertificate()
+
+	// The cached chain is served when Vault becomes unreachable.
+	server.Close()
+	if !bytes.Equal(ca.GetRootCertificate(), root) {
+		t.Error("Expecting the cached root certificate")
+	}
+	if fv.chainFetches != 2 {
+		t.Errorf("Unexpected number of chain fetches %d", fv.chainFetches)
+	}
+}
+
+func TestVaultCAErrors(t *testing.T) {
+	_, server := newFakeVault(t)
+	defer server.Close()
+
+	if _, err := NewVaultCA(VaultCAOptions{Address: server.URL, Token: "bad-token", Role: "istio"}); err == nil ||
+		!strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("Expecting a permission denied error, got %v", err)
+	}
+
+	if _, err := NewVaultCA(VaultCAOptions{Address: server.URL, Token: testVaultToken}); err == nil {
+		t.Error("Expecting an error for a missing role")
+	}
+
+	ca, err := NewVaultCA(VaultCAOptions{
+		Address:    server.URL,
+		Token:      testVaultToken,
+		Role:       "unknown",
+		MaxCertTTL: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM, _, err := GenCSR(CertOptions{Host: "spiffe://example.com/ns/foo/sa/bar", RSAKeySize: 2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ca.Sign(csrPEM, 2*time.Hour, false, "")
+	if err == nil || !strings.Contains(err.Error(), "max allowed TTL") {
+		t.Errorf("Expecting a TTL error, got %v", err)
+	}
+	if _, err = ca.Sign(csrPEM, time.Hour, false, ""); err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("Expecting a not found error, got %v", err)
+	}
+}
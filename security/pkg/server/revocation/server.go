@@ -0,0 +1,180 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/security/pkg/pki/ca"
+)
+
+const (
+	// CRLPath serves the DER encoded certificate revocation list.
+	CRLPath = "/crl"
+	// OCSPPath answers OCSP requests, sent either as POST bodies or base64 encoded in GET paths.
+	OCSPPath = "/ocsp"
+	// RevokePath revokes a certificate. It is only served on the admin address.
+	RevokePath = "/revoke"
+	// IssuedPath lists the issued certificates. It is only served on the admin address.
+	IssuedPath = "/issued"
+
+	// maxOCSPRequestSize bounds the size of the OCSP requests.
+	maxOCSPRequestSize = 10000
+)
+
+// RevokeRequest is the body of a revocation request.
+type RevokeRequest struct {
+	// SerialNumber is the hex encoded serial number of the certificate to revoke.
+	SerialNumber string `json:"serial_number"`
+	// Reason is the RFC 5280 revocation reason code.
+	Reason int `json:"reason"`
+}
+
+// Server publishes the CRL and answers OCSP requests on a public port, and accepts
+// revocations on a separate admin address, which should not be reachable outside of the pod.
+type Server struct {
+	ca           ca.RevocationAuthority
+	port         int
+	adminAddress string
+}
+
+// New creates a new revocation Server.
+func New(ca ca.RevocationAuthority, port int, adminAddress string) *Server {
+	return &Server{
+		ca:           ca,
+		port:         port,
+		adminAddress: adminAddress,
+	}
+}
+
+// Run starts serving the public and admin endpoints.
+func (s *Server) Run() error {
+	public, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("cannot listen on port %d (error: %v)", s.port, err)
+	}
+	admin, err := net.Listen("tcp", s.adminAddress)
+	if err != nil {
+		_ = public.Close()
+		return fmt.Errorf("cannot listen on %s (error: %v)", s.adminAddress, err)
+	}
+
+	go s.serve(public, s.publicHandler())
+	go s.serve(admin, s.adminHandler())
+	return nil
+}
+
+func (s *Server) serve(listener net.Listener, handler http.Handler) {
+	log.Infof("Starting revocation server on %s", listener.Addr())
+	// http.Serve() always returns a non-nil error.
+	err := http.Serve(listener, handler)
+	log.Warnf("Revocation server on %s returns an error: %v", listener.Addr(), err)
+}
+
+func (s *Server) publicHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(CRLPath, s.handleCRL)
+	mux.HandleFunc(OCSPPath, s.handleOCSP)
+	mux.HandleFunc(OCSPPath+"/", s.handleOCSP)
+	return mux
+}
+
+func (s *Server) adminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(RevokePath, s.handleRevoke)
+	mux.HandleFunc(IssuedPath, s.handleIssued)
+	return mux
+}
+
+func (s *Server) handleCRL(w http.ResponseWriter, _ *http.Request) {
+	crl, err := s.ca.GetCRL()
+	if err != nil {
+		log.Errorf("Failed to generate the CRL (error: %v)", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if crl == nil {
+		http.Error(w, "revocation is not enabled", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	_, _ = w.Write(crl)
+}
+
+func (s *Server) handleOCSP(w http.ResponseWriter, r *http.Request) {
+	var request []byte
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		request, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(r.URL.Path, OCSPPath+"/"))
+	case http.MethodPost:
+		request, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxOCSPRequestSize))
+	default:
+		http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid OCSP request (%v)", err), http.StatusBadRequest)
+		return
+	}
+
+	response, err := s.ca.RespondOCSP(request)
+	if err != nil {
+		log.Errorf("Failed to answer an OCSP request (error: %v)", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	_, _ = w.Write(response)
+}
+
+func (s *Server) handleRevoke(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+		return
+	}
+	var req RevokeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid revocation request (%v)", err), http.StatusBadRequest)
+		return
+	}
+	serialNumber, ok := new(big.Int).SetString(strings.TrimPrefix(req.SerialNumber, "0x"), 16)
+	if !ok {
+		http.Error(w, fmt.Sprintf("invalid serial number %q", req.SerialNumber), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.ca.Revoke(serialNumber, req.Reason); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Infof("Certificate %s has been revoked (reason %d)", req.SerialNumber, req.Reason)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleIssued(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(s.ca.IssuedCertificates()); err != nil {
+		log.Warnf("Failed to write the issued certificates (error: %v)", err)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"istio.io/istio/security/pkg/pki"
+	"istio.io/istio/security/pkg/pki/ca"
+)
+
+func createCA(t *testing.T) (*ca.IstioCA, *x509.Certificate) {
+	certPEM, keyPEM, err := ca.GenCertKeyFromOptions(ca.CertOptions{
+		TTL:          time.Hour,
+		Org:          "Root CA",
+		IsCA:         true,
+		IsSelfSigned: true,
+		RSAKeySize:   2048,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	istioCA, err := ca.NewIstioCA(&ca.IstioCAOptions{
+		CertTTL:          time.Hour,
+		MaxCertTTL:       time.Hour,
+		SigningCertBytes: certPEM,
+		SigningKeyBytes:  keyPEM,
+		RootCertBytes:    certPEM,
+		IssuanceLog:      ca.NewMemoryIssuanceLog(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := pki.ParsePemEncodedCertificate(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return istioCA, issuer
+}
+
+func TestRevocation(t *testing.T) {
+	istioCA, issuer := createCA(t)
+	s := New(istioCA, 0, "")
+	public := httptest.NewServer(s.publicHandler())
+	defer public.Close()
+	admin := httptest.NewServer(s.adminHandler())
+	defer admin.Close()
+
+	csrPEM, _, err := ca.GenCSR(ca.CertOptions{Host: "spiffe://cluster.local/ns/foo/sa/bar", RSAKeySize: 2048})
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := istioCA.Sign(csrPEM, 30*time.Minute, false, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := pki.ParsePemEncodedCertificate(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Revocation is not served on the public port.
+	body, _ := json.Marshal(RevokeRequest{SerialNumber: cert.SerialNumber.Text(16), Reason: ocsp.KeyCompromise})
+	resp, err := http.Post(public.URL+RevokePath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Unexpected status %d for a public revocation", resp.StatusCode)
+	}
+
+	resp, err = http.Post(admin.URL+RevokePath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected status %d for a revocation", resp.StatusCode)
+	}
+
+	resp, err = http.Get(public.URL + CRLPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crl, _ := ioutil.ReadAll(resp.Body)
+	list, err := x509.ParseCRL(crl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked := list.TBSCertList.RevokedCertificates; len(revoked) != 1 ||
+		revoked[0].SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		t.Errorf("Unexpected revoked certificates %v", revoked)
+	}
+
+	ocspReq, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, get := range []bool{true, false} {
+		if get {
+			resp, err = http.Get(public.URL + OCSPPath + "/" + base64.StdEncoding.EncodeToString(ocspReq))
+		} else {
+			resp, err = http.Post(public.URL+OCSPPath, "application/ocsp-request", bytes.NewReader(ocspReq))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, _ := ioutil.ReadAll(resp.Body)
+		parsed, err := ocsp.ParseResponse(der, issuer)
+		if err != nil {
+			t.Fatalf("GET %v: %v", get, err)
+		}
+		if parsed.Status != ocsp.Revoked {
+			t.Errorf("GET %v: unexpected OCSP status %d", get, parsed.Status)
+		}
+	}
+
+	resp, err = http.Get(admin.URL + IssuedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var issued []ca.IssuedCert
+	if err = json.NewDecoder(resp.Body).Decode(&issued); err != nil {
+		t.Fatal(err)
+	}
+	if len(issued) != 1 || !issued[0].Revoked || issued[0].Requester != "test" {
+		t.Errorf("Unexpected issued certificates %+v", issued)
+	}
+}
+
+func TestRevokeInvalidRequests(t *testing.T) {
+	istioCA, _ := createCA(t)
+	admin := httptest.NewServer(New(istioCA, 0, "").adminHandler())
+	defer admin.Close()
+
+	for _, body := range []string{"not json", `{"serial_number": "xyz"}`, `{"serial_number": "abcdef"}`} {
+		resp, err := http.Post(admin.URL+RevokePath, "application/json", bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Unexpected status %d for %q", resp.StatusCode, body)
+		}
+	}
+}
@@ -22,7 +22,7 @@ const (
 	// SecretFile propages the key/cert to the workload through file.
 	SecretFile int = iota // 0
 	// WorkloadAPI propages the key/cert to the workload through API.
-	WorkloadAPI // 1, unimplemented.
+	WorkloadAPI // 1
 )
 
 // Config is the configuration for node agent to workload communication.
@@ -38,6 +38,16 @@ type Config struct {
 
 	// ServiceIdentityPrivateKeyFile is valid in FILE mode. It specifies the file path for service identity private key.
 	ServiceIdentityPrivateKeyFile string
+
+	// SocketFile is valid in WORKLOAD API mode. It specifies the path of the Unix domain socket the API is served on.
+	SocketFile string
+
+	// RootCertFile is valid in WORKLOAD API mode. It specifies the file path for the root certificate sent to workloads.
+	RootCertFile string
+
+	// AllowedUIDs is valid in WORKLOAD API mode. It specifies the user IDs of the processes allowed to fetch
+	// the secrets, and must not be empty.
+	AllowedUIDs []uint32
 }
 
 // NewSecretFileServerConfig creates a Config for propogating key/cert to workload through file.
@@ -49,3 +59,14 @@ func NewSecretFileServerConfig(certFile string, keyFile string) Config {
 		ServiceIdentityPrivateKeyFile: keyFile,
 	}
 }
+
+// NewSecretWorkloadAPIServerConfig creates a Config for propogating key/cert to workload through
+// the workload API served on the given Unix domain socket.
+func NewSecretWorkloadAPIServerConfig(socketFile string, rootCertFile string, allowedUIDs []uint32) Config {
+	return Config{
+		Mode:         WorkloadAPI,
+		SocketFile:   socketFile,
+		RootCertFile: rootCertFile,
+		AllowedUIDs:  allowedUIDs,
+	}
+}
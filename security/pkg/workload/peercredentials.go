@@ -0,0 +1,88 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const peerCredentialsAuthType = "peercred"
+
+// PeerCredentials identifies the process at the other end of a Unix domain socket connection.
+type PeerCredentials struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AuthType implements credentials.AuthInfo.
+func (c *PeerCredentials) AuthType() string {
+	return peerCredentialsAuthType
+}
+
+func (c *PeerCredentials) String() string {
+	return fmt.Sprintf("pid=%d uid=%d gid=%d", c.PID, c.UID, c.GID)
+}
+
+// peerCredentialsTransport is a gRPC server transport credential that identifies clients
+// connected over a Unix domain socket by the credentials of their process. The connection
+// itself is not encrypted; the socket never leaves the node.
+type peerCredentialsTransport struct{}
+
+func (peerCredentialsTransport) ClientHandshake(_ context.Context, _ string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, fmt.Errorf("peer credentials are only supported by servers")
+}
+
+func (peerCredentialsTransport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, fmt.Errorf("peer credentials require a Unix domain socket, got %T", conn)
+	}
+	creds, err := getPeerCredentials(unixConn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, creds, nil
+}
+
+func (peerCredentialsTransport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: peerCredentialsAuthType}
+}
+
+func (t peerCredentialsTransport) Clone() credentials.TransportCredentials {
+	return t
+}
+
+func (peerCredentialsTransport) OverrideServerName(string) error {
+	return nil
+}
+
+// peerCredentialsFromContext returns the credentials of the caller of a gRPC request.
+func peerCredentialsFromContext(ctx context.Context) (*PeerCredentials, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no peer in request context")
+	}
+	creds, ok := p.AuthInfo.(*PeerCredentials)
+	if !ok {
+		return nil, fmt.Errorf("no peer credentials for %v", p.Addr)
+	}
+	return creds, nil
+}
@@ -32,7 +32,11 @@ func NewSecretServer(cfg Config) (SecretServer, error) {
 	case SecretFile:
 		return &SecretFileServer{cfg}, nil
 	case WorkloadAPI:
-		return nil, fmt.Errorf("WORKLOAD API is unimplemented")
+		server, err := NewSecretWorkloadAPIServer(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return server, nil
 	default:
 		return nil, fmt.Errorf("mode: %d is not supported", cfg.Mode)
 	}
@@ -0,0 +1,188 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"istio.io/istio/pkg/log"
+	pb "istio.io/istio/security/proto"
+)
+
+const (
+	// socketFilePermission allows the processes of the owner and group of the socket to
+	// connect; callers are further authorized by their peer credentials.
+	socketFilePermission = 0660
+)
+
+// SecretWorkloadAPIServer is an implementation of SecretServer that serves the key/cert to
+// workloads through a gRPC API on a Unix domain socket. Connected workloads receive the
+// rotated key/cert as soon as both have been set.
+type SecretWorkloadAPIServer struct {
+	cfg      Config
+	server   *grpc.Server
+	listener net.Listener
+
+	mu sync.Mutex
+	// key and cert are the halves of a rotation that have been set but not yet sent.
+	key     []byte
+	cert    []byte
+	secrets *pb.WorkloadSecrets
+	// changed is closed and replaced when the secrets are rotated.
+	changed chan struct{}
+}
+
+// NewSecretWorkloadAPIServer creates a SecretWorkloadAPIServer and starts serving the API on the configured socket.
+func NewSecretWorkloadAPIServer(cfg Config) (*SecretWorkloadAPIServer, error) {
+	if cfg.SocketFile == "" {
+		return nil, fmt.Errorf("socket file is required for WORKLOAD API")
+	}
+	if len(cfg.AllowedUIDs) == 0 {
+		return nil, fmt.Errorf("allowed user IDs are required for WORKLOAD API")
+	}
+
+	// remove the socket left behind by a previous instance
+	if err := os.Remove(cfg.SocketFile); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove stale socket %s (%v)", cfg.SocketFile, err)
+	}
+	listener, err := net.Listen("unix", cfg.SocketFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s (%v)", cfg.SocketFile, err)
+	}
+	if err = os.Chmod(cfg.SocketFile, socketFilePermission); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to set permissions of %s (%v)", cfg.SocketFile, err)
+	}
+
+	s := &SecretWorkloadAPIServer{
+		cfg:      cfg,
+		server:   grpc.NewServer(grpc.Creds(peerCredentialsTransport{})),
+		listener: listener,
+		changed:  make(chan struct{}),
+	}
+	pb.RegisterWorkloadServiceServer(s.server, s)
+
+	go func() {
+		log.Infof("Serving workload API on %s", cfg.SocketFile)
+		if err := s.server.Serve(listener); err != nil {
+			log.Errorf("Workload API server on %s terminated: %v", cfg.SocketFile, err)
+		}
+	}()
+	return s, nil
+}
+
+// SetServiceIdentityPrivateKey sets the service identity private key served to the workloads.
+func (s *SecretWorkloadAPIServer) SetServiceIdentityPrivateKey(content []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = content
+	return s.rotate()
+}
+
+// SetServiceIdentityCert sets the service identity certificate served to the workloads.
+func (s *SecretWorkloadAPIServer) SetServiceIdentityCert(content []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cert = content
+	return s.rotate()
+}
+
+// rotate must be called with the lock held. It publishes new secrets once both the
+// key and the cert have been set, so that workloads never see a mismatched pair.
+func (s *SecretWorkloadAPIServer) rotate() error {
+	if s.key == nil || s.cert == nil {
+		return nil
+	}
+
+	var root []byte
+	if s.cfg.RootCertFile != "" {
+		var err error
+		if root, err = ioutil.ReadFile(s.cfg.RootCertFile); err != nil {
+			return fmt.Errorf("failed to read root cert %s (%v)", s.cfg.RootCertFile, err)
+		}
+	}
+
+	var version uint64 = 1
+	if s.secrets != nil {
+		version = s.secrets.Version + 1
+	}
+	s.secrets = &pb.WorkloadSecrets{
+		PrivateKey: s.key,
+		CertChain:  s.cert,
+		RootCert:   root,
+		Version:    version,
+	}
+	s.key, s.cert = nil, nil
+
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return nil
+}
+
+// FetchSecrets implements the WorkloadService API. The secrets are sent when they become
+// available and on every rotation, until the caller ends the stream or the server stops.
+func (s *SecretWorkloadAPIServer) FetchSecrets(_ *pb.FetchSecretsRequest, stream pb.WorkloadService_FetchSecretsServer) error {
+	creds, err := peerCredentialsFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "unable to identify caller: %v", err)
+	}
+	if !s.authorized(creds) {
+		log.Warnf("Refusing secrets to workload %v", creds)
+		return status.Errorf(codes.PermissionDenied, "user %d is not allowed to fetch secrets", creds.UID)
+	}
+	log.Infof("Workload %v is fetching secrets", creds)
+
+	var sent uint64
+	for {
+		s.mu.Lock()
+		secrets, changed := s.secrets, s.changed
+		s.mu.Unlock()
+
+		if secrets != nil && secrets.Version > sent {
+			if err := stream.Send(secrets); err != nil {
+				return err
+			}
+			sent = secrets.Version
+		}
+
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *SecretWorkloadAPIServer) authorized(creds *PeerCredentials) bool {
+	for _, uid := range s.cfg.AllowedUIDs {
+		if creds.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stops serving the API and closes the connections of the workloads.
+func (s *SecretWorkloadAPIServer) Stop() {
+	s.server.Stop()
+}
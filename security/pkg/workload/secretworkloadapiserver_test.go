@@ -0,0 +1,161 @@
+// Copyright 2018 Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "istio.io/istio/security/proto"
+)
+
+func setupWorkloadAPI(t *testing.T, allowedUIDs []uint32) (*SecretWorkloadAPIServer, pb.WorkloadServiceClient, func()) {
+	if runtime.GOOS != "linux" {
+		t.Skip("peer credentials are only supported on Linux")
+	}
+
+	dir, err := ioutil.TempDir("", "workloadapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCertFile := filepath.Join(dir, "root-cert.pem")
+	if err = ioutil.WriteFile(rootCertFile, []byte("root"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	socket := filepath.Join(dir, "workload.sock")
+	ss, err := NewSecretServer(NewSecretWorkloadAPIServerConfig(socket, rootCertFile, allowedUIDs))
+	if err != nil {
+		t.Fatalf("failed to create workload API server: %v", err)
+	}
+	server := ss.(*SecretWorkloadAPIServer)
+
+	conn, err := grpc.Dial(socket, grpc.WithInsecure(),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, pb.NewWorkloadServiceClient(conn), func() {
+		_ = conn.Close()
+		server.Stop()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestWorkloadAPIRotation(t *testing.T) {
+	server, client, cleanup := setupWorkloadAPI(t, []uint32{uint32(os.Getuid())})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.FetchSecrets(ctx, &pb.FetchSecretsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing is sent until both the key and the cert are set
+	if err = server.SetServiceIdentityCert([]byte("cert1")); err != nil {
+		t.Fatal(err)
+	}
+	if err = server.SetServiceIdentityPrivateKey([]byte("key1")); err != nil {
+		t.Fatal(err)
+	}
+	secrets, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(secrets.CertChain, []byte("cert1")) || !bytes.Equal(secrets.PrivateKey, []byte("key1")) ||
+		!bytes.Equal(secrets.RootCert, []byte("root")) || secrets.Version != 1 {
+		t.Errorf("got secrets %v", secrets)
+	}
+
+	if err = server.SetServiceIdentityCert([]byte("cert2")); err != nil {
+		t.Fatal(err)
+	}
+	if err = server.SetServiceIdentityPrivateKey([]byte("key2")); err != nil {
+		t.Fatal(err)
+	}
+	secrets, err = stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(secrets.CertChain, []byte("cert2")) || !bytes.Equal(secrets.PrivateKey, []byte("key2")) ||
+		secrets.Version != 2 {
+		t.Errorf("got rotated secrets %v", secrets)
+	}
+
+	// callers that connect after a rotation get the current secrets
+	late, err := client.FetchSecrets(ctx, &pb.FetchSecretsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secrets, err = late.Recv(); err != nil || secrets.Version != 2 {
+		t.Errorf("got secrets %v, error %v", secrets, err)
+	}
+}
+
+func TestWorkloadAPIPermissionDenied(t *testing.T) {
+	_, client, cleanup := setupWorkloadAPI(t, []uint32{uint32(os.Getuid()) + 1})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.FetchSecrets(ctx, &pb.FetchSecretsRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if s, _ := status.FromError(err); s.Code() != codes.PermissionDenied {
+		t.Errorf("got error %v, want permission denied", err)
+	}
+}
+
+func TestWorkloadAPIRequiresAllowedUIDs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "workloadapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	socket := filepath.Join(dir, "workload.sock")
+	if _, err = NewSecretServer(NewSecretWorkloadAPIServerConfig(socket, "", nil)); err == nil {
+		t.Error("expected an error without allowed user IDs")
+	}
+}
+
+func TestWorkloadAPISocketPermission(t *testing.T) {
+	server, _, cleanup := setupWorkloadAPI(t, []uint32{uint32(os.Getuid())})
+	defer cleanup()
+
+	info, err := os.Stat(server.cfg.SocketFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm&0007 != 0 {
+		t.Errorf("socket is accessible to other users: %v", perm)
+	}
+}